@@ -30,6 +30,7 @@ import (
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ui"
 )
 
 func getAclStrings(e *C.struct_daos_prop_entry) (out []string) {
@@ -357,6 +358,74 @@ func (cmd *containerGetACLCmd) Execute(args []string) error {
 	return cmd.outputACL(output, acl, cmd.Verbose)
 }
 
+type containerCheckAccessCmd struct {
+	aclCmd
+
+	User   ui.ACLPrincipalFlag   `long:"user" short:"u" required:"1" description:"user to evaluate the ACL for (user@[domain])"`
+	Groups []ui.ACLPrincipalFlag `long:"group" short:"g" description:"group the user belongs to (group@[domain]); may be repeated"`
+	Perms  string                `long:"perms" short:"p" description:"permissions to check for, in ACE format (e.g. rw)"`
+}
+
+func (cmd *containerCheckAccessCmd) Execute(args []string) error {
+	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
+	if err != nil {
+		return err
+	}
+	defer deallocCmdArgs()
+
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_COO_RO, ap)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	acl, err := cmd.getACL(ap)
+	if err != nil {
+		return errors.Wrapf(err, "failed to query ACL for container %s", cmd.ContainerID())
+	}
+
+	groups := make([]string, len(cmd.Groups))
+	for i, g := range cmd.Groups {
+		groups[i] = g.String()
+	}
+
+	access, err := control.CheckACLAccess(acl, control.ACLResourceContainer,
+		cmd.User.String(), groups, cmd.Perms)
+	if err != nil {
+		return errors.Wrapf(err, "failed to evaluate ACL for container %s", cmd.ContainerID())
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(access, nil)
+	}
+
+	cmd.Info(control.FormatACLAccess(access))
+	return nil
+}
+
+// setContainerOwner changes the owning user and/or group of the container.
+// An empty user or group is left unchanged.
+func setContainerOwner(hdl C.daos_handle_t, user, group string) error {
+	var cUser *C.char
+	var cGroup *C.char
+	if user != "" {
+		if !strings.ContainsRune(user, '@') {
+			user += "@"
+		}
+		cUser = C.CString(user)
+		defer C.free(unsafe.Pointer(cUser))
+	}
+	if group != "" {
+		if !strings.ContainsRune(group, '@') {
+			group += "@"
+		}
+		cGroup = C.CString(group)
+		defer C.free(unsafe.Pointer(cGroup))
+	}
+
+	return daosError(C.daos_cont_set_owner(hdl, cUser, cGroup, nil))
+}
+
 type containerSetOwnerCmd struct {
 	existingContainerCmd
 
@@ -381,25 +450,7 @@ func (cmd *containerSetOwnerCmd) Execute(args []string) error {
 	}
 	defer cleanup()
 
-	var user *C.char
-	var group *C.char
-	if cmd.User != "" {
-		if !strings.ContainsRune(cmd.User, '@') {
-			cmd.User += "@"
-		}
-		user = C.CString(cmd.User)
-		defer C.free(unsafe.Pointer(user))
-	}
-	if cmd.Group != "" {
-		if !strings.ContainsRune(cmd.Group, '@') {
-			cmd.Group += "@"
-		}
-		group = C.CString(cmd.Group)
-		defer C.free(unsafe.Pointer(group))
-	}
-
-	rc := C.daos_cont_set_owner(ap.cont, user, group, nil)
-	if err := daosError(rc); err != nil {
+	if err := setContainerOwner(ap.cont, cmd.User, cmd.Group); err != nil {
 		return errors.Wrapf(err,
 			"failed to set owner for container %s",
 			cmd.ContainerID())
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2018-2021 Intel Corporation.
+// (C) Copyright 2018-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,11 +7,19 @@
 package main
 
 import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"io"
+	"os"
+	"sort"
+	"strings"
+	"unicode/utf8"
 	"unsafe"
 
 	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 )
@@ -46,6 +54,232 @@ func (al attrList) asList() []string {
 	return names
 }
 
+// withPrefix returns the attributes whose names begin with the prefix.
+func (al attrList) withPrefix(prefix string) attrList {
+	if prefix == "" {
+		return al
+	}
+
+	out := make(attrList, 0, len(al))
+	for _, a := range al {
+		if strings.HasPrefix(a.Name, prefix) {
+			out = append(out, a)
+		}
+	}
+	return out
+}
+
+// attrB64Prefix marks a displayed attribute value as base64-encoded.
+const attrB64Prefix = "base64:"
+
+// attrValueString returns a printable representation of an attribute
+// value. Values that are not valid UTF-8 are displayed base64-encoded, as
+// are values which begin with the base64 prefix, so that a displayed value
+// with the prefix is always encoded.
+func attrValueString(value []byte) string {
+	if utf8.Valid(value) && !bytes.HasPrefix(value, []byte(attrB64Prefix)) {
+		return string(value)
+	}
+	return attrB64Prefix + base64.StdEncoding.EncodeToString(value)
+}
+
+// attrFileValue is an attribute value as stored in a JSON or YAML attribute
+// file. Values that are valid UTF-8 are stored as plain strings, and others
+// are stored base64-encoded as a map with a single "base64" key.
+type attrFileValue []byte
+
+type attrFileB64Value struct {
+	Base64 string `json:"base64" yaml:"base64"`
+}
+
+func (v attrFileValue) encoded() interface{} {
+	if utf8.Valid(v) {
+		return string(v)
+	}
+	return &attrFileB64Value{Base64: base64.StdEncoding.EncodeToString(v)}
+}
+
+func (v attrFileValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.encoded())
+}
+
+func (v attrFileValue) MarshalYAML() (interface{}, error) {
+	return v.encoded(), nil
+}
+
+var errAttrFileValue = errors.New("attribute value must be a string or a map with a base64 key")
+
+func (v *attrFileValue) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var str string
+	if err := unmarshal(&str); err == nil {
+		*v = attrFileValue(str)
+		return nil
+	}
+
+	var b64 attrFileB64Value
+	if err := unmarshal(&b64); err != nil {
+		return errAttrFileValue
+	}
+	return v.setBase64(b64.Base64)
+}
+
+func (v *attrFileValue) UnmarshalJSON(data []byte) error {
+	var str string
+	if err := json.Unmarshal(data, &str); err == nil {
+		*v = attrFileValue(str)
+		return nil
+	}
+	// As with YAML, numbers and booleans are accepted as their literal text.
+	var scalar interface{}
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	if err := dec.Decode(&scalar); err == nil {
+		switch scalar.(type) {
+		case json.Number, bool:
+			*v = attrFileValue(bytes.TrimSpace(data))
+			return nil
+		}
+	}
+
+	var b64 attrFileB64Value
+	dec = json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&b64); err != nil {
+		return errAttrFileValue
+	}
+	return v.setBase64(b64.Base64)
+}
+
+func (v *attrFileValue) setBase64(b64 string) error {
+	data, err := base64.StdEncoding.DecodeString(b64)
+	if err != nil {
+		return errors.Wrap(err, "invalid base64 attribute value")
+	}
+	*v = data
+	return nil
+}
+
+// attrFile is a map of attribute names to values, as stored in a JSON or
+// YAML file for bulk get and set operations.
+type attrFile map[string]attrFileValue
+
+func newAttrFile(attrs attrList) attrFile {
+	af := make(attrFile, len(attrs))
+	for _, a := range attrs {
+		af[a.Name] = a.Value
+	}
+	return af
+}
+
+// attrList returns the attributes in the file, sorted by name.
+func (af attrFile) attrList() attrList {
+	names := make([]string, 0, len(af))
+	for name := range af {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	attrs := make(attrList, len(names))
+	for i, name := range names {
+		attrs[i] = &attribute{
+			Name:  name,
+			Value: af[name],
+		}
+	}
+	return attrs
+}
+
+// parseAttrFile parses a JSON or YAML map of attribute names to values.
+func parseAttrFile(data []byte) (attrList, error) {
+	var af attrFile
+	// NB: Although YAML is a superset of JSON, the YAML parser doesn't
+	// accept all JSON escapes (e.g. "\/"), so JSON is parsed as such.
+	var err error
+	if json.Valid(data) {
+		err = json.Unmarshal(data, &af)
+	} else {
+		err = yaml.UnmarshalStrict(data, &af)
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse attribute file")
+	}
+	if len(af) == 0 {
+		return nil, errors.New("no attributes found in attribute file")
+	}
+
+	for name, value := range af {
+		if name == "" {
+			return nil, errors.New("attribute name must not be empty")
+		}
+		if len(value) == 0 {
+			return nil, errors.Errorf("attribute %q: value must not be empty", name)
+		}
+	}
+
+	return af.attrList(), nil
+}
+
+func readAttrFile(path string) (attrList, error) {
+	data, err := readInputFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read attribute file")
+	}
+
+	return parseAttrFile(data)
+}
+
+// getSetAttrs returns the attributes to be set, read either from an
+// attribute file or from the key:value pairs supplied on the command line.
+func getSetAttrs(file string, pairs map[string]string) (attrList, error) {
+	if file != "" {
+		if len(pairs) > 0 {
+			return nil, errors.New("cannot specify both --file and attribute arguments")
+		}
+		return readAttrFile(file)
+	}
+
+	if len(pairs) == 0 {
+		return nil, errors.New("attribute name and value are required")
+	}
+
+	af := make(attrFile, len(pairs))
+	for key, val := range pairs {
+		af[key] = attrFileValue(val)
+	}
+	return af.attrList(), nil
+}
+
+// attrOutputFlags defines the flags used to write attributes in bulk to
+// a JSON or YAML file.
+type attrOutputFlags struct {
+	OutFile string `long:"outfile" short:"O" description:"write attributes to a JSON or YAML file (- for stdout)"`
+	Force   bool   `long:"force" description:"allow to replace an existing output file"`
+	Format  string `long:"format" description:"output file format (json or yaml; default from --outfile extension, otherwise yaml)"`
+}
+
+func (f *attrOutputFlags) enabled() bool {
+	return f.OutFile != "" || f.Format != ""
+}
+
+// writeAttrs writes the attributes as a map of names to values.
+func (f *attrOutputFlags) writeAttrs(attrs attrList) error {
+	format, err := resolveFileFormat(f.Format, f.OutFile)
+	if err != nil {
+		return err
+	}
+
+	out := os.Stdout
+	if f.OutFile != "" && f.OutFile != "-" {
+		out, err = createOutputFile(f.OutFile, f.Force)
+		if err != nil {
+			return errors.Wrap(err, "failed to open attribute output file")
+		}
+		defer out.Close()
+	}
+
+	return writeFileFormat(out, format, newAttrFile(attrs))
+}
+
 func printAttributes(out io.Writer, header string, attrs ...*attribute) {
 	fmt.Fprintf(out, "%s\n", header)
 
@@ -63,7 +297,7 @@ func printAttributes(out io.Writer, header string, attrs ...*attribute) {
 		row := txtfmt.TableRow{}
 		row[nameTitle] = attr.Name
 		if len(attr.Value) != 0 {
-			row[valueTitle] = string(attr.Value)
+			row[valueTitle] = attrValueString(attr.Value)
 			if len(titles) == 1 {
 				titles = append(titles, valueTitle)
 			}
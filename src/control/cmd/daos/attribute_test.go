@@ -0,0 +1,202 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestDaos_parseAttrFile(t *testing.T) {
+	expAttrs := attrList{
+		{Name: "bin", Value: []byte{0xff, 0x00, 0x01}},
+		{Name: "count", Value: []byte("42")},
+		{Name: "owner", Value: []byte("alice")},
+	}
+
+	for name, tc := range map[string]struct {
+		in       string
+		expAttrs attrList
+		expErr   error
+	}{
+		"empty": {
+			expErr: errors.New("no attributes found"),
+		},
+		"not a map": {
+			in:     "- a\n- b\n",
+			expErr: errors.New("failed to parse"),
+		},
+		"empty value": {
+			in:     `owner: ""`,
+			expErr: errors.New("value must not be empty"),
+		},
+		"invalid base64": {
+			in:     "bin:\n  base64: '!!!'\n",
+			expErr: errors.New("invalid base64"),
+		},
+		"unknown map key": {
+			in:     "bin:\n  hex: ff00\n",
+			expErr: errors.New("must be a string or a map with a base64 key"),
+		},
+		"yaml": {
+			in:       "owner: alice\ncount: 42\nbin:\n  base64: /wAB\n",
+			expAttrs: expAttrs,
+		},
+		"json": {
+			in:       `{"owner": "alice", "count": "42", "bin": {"base64": "/wAB"}}`,
+			expAttrs: expAttrs,
+		},
+		"json with escapes": {
+			in: `{"url": "http:\/\/example.com\/a", "tab": "a\tb", "snow": "\u2603"}`,
+			expAttrs: attrList{
+				{Name: "snow", Value: []byte("\u2603")},
+				{Name: "tab", Value: []byte("a\tb")},
+				{Name: "url", Value: []byte("http://example.com/a")},
+			},
+		},
+		"json scalars": {
+			in: `{"count": 42, "ratio": 0.5, "enabled": true}`,
+			expAttrs: attrList{
+				{Name: "count", Value: []byte("42")},
+				{Name: "enabled", Value: []byte("true")},
+				{Name: "ratio", Value: []byte("0.5")},
+			},
+		},
+		"json unknown map key": {
+			in:     `{"bin": {"base64": "/wAB", "hex": "ff0001"}}`,
+			expErr: errors.New("must be a string or a map with a base64 key"),
+		},
+		"json invalid base64": {
+			in:     `{"bin": {"base64": "!!!"}}`,
+			expErr: errors.New("invalid base64"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotAttrs, gotErr := parseAttrFile([]byte(tc.in))
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expAttrs, gotAttrs); diff != "" {
+				t.Fatalf("unexpected attributes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_attrFile_write(t *testing.T) {
+	attrs := attrList{
+		{Name: "bin", Value: []byte{0xff, 0x00, 0x01}},
+		{Name: "owner", Value: []byte("alice")},
+	}
+
+	for name, tc := range map[string]struct {
+		format    string
+		expOutput string
+	}{
+		"json": {
+			format: fileFormatJSON,
+			expOutput: `{
+  "bin": {
+    "base64": "/wAB"
+  },
+  "owner": "alice"
+}
+`,
+		},
+		"yaml": {
+			format:    fileFormatYAML,
+			expOutput: "bin:\n  base64: /wAB\nowner: alice\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := writeFileFormat(&buf, tc.format, newAttrFile(attrs)); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expOutput, buf.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+
+			gotAttrs, err := parseAttrFile(buf.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(attrs, gotAttrs); diff != "" {
+				t.Fatalf("attributes did not round-trip (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_getSetAttrs(t *testing.T) {
+	for name, tc := range map[string]struct {
+		file     string
+		pairs    map[string]string
+		expAttrs attrList
+		expErr   error
+	}{
+		"nothing to set": {
+			expErr: errors.New("attribute name and value are required"),
+		},
+		"file and pairs": {
+			file:   "attrs.yaml",
+			pairs:  map[string]string{"a": "b"},
+			expErr: errors.New("cannot specify both"),
+		},
+		"missing file": {
+			file:   "/this/does/not/exist.yaml",
+			expErr: errors.New("failed to read attribute file"),
+		},
+		"pairs": {
+			pairs: map[string]string{"b": "2", "a": "1"},
+			expAttrs: attrList{
+				{Name: "a", Value: []byte("1")},
+				{Name: "b", Value: []byte("2")},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotAttrs, gotErr := getSetAttrs(tc.file, tc.pairs)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expAttrs, gotAttrs); diff != "" {
+				t.Fatalf("unexpected attributes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_attrList_withPrefix(t *testing.T) {
+	attrs := attrList{
+		{Name: "app.a"},
+		{Name: "app.b"},
+		{Name: "other"},
+	}
+
+	test.AssertEqual(t, 3, len(attrs.withPrefix("")), "unexpected count for empty prefix")
+	if diff := cmp.Diff(attrs[:2], attrs.withPrefix("app.")); diff != "" {
+		t.Fatalf("unexpected attributes (-want, +got):\n%s\n", diff)
+	}
+	test.AssertEqual(t, 0, len(attrs.withPrefix("none")), "unexpected count for unmatched prefix")
+}
+
+func TestDaos_attrValueString(t *testing.T) {
+	test.AssertEqual(t, "alice", attrValueString([]byte("alice")), "unexpected string value")
+	test.AssertEqual(t, "base64:/wAB", attrValueString([]byte{0xff, 0x00, 0x01}), "unexpected binary value")
+	// A value that begins with the prefix is encoded to avoid ambiguity.
+	test.AssertEqual(t, "base64:YmFzZTY0Oi93QUI=", attrValueString([]byte("base64:/wAB")), "unexpected prefixed value")
+}
@@ -20,6 +20,7 @@ import (
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
 
+	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/lib/ui"
@@ -57,11 +58,16 @@ type containerCmd struct {
 	UpdateACL    containerUpdateACLCmd    `command:"update-acl" description:"update a container's ACL"`
 	DeleteACL    containerDeleteACLCmd    `command:"delete-acl" description:"delete a container's ACL"`
 	SetOwner     containerSetOwnerCmd     `command:"set-owner" alias:"chown" description:"change ownership for a container"`
+	CheckAccess  containerCheckAccessCmd  `command:"check-access" description:"evaluate a container's ACL for a user"`
+
+	ExportConfig containerExportConfigCmd `command:"export-config" description:"export a container's configuration"`
+	ImportConfig containerImportConfigCmd `command:"import-config" description:"apply an exported configuration to a container"`
 
 	CreateSnapshot  containerSnapCreateCmd       `command:"create-snap" alias:"snap" description:"create container snapshot"`
 	DestroySnapshot containerSnapDestroyCmd      `command:"destroy-snap" description:"destroy container snapshot"`
 	ListSnapshots   containerSnapListCmd         `command:"list-snap" alias:"list-snaps" description:"list container snapshots"`
 	Rollback        containerSnapshotRollbackCmd `command:"rollback" description:"roll back container to specified snapshot"`
+	SnapPolicy      containerSnapPolicyCmd       `command:"snap-policy" description:"manage a container's snapshot policy"`
 }
 
 type containerBaseCmd struct {
@@ -229,9 +235,12 @@ type containerCreateCmd struct {
 	Mode            ConsModeFlag         `long:"mode" short:"M" description:"DFS consistency mode"`
 	ACLFile         string               `long:"acl-file" short:"A" description:"input file containing ACL"`
 	Group           ui.ACLPrincipalFlag  `long:"group" short:"g" description:"group who will own the container (group@[domain])"`
+	FromConfig      string               `long:"from-config" description:"input file containing an exported container configuration"`
 	Args            struct {
 		Label string `positional-arg-name:"label"`
 	} `positional-args:"yes"`
+
+	configACL []string
 }
 
 func (cmd *containerCreateCmd) Execute(_ []string) (err error) {
@@ -253,6 +262,16 @@ func (cmd *containerCreateCmd) Execute(_ []string) (err error) {
 		cmd.contLabel = cmd.Args.Label
 	}
 
+	var cfg *contConfig
+	if cmd.FromConfig != "" {
+		if cfg, err = readContConfig(cmd.FromConfig); err != nil {
+			return err
+		}
+		if err := cmd.setConfigDefaults(cfg); err != nil {
+			return err
+		}
+	}
+
 	if cmd.Properties.props != nil {
 		ap.props = cmd.Properties.props
 	}
@@ -292,11 +311,21 @@ func (cmd *containerCreateCmd) Execute(_ []string) (err error) {
 		return err
 	}
 
-	if err := cmd.openContainer(C.DAOS_COO_RO); err != nil {
+	openFlags := C.uint(C.DAOS_COO_RO)
+	if cfg != nil {
+		openFlags = C.DAOS_COO_RW
+	}
+	if err := cmd.openContainer(openFlags); err != nil {
 		return errors.Wrapf(err, "failed to open new container %s", contID)
 	}
 	defer cmd.closeContainer()
 
+	if cfg != nil {
+		if err := cmd.applyConfig(cfg); err != nil {
+			return errors.Wrapf(err, "container %s was created, but applying the configuration failed", contID)
+		}
+	}
+
 	var ci *containerInfo
 	ci, err = cmd.queryContainer()
 	if err != nil {
@@ -455,7 +484,7 @@ func (cmd *containerCreateCmd) getCreateProps() (*C.daos_prop_t, func(), error)
 		numEntries = int(cmd.Properties.props.dpp_nr)
 	}
 
-	if cmd.ACLFile != "" {
+	if cmd.ACLFile != "" || len(cmd.configACL) > 0 {
 		numEntries++
 	}
 
@@ -492,9 +521,9 @@ func (cmd *containerCreateCmd) getCreateProps() (*C.daos_prop_t, func(), error)
 		}
 	}
 
-	if cmd.ACLFile != "" {
+	if cmd.ACLFile != "" || len(cmd.configACL) > 0 {
 		// The ACL becomes part of the daos_prop_t and will be freed with that structure
-		cACL, _, err := aclFileToC(cmd.ACLFile)
+		cACL, err := cmd.getCreateACL()
 		if err != nil {
 			C.daos_prop_free(props)
 			return nil, nil, err
@@ -520,6 +549,16 @@ func (cmd *containerCreateCmd) getCreateProps() (*C.daos_prop_t, func(), error)
 	return props, func() { C.daos_prop_free(props) }, nil
 }
 
+func (cmd *containerCreateCmd) getCreateACL() (*C.struct_daos_acl, error) {
+	if cmd.ACLFile != "" {
+		cACL, _, err := aclFileToC(cmd.ACLFile)
+		return cACL, err
+	}
+
+	cACL, _, err := aclToC(&control.AccessControlList{Entries: cmd.configACL})
+	return cACL, err
+}
+
 type existingContainerCmd struct {
 	containerBaseCmd
 
@@ -1136,7 +1175,8 @@ func (cmd *containerCheckCmd) Execute(_ []string) error {
 type containerListAttrsCmd struct {
 	existingContainerCmd
 
-	Verbose bool `long:"verbose" short:"V" description:"Include values"`
+	Verbose bool   `long:"verbose" short:"V" description:"Include values"`
+	Prefix  string `long:"prefix" description:"only list attributes whose names begin with this prefix"`
 }
 
 func (cmd *containerListAttrsCmd) Execute(args []string) error {
@@ -1158,6 +1198,7 @@ func (cmd *containerListAttrsCmd) Execute(args []string) error {
 			"failed to list attributes for container %s",
 			cmd.ContainerID())
 	}
+	attrs = attrs.withPrefix(cmd.Prefix)
 
 	if cmd.JSONOutputEnabled() {
 		if cmd.Verbose {
@@ -1215,6 +1256,7 @@ func (cmd *containerDelAttrCmd) Execute(args []string) error {
 
 type containerGetAttrCmd struct {
 	existingContainerCmd
+	attrOutputFlags
 
 	FlagAttr string `long:"attr" short:"a" description:"single attribute name (deprecated; use positional argument)"`
 	Args     struct {
@@ -1257,6 +1299,10 @@ func (cmd *containerGetAttrCmd) Execute(args []string) error {
 		return errors.Wrapf(err, "failed to get attributes from container %s", cmd.ContainerID())
 	}
 
+	if cmd.attrOutputFlags.enabled() {
+		return cmd.writeAttrs(attrs)
+	}
+
 	if cmd.JSONOutputEnabled() {
 		// Maintain compatibility with older behavior.
 		if len(cmd.Args.Attrs.ParsedProps) == 1 && len(attrs) == 1 {
@@ -1279,6 +1325,7 @@ type containerSetAttrCmd struct {
 
 	FlagAttr  string `long:"attr" short:"a" description:"attribute name (deprecated; use positional argument)"`
 	FlagValue string `long:"value" short:"v" description:"attribute value (deprecated; use positional argument)"`
+	File      string `long:"file" description:"read attributes from a JSON or YAML file (- for stdin)"`
 	Args      struct {
 		Attrs ui.SetPropertiesFlag `positional-arg-name:"key:val[,key:val...]"`
 	} `positional-args:"yes"`
@@ -1300,8 +1347,9 @@ func (cmd *containerSetAttrCmd) Execute(args []string) error {
 		}
 	}
 
-	if len(cmd.Args.Attrs.ParsedProps) == 0 {
-		return errors.New("attribute name and value are required")
+	attrs, err := getSetAttrs(cmd.File, cmd.Args.Attrs.ParsedProps)
+	if err != nil {
+		return err
 	}
 
 	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
@@ -1316,14 +1364,6 @@ func (cmd *containerSetAttrCmd) Execute(args []string) error {
 	}
 	defer cleanup()
 
-	attrs := make(attrList, 0, len(cmd.Args.Attrs.ParsedProps))
-	for key, val := range cmd.Args.Attrs.ParsedProps {
-		attrs = append(attrs, &attribute{
-			Name:  key,
-			Value: []byte(val),
-		})
-	}
-
 	if err := setDaosAttributes(cmd.cContHandle, contAttr, attrs); err != nil {
 		return errors.Wrapf(err, "failed to set attributes on container %s", cmd.ContainerID())
 	}
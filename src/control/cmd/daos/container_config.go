@@ -0,0 +1,411 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+/*
+#include "util.h"
+
+#include <daos/cont_props.h>
+*/
+import "C"
+
+// contConfigVersion is the version of the container configuration
+// document written by export-config. Documents with a newer version
+// are rejected on import.
+const contConfigVersion = 1
+
+// contConfig is a portable description of a container's configuration,
+// suitable for recreating the container on another pool or system.
+type contConfig struct {
+	Version    int               `json:"version" yaml:"version"`
+	Type       string            `json:"type,omitempty" yaml:"type,omitempty"`
+	Properties map[string]string `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Owner      string            `json:"owner,omitempty" yaml:"owner,omitempty"`
+	Group      string            `json:"group,omitempty" yaml:"group,omitempty"`
+	ACL        []string          `json:"acl,omitempty" yaml:"acl,omitempty"`
+	Attributes attrFile          `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+}
+
+func (cfg *contConfig) validate() error {
+	switch {
+	case cfg.Version == 0:
+		return errors.New("container configuration is missing a version")
+	case cfg.Version > contConfigVersion:
+		return errors.Errorf("unsupported container configuration version %d (max %d)",
+			cfg.Version, contConfigVersion)
+	}
+
+	return nil
+}
+
+// propNames returns the configuration's property names in sorted order.
+func (cfg *contConfig) propNames() []string {
+	names := make([]string, 0, len(cfg.Properties))
+	for name := range cfg.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// parseContConfig parses a JSON or YAML container configuration document.
+func parseContConfig(data []byte) (*contConfig, error) {
+	cfg := new(contConfig)
+	// NB: YAML is a superset of JSON, so this handles both formats.
+	if err := yaml.UnmarshalStrict(data, cfg); err != nil {
+		return nil, errors.Wrap(err, "failed to parse container configuration")
+	}
+
+	if err := cfg.validate(); err != nil {
+		return nil, err
+	}
+
+	return cfg, nil
+}
+
+func readContConfig(path string) (*contConfig, error) {
+	data, err := readInputFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read container configuration")
+	}
+
+	return parseContConfig(data)
+}
+
+// contConfigPropValue converts the displayed value of a property into the
+// form accepted when setting it. The second return value is false if the
+// property has no value that can be set.
+func contConfigPropValue(name, value string) (string, bool) {
+	if value == "" || value == "not set" {
+		return "", false
+	}
+
+	switch name {
+	case "rd_fac":
+		// e.g. "rd_fac2" -> "2"
+		value = strings.TrimPrefix(value, "rd_fac")
+	case "rd_lvl", "perf_domain", "layout_type":
+		// e.g. "node (2)" -> "node"
+		if idx := strings.Index(value, " ("); idx > 0 {
+			value = value[:idx]
+		}
+		if strings.EqualFold(value, "unknown") {
+			return "", false
+		}
+	}
+
+	return value, true
+}
+
+// contConfigPropNames returns the names of the properties recorded in an
+// exported configuration. Read-only properties are not recorded, nor is
+// the container's health status, which is not part of its configuration.
+func contConfigPropNames() []string {
+	var names []string
+	for _, name := range propHdlrs.keys() {
+		if propHdlrs[name].readOnly || name == C.DAOS_PROP_ENTRY_STATUS {
+			continue
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// getContConfig builds a configuration document from the open container.
+func (cmd *containerBaseCmd) getContConfig() (*contConfig, error) {
+	cfg := &contConfig{
+		Version:    contConfigVersion,
+		Properties: make(map[string]string),
+	}
+
+	names := append(contConfigPropNames(), C.DAOS_PROP_ENTRY_LAYOUT_TYPE)
+	props, freeProps, err := getContainerProperties(cmd.cContHandle, names...)
+	defer freeProps()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch container properties")
+	}
+	for _, prop := range props {
+		value, ok := contConfigPropValue(prop.Name, prop.String())
+		if !ok {
+			continue
+		}
+		if prop.Name == C.DAOS_PROP_ENTRY_LAYOUT_TYPE {
+			cfg.Type = value
+			continue
+		}
+		cfg.Properties[prop.Name] = value
+	}
+
+	aclProps, freeACL, err := getContAcl(cmd.cContHandle)
+	switch {
+	case err == daos.NoPermission:
+		cmd.Notice("insufficient permission to read container ACL; ACL and ownership will not be exported")
+	case err != nil:
+		return nil, errors.Wrap(err, "failed to fetch container ACL")
+	default:
+		defer freeACL()
+		acl := convertACLProps(aclProps)
+		cfg.Owner = acl.Owner
+		cfg.Group = acl.OwnerGroup
+		if !acl.Empty() && acl.Entries[0] != "" {
+			cfg.ACL = acl.Entries
+		}
+	}
+
+	attrs, err := listDaosAttributes(cmd.cContHandle, contAttr, true)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to fetch container attributes")
+	}
+	cfg.Attributes = newAttrFile(attrs)
+
+	return cfg, nil
+}
+
+// setContConfigAttrs sets the configuration's user attributes on the open
+// container.
+func (cmd *containerBaseCmd) setContConfigAttrs(cfg *contConfig) error {
+	if err := setDaosAttributes(cmd.cContHandle, contAttr, cfg.Attributes.attrList()); err != nil {
+		return errors.Wrap(err, "failed to set container attributes")
+	}
+	return nil
+}
+
+// setContConfigOwner sets the configuration's owner and group on the open
+// container, if they differ from the current values. This should be done
+// last, as a change of ownership may revoke the caller's access.
+func (cmd *containerBaseCmd) setContConfigOwner(cfg *contConfig, cur *contConfig) error {
+	var user, group string
+	if cfg.Owner != "" && cfg.Owner != cur.Owner {
+		user = cfg.Owner
+	}
+	if cfg.Group != "" && cfg.Group != cur.Group {
+		group = cfg.Group
+	}
+	if user == "" && group == "" {
+		return nil
+	}
+
+	if err := setContainerOwner(cmd.cContHandle, user, group); err != nil {
+		return errors.Wrap(err, "failed to set container owner")
+	}
+	return nil
+}
+
+// setConfigDefaults fills in any create options not supplied on the command
+// line from the configuration. Options supplied on the command line take
+// precedence over the configuration. User attributes and the container
+// owner are applied after creation by applyConfig().
+func (cmd *containerCreateCmd) setConfigDefaults(cfg *contConfig) error {
+	if !cmd.Type.Set && cfg.Type != "" {
+		if err := cmd.Type.UnmarshalFlag(cfg.Type); err != nil {
+			return errors.Wrap(err, "invalid container type in configuration")
+		}
+	}
+
+	for _, name := range cfg.propNames() {
+		value := cfg.Properties[name]
+		if newName, found := contDeprProps[name]; found {
+			name = newName
+		}
+		if _, found := cmd.Properties.ParsedProps[name]; found {
+			continue
+		}
+
+		hdlr, err := propHdlrs.get(name)
+		if err != nil {
+			cmd.Noticef("skipping unknown property %q", name)
+			continue
+		}
+		if hdlr.readOnly {
+			cmd.Noticef("skipping read-only property %q", name)
+			continue
+		}
+
+		if err := cmd.Properties.AddPropVal(name, value); err != nil {
+			return errors.Wrapf(err, "invalid value for property %q in configuration", name)
+		}
+		if name == C.DAOS_PROP_ENTRY_LABEL {
+			cmd.contLabel = value
+		}
+	}
+
+	if cmd.ACLFile == "" && len(cfg.ACL) > 0 {
+		cmd.configACL = cfg.ACL
+	}
+	if cmd.Group == "" && cfg.Group != "" {
+		if err := cmd.Group.UnmarshalFlag(cfg.Group); err != nil {
+			return errors.Wrap(err, "invalid group in configuration")
+		}
+	}
+
+	return nil
+}
+
+// applyConfig applies the configuration settings that can't be supplied
+// at creation time to the newly-created container.
+func (cmd *containerCreateCmd) applyConfig(cfg *contConfig) error {
+	if err := cmd.setContConfigAttrs(cfg); err != nil {
+		return err
+	}
+
+	if cfg.Owner == "" {
+		return nil
+	}
+	cur, err := cmd.getContConfig()
+	if err != nil {
+		return err
+	}
+	// The group was set at creation.
+	return cmd.setContConfigOwner(&contConfig{Owner: cfg.Owner}, cur)
+}
+
+type containerExportConfigCmd struct {
+	existingContainerCmd
+
+	File   string `long:"outfile" short:"O" description:"write configuration to file instead of stdout"`
+	Force  bool   `long:"force" short:"f" description:"allow to replace an existing output file"`
+	Format string `long:"format" description:"configuration format (json or yaml; default from --outfile extension, otherwise yaml)"`
+}
+
+func (cmd *containerExportConfigCmd) Execute(_ []string) error {
+	format, err := resolveFileFormat(cmd.Format, cmd.File)
+	if err != nil {
+		return err
+	}
+
+	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
+	if err != nil {
+		return err
+	}
+	defer deallocCmdArgs()
+
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_COO_RO, ap)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	cfg, err := cmd.getContConfig()
+	if err != nil {
+		return errors.Wrapf(err, "failed to export configuration for container %s",
+			cmd.ContainerID())
+	}
+
+	if cmd.JSONOutputEnabled() && cmd.File == "" {
+		return cmd.OutputJSON(cfg, nil)
+	}
+
+	output := os.Stdout
+	if cmd.File != "" {
+		output, err = createOutputFile(cmd.File, cmd.Force)
+		if err != nil {
+			return errors.Wrap(err, "failed to open configuration output file")
+		}
+		defer output.Close()
+	}
+
+	return writeFileFormat(output, format, cfg)
+}
+
+type containerImportConfigCmd struct {
+	existingContainerCmd
+
+	File string `long:"config-file" short:"f" required:"1" description:"input file containing container configuration (- for stdin)"`
+}
+
+func (cmd *containerImportConfigCmd) Execute(_ []string) error {
+	cfg, err := readContConfig(cmd.File)
+	if err != nil {
+		return err
+	}
+
+	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
+	if err != nil {
+		return err
+	}
+	defer deallocCmdArgs()
+
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_COO_RW, ap)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	cur, err := cmd.getContConfig()
+	if err != nil {
+		return errors.Wrapf(err, "failed to query configuration for container %s",
+			cmd.ContainerID())
+	}
+
+	// With the exception of the label and health status, properties may
+	// only be set when the container is created, so differences are
+	// reported rather than applied. The label of an existing container
+	// is never changed by an import.
+	if cfg.Type != "" && !strings.EqualFold(cfg.Type, cur.Type) {
+		cmd.Noticef("skipping container type %s: type can only be set at creation (current: %s)",
+			cfg.Type, cur.Type)
+	}
+	for _, name := range cfg.propNames() {
+		value := cfg.Properties[name]
+		if newName, found := contDeprProps[name]; found {
+			name = newName
+		}
+
+		hdlr, err := propHdlrs.get(name)
+		switch {
+		case err != nil:
+			cmd.Noticef("skipping unknown property %q", name)
+		case hdlr.readOnly:
+			cmd.Noticef("skipping read-only property %q", name)
+		case name == C.DAOS_PROP_ENTRY_LABEL || name == C.DAOS_PROP_ENTRY_STATUS:
+			cmd.Debugf("skipping property %q on existing container", name)
+		case value != cur.Properties[name]:
+			cmd.Noticef("skipping property %q: can only be set at creation (current: %q, config: %q)",
+				name, cur.Properties[name], value)
+		}
+	}
+
+	if err := cmd.setContConfigAttrs(cfg); err != nil {
+		return err
+	}
+
+	if len(cfg.ACL) > 0 {
+		cACL, freeACL, err := aclToC(&control.AccessControlList{Entries: cfg.ACL})
+		if err != nil {
+			return err
+		}
+		defer freeACL()
+
+		if err := daosError(C.daos_cont_overwrite_acl(cmd.cContHandle, cACL, nil)); err != nil {
+			return errors.Wrapf(err, "failed to overwrite ACL for container %s",
+				cmd.ContainerID())
+		}
+	}
+
+	if err := cmd.setContConfigOwner(cfg, cur); err != nil {
+		return err
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, nil)
+	}
+
+	cmd.Infof("Successfully imported configuration for container %s", cmd.ContainerID())
+	return nil
+}
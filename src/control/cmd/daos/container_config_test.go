@@ -0,0 +1,175 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestDaos_parseContConfig(t *testing.T) {
+	expCfg := &contConfig{
+		Version: 1,
+		Type:    "POSIX",
+		Properties: map[string]string{
+			"label":  "mycont",
+			"rd_fac": "1",
+		},
+		Owner:      "alice@",
+		Group:      "staff@",
+		ACL:        []string{"A::OWNER@:rwdtTaAo", "A:G:GROUP@:rwtT"},
+		Attributes: attrFile{"import_date": attrFileValue("12/01/2021")},
+	}
+
+	for name, tc := range map[string]struct {
+		in     string
+		expCfg *contConfig
+		expErr error
+	}{
+		"empty": {
+			expErr: errors.New("missing a version"),
+		},
+		"unsupported version": {
+			in:     "version: 2",
+			expErr: errors.New("unsupported container configuration version 2"),
+		},
+		"unknown field": {
+			in:     "version: 1\nlabels: foo",
+			expErr: errors.New("failed to parse"),
+		},
+		"yaml": {
+			in: `
+version: 1
+type: POSIX
+properties:
+  label: mycont
+  rd_fac: "1"
+owner: alice@
+group: staff@
+acl:
+- A::OWNER@:rwdtTaAo
+- A:G:GROUP@:rwtT
+attributes:
+  import_date: 12/01/2021
+`,
+			expCfg: expCfg,
+		},
+		"json": {
+			in: `{
+  "version": 1,
+  "type": "POSIX",
+  "properties": {"label": "mycont", "rd_fac": "1"},
+  "owner": "alice@",
+  "group": "staff@",
+  "acl": ["A::OWNER@:rwdtTaAo", "A:G:GROUP@:rwtT"],
+  "attributes": {"import_date": "12/01/2021"}
+}`,
+			expCfg: expCfg,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotCfg, gotErr := parseContConfig([]byte(tc.in))
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expCfg, gotCfg); diff != "" {
+				t.Fatalf("unexpected config (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_contConfig_write(t *testing.T) {
+	cfg := &contConfig{
+		Version:    contConfigVersion,
+		Type:       "POSIX",
+		Properties: map[string]string{"label": "mycont", "ec_cell_sz": "64 KiB"},
+		ACL:        []string{"A::OWNER@:rwdtTaAo"},
+		Attributes: attrFile{"key": attrFileValue("value"), "bin": attrFileValue{0xff, 0x00}},
+	}
+
+	for _, format := range []string{fileFormatJSON, fileFormatYAML} {
+		t.Run(format, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := writeFileFormat(&buf, format, cfg); err != nil {
+				t.Fatal(err)
+			}
+
+			gotCfg, err := parseContConfig(buf.Bytes())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(cfg, gotCfg); diff != "" {
+				t.Fatalf("config did not round-trip (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_contConfigPropValue(t *testing.T) {
+	for name, tc := range map[string]struct {
+		prop     string
+		value    string
+		expValue string
+		expOK    bool
+	}{
+		"empty": {
+			prop: "label",
+		},
+		"not set": {
+			prop:  "ec_pda",
+			value: "not set",
+		},
+		"unchanged": {
+			prop:     "cksum",
+			value:    "crc32",
+			expValue: "crc32",
+			expOK:    true,
+		},
+		"rd_fac": {
+			prop:     "rd_fac",
+			value:    "rd_fac2",
+			expValue: "2",
+			expOK:    true,
+		},
+		"rd_lvl": {
+			prop:     "rd_lvl",
+			value:    "node (2)",
+			expValue: "node",
+			expOK:    true,
+		},
+		"perf_domain": {
+			prop:     "perf_domain",
+			value:    "root (255)",
+			expValue: "root",
+			expOK:    true,
+		},
+		"layout_type": {
+			prop:     "layout_type",
+			value:    "POSIX (1)",
+			expValue: "POSIX",
+			expOK:    true,
+		},
+		"unknown layout_type": {
+			prop:  "layout_type",
+			value: "unknown (0)",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotValue, gotOK := contConfigPropValue(tc.prop, tc.value)
+			test.AssertEqual(t, tc.expOK, gotOK, "unexpected ok")
+			test.AssertEqual(t, tc.expValue, gotValue, "unexpected value")
+		})
+	}
+}
@@ -39,6 +39,7 @@ type fsCmd struct {
 	ResetObjClass  fsResetOclassCmd    `command:"reset-oclass" description:"reset fs obj class"`
 	DfuseQuery     fsDfuseQueryCmd     `command:"query" description:"Query dfuse for memory usage"`
 	DfuseEvict     fsDfuseEvictCmd     `command:"evict" description:"Evict object from dfuse"`
+	Du             fsDuCmd             `command:"du" description:"Report space usage by directory"`
 }
 
 type fsCopyCmd struct {
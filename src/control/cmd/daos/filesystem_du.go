@@ -0,0 +1,308 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+/*
+#include "util.h"
+*/
+import "C"
+
+const (
+	// duReadBatch is the number of directory entries read per call.
+	duReadBatch = 128
+)
+
+// duDir holds the space accounting for a directory. While walking, Bytes
+// and Inodes count the directory itself and its non-directory entries; once
+// the walk is complete, they include the totals of all subdirectories.
+type duDir struct {
+	Path   string `json:"path"`
+	Bytes  uint64 `json:"bytes"`
+	Inodes uint64 `json:"inodes"`
+	depth  int
+	parent *duDir
+	handle interface{}
+}
+
+// duReadFunc reads a directory, adding its entries to the directory's
+// totals and returning its subdirectories.
+type duReadFunc func(dir *duDir) ([]*duDir, error)
+
+// walkDu walks the directory tree under root, reading up to nrWorkers
+// directories in parallel. Directories that fail to be read are passed to
+// onError and the walk continues. All of the directories visited are
+// returned with their totals rolled up from their subdirectories.
+func walkDu(root *duDir, nrWorkers int, read duReadFunc, onError func(*duDir, error)) []*duDir {
+	if nrWorkers < 1 {
+		nrWorkers = 1
+	}
+
+	type readResult struct {
+		dir     *duDir
+		subdirs []*duDir
+		err     error
+	}
+	work := make(chan *duDir)
+	results := make(chan readResult)
+	for i := 0; i < nrWorkers; i++ {
+		go func() {
+			for dir := range work {
+				subdirs, err := read(dir)
+				results <- readResult{dir: dir, subdirs: subdirs, err: err}
+			}
+		}()
+	}
+
+	// Pending directories are handed out newest first in order to keep
+	// the walk close to depth-first and bound the pending list.
+	var dirs []*duDir
+	pending := []*duDir{root}
+	inFlight := 0
+	for len(pending) > 0 || inFlight > 0 {
+		var workCh chan *duDir
+		var next *duDir
+		if len(pending) > 0 {
+			workCh = work
+			next = pending[len(pending)-1]
+		}
+
+		select {
+		case workCh <- next:
+			pending = pending[:len(pending)-1]
+			inFlight++
+		case res := <-results:
+			inFlight--
+			dirs = append(dirs, res.dir)
+			if res.err != nil {
+				onError(res.dir, res.err)
+			}
+			for _, subdir := range res.subdirs {
+				subdir.parent = res.dir
+				subdir.depth = res.dir.depth + 1
+				subdir.Path = path.Join(res.dir.Path, subdir.Path)
+			}
+			pending = append(pending, res.subdirs...)
+		}
+	}
+	close(work)
+
+	// Roll up the totals from the deepest directories first.
+	sort.SliceStable(dirs, func(i, j int) bool {
+		return dirs[i].depth > dirs[j].depth
+	})
+	for _, dir := range dirs {
+		if dir.parent != nil {
+			dir.parent.Bytes += dir.Bytes
+			dir.parent.Inodes += dir.Inodes
+		}
+	}
+
+	return dirs
+}
+
+// duReport returns the directories no deeper than maxDepth below the root,
+// sorted by path. A negative maxDepth reports all directories.
+func duReport(dirs []*duDir, maxDepth int) []*duDir {
+	report := []*duDir{}
+	for _, dir := range dirs {
+		if maxDepth < 0 || dir.depth <= maxDepth {
+			report = append(report, dir)
+		}
+	}
+	sort.Slice(report, func(i, j int) bool {
+		return report[i].Path < report[j].Path
+	})
+	return report
+}
+
+func printDuReport(out *strings.Builder, report []*duDir, rawBytes bool) {
+	sizeTitle := "Size"
+	inodesTitle := "Inodes"
+	pathTitle := "Path"
+	titles := []string{sizeTitle, inodesTitle, pathTitle}
+
+	table := []txtfmt.TableRow{}
+	for _, dir := range report {
+		size := humanize.IBytes(dir.Bytes)
+		if rawBytes {
+			size = fmt.Sprintf("%d", dir.Bytes)
+		}
+		table = append(table, txtfmt.TableRow{
+			sizeTitle:   size,
+			inodesTitle: fmt.Sprintf("%d", dir.Inodes),
+			pathTitle:   dir.Path,
+		})
+	}
+
+	tf := txtfmt.NewTableFormatter(titles...)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
+
+type fsDuCmd struct {
+	existingContainerCmd
+
+	DfsPath   string `long:"dfs-path" short:"H" description:"DFS path relative to root of container when using pool and container (default: the root of the container)"`
+	DfsPrefix string `long:"dfs-prefix" short:"I" description:"Optional prefix path to the root of the DFS container when using pool and container"`
+	MaxDepth  int    `long:"max-depth" short:"d" default:"1" description:"report directories up to this depth below the path (-1 for all)"`
+	Threads   int    `long:"threads" short:"t" default:"16" description:"number of directories to read in parallel"`
+	Bytes     bool   `long:"bytes" short:"b" description:"show sizes in bytes"`
+}
+
+// dfsDuReader reads directories of a mounted DFS container.
+type dfsDuReader struct {
+	dfs *C.dfs_t
+}
+
+func (r *dfsDuReader) read(dir *duDir) ([]*duDir, error) {
+	obj := dir.handle.(*C.dfs_obj_t)
+	dir.handle = nil
+	defer C.dfs_release(obj)
+
+	dir.Inodes++
+
+	var subdirs []*duDir
+	var lookupErr error
+	var anchor C.daos_anchor_t
+	dirents := make([]C.struct_dirent, duReadBatch)
+	stbufs := make([]C.struct_stat, duReadBatch)
+	for !C.daos_anchor_is_eof(&anchor) {
+		nr := C.uint32_t(duReadBatch)
+		rc := C.dfs_readdirplus(r.dfs, obj, &anchor, &nr, &dirents[0], &stbufs[0])
+		if err := dfsError(rc); err != nil {
+			return subdirs, errors.Wrapf(err, "failed to read directory %s", dir.Path)
+		}
+
+		for i := 0; i < int(nr); i++ {
+			switch stbufs[i].st_mode & C.S_IFMT {
+			case C.S_IFDIR:
+				name := C.GoString(&dirents[i].d_name[0])
+
+				var subObj *C.dfs_obj_t
+				rc := C.dfs_lookup_rel(r.dfs, obj, &dirents[i].d_name[0], C.O_RDONLY, &subObj, nil, nil)
+				if err := dfsError(rc); err != nil {
+					if lookupErr == nil {
+						lookupErr = errors.Wrapf(err, "failed to open directory %s",
+							path.Join(dir.Path, name))
+					}
+					continue
+				}
+				subdirs = append(subdirs, &duDir{Path: name, handle: subObj})
+			case C.S_IFREG:
+				dir.Bytes += uint64(stbufs[i].st_size)
+				dir.Inodes++
+			default:
+				dir.Inodes++
+			}
+		}
+	}
+
+	return subdirs, lookupErr
+}
+
+func (cmd *fsDuCmd) Execute(_ []string) error {
+	if cmd.Path != "" {
+		if cmd.DfsPath != "" {
+			return errors.New("Cannot use both --dfs-path and --path")
+		}
+		if cmd.DfsPrefix != "" {
+			return errors.New("Cannot use both --dfs-prefix and --path")
+		}
+	}
+
+	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
+	if err != nil {
+		return err
+	}
+	defer deallocCmdArgs()
+
+	// Ensure that the path within the container is resolved from --path.
+	ap.fs_op = C.FS_GET_ATTR
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_COO_RO, ap)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	dfsPath := cmd.DfsPath
+	switch {
+	case cmd.Path != "" && ap.dfs_path != nil:
+		dfsPath = C.GoString(ap.dfs_path)
+	case dfsPath == "" && cmd.DfsPrefix != "":
+		dfsPath = cmd.DfsPrefix
+	case dfsPath == "":
+		dfsPath = "/"
+	}
+
+	var dfs *C.dfs_t
+	if err := dfsError(C.dfs_mount(cmd.cPoolHandle, cmd.cContHandle, C.O_RDONLY, &dfs)); err != nil {
+		return errors.Wrap(err, "failed to mount container")
+	}
+	defer func() {
+		if err := dfsError(C.dfs_umount(dfs)); err != nil {
+			cmd.Errorf("failed to unmount container: %s", err)
+		}
+	}()
+
+	if cmd.DfsPrefix != "" {
+		cPrefix := C.CString(cmd.DfsPrefix)
+		defer freeString(cPrefix)
+		if err := dfsError(C.dfs_set_prefix(dfs, cPrefix)); err != nil {
+			return errors.Wrapf(err, "failed to set prefix %s", cmd.DfsPrefix)
+		}
+	}
+
+	cPath := C.CString(dfsPath)
+	defer freeString(cPath)
+	var rootObj *C.dfs_obj_t
+	var mode C.mode_t
+	if err := dfsError(C.dfs_lookup(dfs, cPath, C.O_RDONLY, &rootObj, &mode, nil)); err != nil {
+		return errors.Wrapf(err, "failed to look up %s", dfsPath)
+	}
+	if mode&C.S_IFMT != C.S_IFDIR {
+		C.dfs_release(rootObj)
+		return errors.Errorf("%s is not a directory", dfsPath)
+	}
+
+	reader := &dfsDuReader{dfs: dfs}
+	nrErrors := 0
+	dirs := walkDu(&duDir{Path: dfsPath, handle: rootObj}, cmd.Threads, reader.read,
+		func(dir *duDir, err error) {
+			nrErrors++
+			cmd.Errorf("%s", err)
+		})
+	report := duReport(dirs, cmd.MaxDepth)
+
+	if cmd.JSONOutputEnabled() {
+		var err error
+		if nrErrors > 0 {
+			err = errors.Errorf("failed to read %d directories; totals are incomplete", nrErrors)
+		}
+		return cmd.OutputJSON(report, err)
+	}
+
+	var bld strings.Builder
+	printDuReport(&bld, report, cmd.Bytes)
+	cmd.Info(bld.String())
+
+	if nrErrors > 0 {
+		return errors.Errorf("failed to read %d directories; totals are incomplete", nrErrors)
+	}
+	return nil
+}
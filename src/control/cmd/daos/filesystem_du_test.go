@@ -0,0 +1,124 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+)
+
+// testDuTree maps directory paths to their file sizes and subdirectory
+// names.
+type testDuTree map[string]struct {
+	files   []uint64
+	subdirs []string
+}
+
+func (tree testDuTree) read(dir *duDir) ([]*duDir, error) {
+	if strings.HasSuffix(dir.Path, "bad") {
+		return nil, errors.New("unreadable")
+	}
+
+	dir.Inodes++
+	var subdirs []*duDir
+	for _, size := range tree[dir.Path].files {
+		dir.Bytes += size
+		dir.Inodes++
+	}
+	for _, name := range tree[dir.Path].subdirs {
+		subdirs = append(subdirs, &duDir{Path: name})
+	}
+	return subdirs, nil
+}
+
+func TestDaos_walkDu(t *testing.T) {
+	tree := testDuTree{
+		"/": {
+			files:   []uint64{1},
+			subdirs: []string{"a", "b", "bad"},
+		},
+		"/a": {
+			files:   []uint64{10, 20},
+			subdirs: []string{"x", "y"},
+		},
+		"/a/x": {
+			files: []uint64{100},
+		},
+		"/a/y": {
+			subdirs: []string{"z"},
+		},
+		"/a/y/z": {
+			files: []uint64{1000, 2000, 3000},
+		},
+		"/b": {},
+	}
+
+	type reportEntry struct {
+		Path   string
+		Bytes  uint64
+		Inodes uint64
+	}
+
+	for name, tc := range map[string]struct {
+		maxDepth  int
+		nrWorkers int
+		expReport []reportEntry
+	}{
+		"depth 0": {
+			nrWorkers: 1,
+			expReport: []reportEntry{
+				{"/", 6131, 13},
+			},
+		},
+		"depth 1": {
+			maxDepth:  1,
+			nrWorkers: 4,
+			expReport: []reportEntry{
+				{"/", 6131, 13},
+				{"/a", 6130, 10},
+				{"/b", 0, 1},
+				{"/bad", 0, 0},
+			},
+		},
+		"all": {
+			maxDepth:  -1,
+			nrWorkers: 16,
+			expReport: []reportEntry{
+				{"/", 6131, 13},
+				{"/a", 6130, 10},
+				{"/a/x", 100, 2},
+				{"/a/y", 6000, 5},
+				{"/a/y/z", 6000, 4},
+				{"/b", 0, 1},
+				{"/bad", 0, 0},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotErrs []string
+			onError := func(dir *duDir, err error) {
+				gotErrs = append(gotErrs, dir.Path+": "+err.Error())
+			}
+
+			dirs := walkDu(&duDir{Path: "/"}, tc.nrWorkers, tree.read, onError)
+
+			var gotReport []reportEntry
+			for _, dir := range duReport(dirs, tc.maxDepth) {
+				gotReport = append(gotReport, reportEntry{dir.Path, dir.Bytes, dir.Inodes})
+			}
+			if diff := cmp.Diff(tc.expReport, gotReport); diff != "" {
+				t.Fatalf("unexpected report (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff([]string{"/bad: unreadable"}, gotErrs); diff != "" {
+				t.Fatalf("unexpected errors (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,7 +7,14 @@
 package main
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 	"unsafe"
 
 	"github.com/jessevdk/go-flags"
@@ -22,9 +29,9 @@ import (
 import "C"
 
 type objectCmd struct {
-	Query objQueryCmd `command:"query" description:"query an object's layout"`
-	//ListKeys objListKeysCmd `command:"list-keys" description:"list an object's keys"`
-	//Dump     objDumpCmd     `command:"dump" description:"dump an object's contents"`
+	Query    objQueryCmd    `command:"query" description:"query an object's layout"`
+	ListKeys objListKeysCmd `command:"list-keys" description:"list an object's keys"`
+	Dump     objDumpCmd     `command:"dump" description:"dump an object's contents"`
 }
 
 type objBaseCmd struct {
@@ -165,3 +172,565 @@ func (cmd *objQueryCmd) Execute(_ []string) error {
 
 	return nil
 }
+
+const (
+	// objEnumKeyNr is the number of key descriptors requested per
+	// enumeration call.
+	objEnumKeyNr = 16
+	// objEnumKeyBufSize is the initial size of the key enumeration buffer;
+	// it is grown as necessary if a key does not fit.
+	objEnumKeyBufSize = 4096
+	// objEnumRecxNr is the number of extents requested per array
+	// enumeration call.
+	objEnumRecxNr = 16
+	// objDumpChunkSize is the maximum amount of array data fetched (and
+	// held in memory) at one time while dumping an object.
+	objDumpChunkSize = 1 << 20
+)
+
+// objKey is a dkey or akey. Keys are frequently, but not necessarily,
+// printable strings, so they are displayed as-is when possible and
+// as a hex string otherwise.
+type objKey []byte
+
+func (k objKey) isPrintable() bool {
+	if len(k) == 0 || !utf8.Valid(k) || strings.HasPrefix(string(k), "0x") {
+		return false
+	}
+	for _, r := range string(k) {
+		if !unicode.IsPrint(r) {
+			return false
+		}
+	}
+	return true
+}
+
+func (k objKey) String() string {
+	if k.isPrintable() {
+		return string(k)
+	}
+	return "0x" + hex.EncodeToString(k)
+}
+
+func (k objKey) MarshalJSON() ([]byte, error) {
+	return json.Marshal(k.String())
+}
+
+// parseObjKey converts a key supplied on the command line into its
+// binary representation. Keys prefixed with "0x" are decoded as hex,
+// matching the format used when displaying non-printable keys.
+func parseObjKey(in string) (objKey, error) {
+	if in == "" {
+		return nil, errors.New("key must not be empty")
+	}
+	if !strings.HasPrefix(in, "0x") {
+		return objKey(in), nil
+	}
+
+	key, err := hex.DecodeString(strings.TrimPrefix(in, "0x"))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse %q as hex key", in)
+	}
+	if len(key) == 0 {
+		return nil, errors.New("key must not be empty")
+	}
+	return key, nil
+}
+
+// newObjKey allocates a C key containing a copy of the supplied key. The
+// returned key must be freed with freeObjKey().
+func newObjKey(key objKey) *C.daos_key_t {
+	cKey := (*C.daos_key_t)(C.calloc(1, C.sizeof_daos_key_t))
+	C.d_iov_set(cKey, C.CBytes(key), C.size_t(len(key)))
+	return cKey
+}
+
+func freeObjKey(cKey *C.daos_key_t) {
+	if cKey == nil {
+		return
+	}
+	C.free(cKey.iov_buf)
+	C.free(unsafe.Pointer(cKey))
+}
+
+// objBuf is a C-allocated buffer described by a single-entry
+// scatter/gather list, suitable for passing to the object API.
+type objBuf struct {
+	sgl  *C.d_sg_list_t
+	buf  unsafe.Pointer
+	size C.size_t
+}
+
+func newObjBuf(size C.size_t) *objBuf {
+	ob := &objBuf{
+		sgl: (*C.d_sg_list_t)(C.calloc(1, C.sizeof_d_sg_list_t)),
+	}
+	ob.sgl.sg_nr = 1
+	ob.sgl.sg_iovs = (*C.d_iov_t)(C.calloc(1, C.sizeof_d_iov_t))
+	ob.resize(size)
+	return ob
+}
+
+// resize ensures that the buffer can hold at least size bytes.
+func (ob *objBuf) resize(size C.size_t) {
+	if size <= ob.size && ob.buf != nil {
+		return
+	}
+	C.free(ob.buf)
+	ob.buf = C.malloc(size)
+	ob.size = size
+}
+
+// reset prepares the buffer to receive up to size bytes.
+func (ob *objBuf) reset(size C.size_t) {
+	ob.resize(size)
+	ob.sgl.sg_nr_out = 0
+	C.d_iov_set(ob.sgl.sg_iovs, ob.buf, size)
+}
+
+// bytes returns a copy of size bytes starting at off.
+func (ob *objBuf) bytes(off, size C.size_t) []byte {
+	return C.GoBytes(unsafe.Pointer(uintptr(ob.buf)+uintptr(off)), C.int(size))
+}
+
+func (ob *objBuf) free() {
+	C.free(ob.buf)
+	C.free(unsafe.Pointer(ob.sgl.sg_iovs))
+	C.free(unsafe.Pointer(ob.sgl))
+}
+
+// objReadCmd is embedded by commands that read object contents,
+// optionally at a given snapshot.
+type objReadCmd struct {
+	objBaseCmd
+
+	Epoch    EpochFlag `long:"epc" short:"e" description:"read the object at the given snapshot epoch"`
+	SnapName string    `long:"snap" short:"s" description:"read the object at the given snapshot name"`
+}
+
+// openObject opens the object read-only, along with a read-only
+// transaction if a snapshot was requested. The returned cleanup function
+// must be called when the object is no longer needed.
+func (cmd *objReadCmd) openObject(ap *C.struct_cmd_args_s) (oh, th C.daos_handle_t, cleanup func(), err error) {
+	if cmd.Epoch.Set && cmd.SnapName != "" {
+		return oh, th, nil, errors.New("can't specify both --epc and --snap")
+	}
+
+	oid, err := cmd.getOid()
+	if err != nil {
+		return oh, th, nil, err
+	}
+
+	var epoch C.uint64_t
+	switch {
+	case cmd.Epoch.Set:
+		epoch = C.uint64_t(cmd.Epoch.Value)
+	case cmd.SnapName != "":
+		epoch, err = resolveSnapName(ap, cmd.ContainerID().String(), cmd.SnapName)
+		if err != nil {
+			return oh, th, nil, err
+		}
+	}
+
+	if err = daosError(C.daos_obj_open(ap.cont, oid, C.DAOS_OO_RO, &oh, nil)); err != nil {
+		return oh, th, nil, errors.Wrapf(err, "failed to open object %s", oidString(oid))
+	}
+
+	if epoch != 0 {
+		if err = daosError(C.daos_tx_open_snap(ap.cont, epoch, &th, nil)); err != nil {
+			C.daos_obj_close(oh, nil)
+			return oh, th, nil, errors.Wrapf(err, "failed to open snapshot at epoch %#x", uint64(epoch))
+		}
+	}
+
+	cleanup = func() {
+		if epoch != 0 {
+			if err := daosError(C.daos_tx_close(th, nil)); err != nil {
+				cmd.Errorf("failed to close snapshot: %s", err)
+			}
+		}
+		if err := daosError(C.daos_obj_close(oh, nil)); err != nil {
+			cmd.Errorf("failed to close object: %s", err)
+		}
+	}
+
+	return oh, th, cleanup, nil
+}
+
+// listObjKeys calls the supplied callback for each dkey in the object or,
+// if dkey is non-nil, for each akey under that dkey.
+func listObjKeys(oh, th C.daos_handle_t, dkey *C.daos_key_t, cb func(objKey) error) error {
+	var anchor C.daos_anchor_t
+
+	kds := (*C.daos_key_desc_t)(C.calloc(objEnumKeyNr, C.sizeof_daos_key_desc_t))
+	defer C.free(unsafe.Pointer(kds))
+	kdSlice := unsafe.Slice(kds, objEnumKeyNr)
+
+	ob := newObjBuf(objEnumKeyBufSize)
+	defer ob.free()
+
+	for !C.daos_anchor_is_eof(&anchor) {
+		nr := C.uint32_t(objEnumKeyNr)
+		ob.reset(ob.size)
+
+		var rc C.int
+		if dkey == nil {
+			rc = C.daos_obj_list_dkey(oh, th, &nr, kds, ob.sgl, &anchor, nil)
+		} else {
+			rc = C.daos_obj_list_akey(oh, th, dkey, &nr, kds, ob.sgl, &anchor, nil)
+		}
+		if rc == -C.DER_KEY2BIG {
+			// The size required for the next key is returned in
+			// the first descriptor; grow the buffer and retry.
+			size := C.size_t(kdSlice[0].kd_key_len)
+			if size <= ob.size {
+				size = ob.size * 2
+			}
+			ob.resize(size)
+			continue
+		}
+		if err := daosError(rc); err != nil {
+			return err
+		}
+
+		var off C.size_t
+		for _, kd := range kdSlice[:nr] {
+			keyLen := C.size_t(kd.kd_key_len)
+			if err := cb(ob.bytes(off, keyLen)); err != nil {
+				return err
+			}
+			off += keyLen
+		}
+	}
+
+	return nil
+}
+
+type objKeyList struct {
+	DKey  objKey   `json:"dkey"`
+	AKeys []objKey `json:"akeys"`
+}
+
+type objListKeysCmd struct {
+	objReadCmd
+}
+
+func (cmd *objListKeysCmd) Execute(_ []string) error {
+	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
+	if err != nil {
+		return err
+	}
+	defer deallocCmdArgs()
+
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_COO_RO, ap)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	oh, th, closeObj, err := cmd.openObject(ap)
+	if err != nil {
+		return err
+	}
+	defer closeObj()
+
+	// Keys are printed as they are listed so that large objects don't
+	// need to be held in memory; only JSON output is buffered.
+	jsonOutput := cmd.JSONOutputEnabled()
+	var keys []*objKeyList
+	err = listObjKeys(oh, th, nil, func(dkey objKey) error {
+		var keyList *objKeyList
+		if jsonOutput {
+			keyList = &objKeyList{DKey: dkey, AKeys: []objKey{}}
+			keys = append(keys, keyList)
+		} else {
+			cmd.Infof("dkey: %s", dkey)
+		}
+
+		cDkey := newObjKey(dkey)
+		defer freeObjKey(cDkey)
+		if err := listObjKeys(oh, th, cDkey, func(akey objKey) error {
+			if jsonOutput {
+				keyList.AKeys = append(keyList.AKeys, akey)
+			} else {
+				cmd.Infof("  akey: %s", akey)
+			}
+			return nil
+		}); err != nil {
+			return errors.Wrapf(err, "failed to list akeys for dkey %s", dkey)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return errors.Wrapf(err, "failed to list keys for object %s", cmd.Args.ObjectID.String())
+	}
+
+	if jsonOutput {
+		return cmd.OutputJSON(keys, nil)
+	}
+
+	return nil
+}
+
+// objDumpFormat is the output format used by the object dump command.
+type objDumpFormat string
+
+const (
+	objDumpFormatHex  objDumpFormat = "hex"
+	objDumpFormatRaw  objDumpFormat = "raw"
+	objDumpFormatJSON objDumpFormat = "json"
+)
+
+func (f *objDumpFormat) UnmarshalFlag(fv string) error {
+	switch objDumpFormat(strings.ToLower(fv)) {
+	case objDumpFormatHex, objDumpFormatRaw, objDumpFormatJSON:
+		*f = objDumpFormat(strings.ToLower(fv))
+		return nil
+	default:
+		return errors.Errorf("invalid dump format %q (must be one of %s, %s or %s)",
+			fv, objDumpFormatHex, objDumpFormatRaw, objDumpFormatJSON)
+	}
+}
+
+// objValue is a single value or a contiguous array extent read from an
+// object. Array values larger than the dump chunk size are emitted as a
+// sequence of objValues.
+type objValue struct {
+	DKey       objKey `json:"dkey"`
+	AKey       objKey `json:"akey"`
+	Array      bool   `json:"array"`
+	Index      uint64 `json:"index"`
+	RecordSize uint64 `json:"record_size"`
+	Data       []byte `json:"data"`
+}
+
+// objValueWriter writes object values to the output stream in the
+// requested format as they are read, so that large objects are not
+// buffered in memory.
+type objValueWriter struct {
+	out    io.Writer
+	format objDumpFormat
+	enc    *json.Encoder
+}
+
+func newObjValueWriter(out io.Writer, format objDumpFormat) *objValueWriter {
+	return &objValueWriter{
+		out:    out,
+		format: format,
+		enc:    json.NewEncoder(out),
+	}
+}
+
+func (w *objValueWriter) write(val *objValue) error {
+	switch w.format {
+	case objDumpFormatRaw:
+		_, err := w.out.Write(val.Data)
+		return err
+	case objDumpFormatJSON:
+		return w.enc.Encode(val)
+	}
+
+	if val.Array {
+		nr := uint64(0)
+		if val.RecordSize > 0 {
+			nr = uint64(len(val.Data)) / val.RecordSize
+		}
+		fmt.Fprintf(w.out, "dkey: %s akey: %s array [%d, %d) record size %d\n",
+			val.DKey, val.AKey, val.Index, val.Index+nr, val.RecordSize)
+	} else {
+		fmt.Fprintf(w.out, "dkey: %s akey: %s single value size %d\n",
+			val.DKey, val.AKey, len(val.Data))
+	}
+
+	dumper := hex.Dumper(w.out)
+	if _, err := dumper.Write(val.Data); err != nil {
+		return err
+	}
+	return dumper.Close()
+}
+
+type objDumpCmd struct {
+	objReadCmd
+
+	DKey   string        `long:"dkey" description:"only dump values under this dkey (prefix with 0x for hex)"`
+	AKey   string        `long:"akey" description:"only dump values under this akey (prefix with 0x for hex)"`
+	Format objDumpFormat `long:"format" short:"f" description:"output format (hex, raw or json)" default:"hex"`
+}
+
+// dumpSingle fetches and writes a single value.
+func dumpSingle(oh, th C.daos_handle_t, dkey *C.daos_key_t, iod *C.daos_iod_t, val *objValue, w *objValueWriter) error {
+	size := C.size_t(iod.iod_size)
+	ob := newObjBuf(size)
+	defer ob.free()
+	ob.reset(size)
+
+	if err := daosError(C.daos_obj_fetch(oh, th, 0, dkey, 1, iod, ob.sgl, nil, nil)); err != nil {
+		return err
+	}
+
+	val.RecordSize = uint64(size)
+	val.Data = ob.bytes(0, size)
+	return w.write(val)
+}
+
+// dumpArray enumerates the extents of an array value and fetches and
+// writes them in chunks of at most objDumpChunkSize bytes.
+func dumpArray(oh, th C.daos_handle_t, dkey *C.daos_key_t, iod *C.daos_iod_t, val *objValue, w *objValueWriter) error {
+	var anchor C.daos_anchor_t
+	var recxs [objEnumRecxNr]C.daos_recx_t
+	var eprs [objEnumRecxNr]C.daos_epoch_range_t
+
+	fetchRecx := (*C.daos_recx_t)(C.calloc(1, C.sizeof_daos_recx_t))
+	defer C.free(unsafe.Pointer(fetchRecx))
+	iod.iod_type = C.DAOS_IOD_ARRAY
+	iod.iod_nr = 1
+	iod.iod_recxs = fetchRecx
+
+	ob := newObjBuf(objDumpChunkSize)
+	defer ob.free()
+
+	for !C.daos_anchor_is_eof(&anchor) {
+		var recSize C.daos_size_t
+		nr := C.uint32_t(objEnumRecxNr)
+		if err := daosError(C.daos_obj_list_recx(oh, th, dkey, &iod.iod_name, &recSize, &nr,
+			&recxs[0], &eprs[0], &anchor, true, nil)); err != nil {
+			return errors.Wrap(err, "failed to list array extents")
+		}
+		if recSize == 0 {
+			continue
+		}
+		iod.iod_size = recSize
+
+		chunkNr := C.uint64_t(objDumpChunkSize / recSize)
+		if chunkNr == 0 {
+			chunkNr = 1
+		}
+
+		for _, recx := range recxs[:nr] {
+			for idx := recx.rx_idx; idx < recx.rx_idx+recx.rx_nr; idx += chunkNr {
+				fetchRecx.rx_idx = idx
+				fetchRecx.rx_nr = recx.rx_idx + recx.rx_nr - idx
+				if fetchRecx.rx_nr > chunkNr {
+					fetchRecx.rx_nr = chunkNr
+				}
+
+				size := C.size_t(fetchRecx.rx_nr * C.uint64_t(recSize))
+				ob.reset(size)
+				if err := daosError(C.daos_obj_fetch(oh, th, 0, dkey, 1, iod, ob.sgl, nil, nil)); err != nil {
+					return err
+				}
+
+				val.Index = uint64(idx)
+				val.RecordSize = uint64(recSize)
+				val.Data = ob.bytes(0, size)
+				if err := w.write(val); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// dumpAkey writes the value stored under the given dkey/akey pair.
+func dumpAkey(oh, th C.daos_handle_t, dkey objKey, cDkey *C.daos_key_t, akey objKey, w *objValueWriter) error {
+	cAkey := newObjKey(akey)
+	defer freeObjKey(cAkey)
+
+	iod := (*C.daos_iod_t)(C.calloc(1, C.sizeof_daos_iod_t))
+	defer C.free(unsafe.Pointer(iod))
+	iod.iod_name = *cAkey
+
+	// Probe for a single value; if the returned size is zero, the akey
+	// holds an array instead.
+	iod.iod_type = C.DAOS_IOD_SINGLE
+	iod.iod_size = C.DAOS_REC_ANY
+	iod.iod_nr = 1
+	if err := daosError(C.daos_obj_fetch(oh, th, 0, cDkey, 1, iod, nil, nil, nil)); err != nil {
+		return err
+	}
+
+	val := &objValue{DKey: dkey, AKey: akey}
+	if iod.iod_size != 0 {
+		return dumpSingle(oh, th, cDkey, iod, val, w)
+	}
+
+	val.Array = true
+	return dumpArray(oh, th, cDkey, iod, val, w)
+}
+
+// dumpDkey writes the values stored under the given dkey, optionally
+// restricted to a single akey.
+func dumpDkey(oh, th C.daos_handle_t, dkey, akey objKey, w *objValueWriter) error {
+	cDkey := newObjKey(dkey)
+	defer freeObjKey(cDkey)
+
+	dumpOne := func(akey objKey) error {
+		if err := dumpAkey(oh, th, dkey, cDkey, akey, w); err != nil {
+			return errors.Wrapf(err, "failed to dump dkey %s akey %s", dkey, akey)
+		}
+		return nil
+	}
+
+	if akey != nil {
+		return dumpOne(akey)
+	}
+	if err := listObjKeys(oh, th, cDkey, dumpOne); err != nil {
+		return errors.Wrapf(err, "failed to list akeys for dkey %s", dkey)
+	}
+	return nil
+}
+
+func (cmd *objDumpCmd) Execute(_ []string) error {
+	var dkey, akey objKey
+	var err error
+	if cmd.DKey != "" {
+		if dkey, err = parseObjKey(cmd.DKey); err != nil {
+			return errors.Wrap(err, "invalid --dkey")
+		}
+	}
+	if cmd.AKey != "" {
+		if akey, err = parseObjKey(cmd.AKey); err != nil {
+			return errors.Wrap(err, "invalid --akey")
+		}
+	}
+
+	format := cmd.Format
+	if cmd.JSONOutputEnabled() {
+		format = objDumpFormatJSON
+	}
+
+	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
+	if err != nil {
+		return err
+	}
+	defer deallocCmdArgs()
+
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_COO_RO, ap)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	oh, th, closeObj, err := cmd.openObject(ap)
+	if err != nil {
+		return err
+	}
+	defer closeObj()
+
+	w := newObjValueWriter(os.Stdout, format)
+	if dkey != nil {
+		err = dumpDkey(oh, th, dkey, akey, w)
+	} else {
+		err = listObjKeys(oh, th, nil, func(dkey objKey) error {
+			return dumpDkey(oh, th, dkey, akey, w)
+		})
+	}
+	if err != nil {
+		return errors.Wrapf(err, "failed to dump object %s", cmd.Args.ObjectID.String())
+	}
+
+	return nil
+}
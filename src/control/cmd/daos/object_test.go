@@ -0,0 +1,185 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestDaos_objKey(t *testing.T) {
+	for name, tc := range map[string]struct {
+		key       objKey
+		expString string
+	}{
+		"printable": {
+			key:       objKey("file.txt"),
+			expString: "file.txt",
+		},
+		"binary": {
+			key:       objKey{0x01, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00},
+			expString: "0x0100000000000000",
+		},
+		"unprintable": {
+			key:       objKey("a\tb"),
+			expString: "0x610962",
+		},
+		"hex prefix": {
+			key:       objKey("0x12"),
+			expString: "0x30783132",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expString, tc.key.String(), "unexpected string")
+
+			gotKey, err := parseObjKey(tc.key.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.key, gotKey); diff != "" {
+				t.Fatalf("key did not round-trip (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_parseObjKey(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in     string
+		expKey objKey
+		expErr error
+	}{
+		"empty": {
+			expErr: errors.New("must not be empty"),
+		},
+		"empty hex": {
+			in:     "0x",
+			expErr: errors.New("must not be empty"),
+		},
+		"invalid hex": {
+			in:     "0xzz",
+			expErr: errors.New("failed to parse"),
+		},
+		"string": {
+			in:     "dkey",
+			expKey: objKey("dkey"),
+		},
+		"hex": {
+			in:     "0x0102",
+			expKey: objKey{0x01, 0x02},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotKey, gotErr := parseObjKey(tc.in)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expKey, gotKey); diff != "" {
+				t.Fatalf("unexpected key (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_objDumpFormat(t *testing.T) {
+	for name, tc := range map[string]struct {
+		arg       string
+		expFormat objDumpFormat
+		expErr    error
+	}{
+		"hex": {
+			arg:       "hex",
+			expFormat: objDumpFormatHex,
+		},
+		"raw": {
+			arg:       "RAW",
+			expFormat: objDumpFormatRaw,
+		},
+		"json": {
+			arg:       "json",
+			expFormat: objDumpFormatJSON,
+		},
+		"invalid": {
+			arg:    "yaml",
+			expErr: errors.New("invalid dump format"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotFormat objDumpFormat
+			gotErr := gotFormat.UnmarshalFlag(tc.arg)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expFormat, gotFormat, "unexpected format")
+		})
+	}
+}
+
+func TestDaos_objValueWriter(t *testing.T) {
+	single := &objValue{
+		DKey: objKey("dkey"),
+		AKey: objKey{0x01},
+		Data: []byte("hello"),
+	}
+	array := &objValue{
+		DKey:       objKey("dkey"),
+		AKey:       objKey("akey"),
+		Array:      true,
+		Index:      4,
+		RecordSize: 2,
+		Data:       []byte("abcd"),
+	}
+
+	for name, tc := range map[string]struct {
+		format    objDumpFormat
+		vals      []*objValue
+		expOutput string
+	}{
+		"hex": {
+			format: objDumpFormatHex,
+			vals:   []*objValue{single, array},
+			expOutput: "dkey: dkey akey: 0x01 single value size 5\n" +
+				"00000000  68 65 6c 6c 6f                                    |hello|\n" +
+				"dkey: dkey akey: akey array [4, 6) record size 2\n" +
+				"00000000  61 62 63 64                                       |abcd|\n",
+		},
+		"raw": {
+			format:    objDumpFormatRaw,
+			vals:      []*objValue{single, array},
+			expOutput: "helloabcd",
+		},
+		"json": {
+			format: objDumpFormatJSON,
+			vals:   []*objValue{single, array},
+			expOutput: `{"dkey":"dkey","akey":"0x01","array":false,"index":0,"record_size":0,"data":"aGVsbG8="}` + "\n" +
+				`{"dkey":"dkey","akey":"akey","array":true,"index":4,"record_size":2,"data":"YWJjZA=="}` + "\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			w := newObjValueWriter(&buf, tc.format)
+			for _, val := range tc.vals {
+				if err := w.write(val); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if diff := cmp.Diff(tc.expOutput, buf.String()); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
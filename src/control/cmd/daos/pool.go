@@ -433,7 +433,8 @@ func (cmd *poolQueryTargetsCmd) Execute(_ []string) error {
 type poolListAttrsCmd struct {
 	poolBaseCmd
 
-	Verbose bool `long:"verbose" short:"V" description:"Include values"`
+	Verbose bool   `long:"verbose" short:"V" description:"Include values"`
+	Prefix  string `long:"prefix" description:"only list attributes whose names begin with this prefix"`
 }
 
 func (cmd *poolListAttrsCmd) Execute(_ []string) error {
@@ -448,6 +449,7 @@ func (cmd *poolListAttrsCmd) Execute(_ []string) error {
 		return errors.Wrapf(err,
 			"failed to list attributes for pool %s", cmd.poolUUID)
 	}
+	attrs = attrs.withPrefix(cmd.Prefix)
 
 	if cmd.JSONOutputEnabled() {
 		if cmd.Verbose {
@@ -467,6 +469,7 @@ func (cmd *poolListAttrsCmd) Execute(_ []string) error {
 
 type poolGetAttrCmd struct {
 	poolBaseCmd
+	attrOutputFlags
 
 	Args struct {
 		Attrs ui.GetPropertiesFlag `positional-arg-name:"key[,key...]"`
@@ -490,6 +493,10 @@ func (cmd *poolGetAttrCmd) Execute(_ []string) error {
 		return errors.Wrapf(err, "failed to get attributes for pool %s", cmd.PoolID())
 	}
 
+	if cmd.attrOutputFlags.enabled() {
+		return cmd.writeAttrs(attrs)
+	}
+
 	if cmd.JSONOutputEnabled() {
 		// Maintain compatibility with older behavior.
 		if len(cmd.Args.Attrs.ParsedProps) == 1 && len(attrs) == 1 {
@@ -510,29 +517,23 @@ func (cmd *poolGetAttrCmd) Execute(_ []string) error {
 type poolSetAttrCmd struct {
 	poolBaseCmd
 
+	File string `long:"file" description:"read attributes from a JSON or YAML file (- for stdin)"`
 	Args struct {
-		Attrs ui.SetPropertiesFlag `positional-arg-name:"key:val[,key:val...]" required:"1"`
+		Attrs ui.SetPropertiesFlag `positional-arg-name:"key:val[,key:val...]"`
 	} `positional-args:"yes"`
 }
 
 func (cmd *poolSetAttrCmd) Execute(_ []string) error {
-	cleanup, err := cmd.resolveAndConnect(C.DAOS_PC_RW, nil)
+	attrs, err := getSetAttrs(cmd.File, cmd.Args.Attrs.ParsedProps)
 	if err != nil {
 		return err
 	}
-	defer cleanup()
-
-	if len(cmd.Args.Attrs.ParsedProps) == 0 {
-		return errors.New("attribute name and value are required")
-	}
 
-	attrs := make(attrList, 0, len(cmd.Args.Attrs.ParsedProps))
-	for key, val := range cmd.Args.Attrs.ParsedProps {
-		attrs = append(attrs, &attribute{
-			Name:  key,
-			Value: []byte(val),
-		})
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_PC_RW, nil)
+	if err != nil {
+		return err
 	}
+	defer cleanup()
 
 	if err := setDaosAttributes(cmd.cPoolHandle, poolAttr, attrs); err != nil {
 		return errors.Wrapf(err, "failed to set attributes on pool %s", cmd.PoolID())
@@ -567,12 +568,17 @@ func (cmd *poolDelAttrCmd) Execute(_ []string) error {
 
 type poolAutoTestCmd struct {
 	poolBaseCmd
+	poolBenchFlags
 
 	SkipBig       C.bool `long:"skip-big" short:"S" description:"skip big tests"`
 	DeadlineLimit C.int  `long:"deadline-limit" short:"D" description:"deadline limit for test (seconds)"`
 }
 
 func (cmd *poolAutoTestCmd) Execute(_ []string) error {
+	if cmd.Bench {
+		return cmd.runBench()
+	}
+
 	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
 	if err != nil {
 		return err
@@ -0,0 +1,493 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"sort"
+	"strings"
+	"time"
+	"unsafe"
+
+	"github.com/dustin/go-humanize"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+/*
+#include "util.h"
+*/
+import "C"
+
+const (
+	benchOpWrite = "write"
+	benchOpRead  = "read"
+	benchOpPunch = "punch"
+
+	// benchMaxIOSize is the largest I/O size accepted for a benchmark
+	// step.
+	benchMaxIOSize = 64 << 20
+)
+
+// benchOps are the benchmark operations, in the order they are run for
+// each object class and I/O size.
+var benchOps = []string{benchOpWrite, benchOpRead, benchOpPunch}
+
+// benchLatency holds operation latency percentiles in microseconds.
+type benchLatency struct {
+	P50 float64 `json:"p50"`
+	P90 float64 `json:"p90"`
+	P99 float64 `json:"p99"`
+	Max float64 `json:"max"`
+}
+
+// percentile returns the nearest-rank percentile of the sorted latencies.
+func percentile(sorted []time.Duration, pct float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(pct/100*float64(len(sorted)))) - 1
+	if idx < 0 {
+		idx = 0
+	}
+	return sorted[idx]
+}
+
+func durationUs(d time.Duration) float64 {
+	return float64(d) / float64(time.Microsecond)
+}
+
+// benchStep holds the results of a single benchmark step.
+type benchStep struct {
+	Op          string       `json:"op"`
+	ObjClass    string       `json:"oclass"`
+	IOSize      uint64       `json:"io_size"`
+	Ops         uint64       `json:"ops"`
+	Seconds     float64      `json:"seconds"`
+	Bandwidth   float64      `json:"bandwidth_mib"`
+	IOPS        float64      `json:"iops"`
+	Latency     benchLatency `json:"latency_us"`
+	Regressions []string     `json:"regressions,omitempty"`
+}
+
+func newBenchStep(op, oclass string, ioSize uint64, elapsed time.Duration, latencies []time.Duration) *benchStep {
+	step := &benchStep{
+		Op:       op,
+		ObjClass: oclass,
+		IOSize:   ioSize,
+		Ops:      uint64(len(latencies)),
+		Seconds:  elapsed.Seconds(),
+	}
+	if step.Ops == 0 || elapsed <= 0 {
+		return step
+	}
+
+	step.IOPS = float64(step.Ops) / elapsed.Seconds()
+	if op != benchOpPunch {
+		step.Bandwidth = float64(step.Ops*ioSize) / elapsed.Seconds() / (1 << 20)
+	}
+
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	step.Latency = benchLatency{
+		P50: durationUs(percentile(sorted, 50)),
+		P90: durationUs(percentile(sorted, 90)),
+		P99: durationUs(percentile(sorted, 99)),
+		Max: durationUs(sorted[len(sorted)-1]),
+	}
+
+	return step
+}
+
+// benchThreshold sets the minimum expected performance for the benchmark
+// steps that it matches. An empty object class or zero I/O size matches
+// any step with the same operation.
+type benchThreshold struct {
+	Op            string  `json:"op"`
+	ObjClass      string  `json:"oclass,omitempty"`
+	IOSize        uint64  `json:"io_size,omitempty"`
+	MinBandwidth  float64 `json:"min_bandwidth_mib,omitempty"`
+	MinIOPS       float64 `json:"min_iops,omitempty"`
+	MaxLatencyP99 float64 `json:"max_latency_p99_us,omitempty"`
+}
+
+func (bt *benchThreshold) matches(step *benchStep) bool {
+	return bt.Op == step.Op &&
+		(bt.ObjClass == "" || strings.EqualFold(bt.ObjClass, step.ObjClass)) &&
+		(bt.IOSize == 0 || bt.IOSize == step.IOSize)
+}
+
+// check returns a description of each threshold not met by the step.
+func (bt *benchThreshold) check(step *benchStep) []string {
+	var regressions []string
+	if bt.MinBandwidth > 0 && step.Bandwidth < bt.MinBandwidth {
+		regressions = append(regressions, fmt.Sprintf("bandwidth %.2f MiB/s < %.2f MiB/s",
+			step.Bandwidth, bt.MinBandwidth))
+	}
+	if bt.MinIOPS > 0 && step.IOPS < bt.MinIOPS {
+		regressions = append(regressions, fmt.Sprintf("IOPS %.2f < %.2f", step.IOPS, bt.MinIOPS))
+	}
+	if bt.MaxLatencyP99 > 0 && step.Latency.P99 > bt.MaxLatencyP99 {
+		regressions = append(regressions, fmt.Sprintf("p99 latency %.2fus > %.2fus",
+			step.Latency.P99, bt.MaxLatencyP99))
+	}
+	return regressions
+}
+
+// benchBaseline is a set of thresholds that benchmark results are
+// compared against.
+type benchBaseline struct {
+	Thresholds []*benchThreshold `json:"thresholds"`
+}
+
+func parseBenchBaseline(data []byte) (*benchBaseline, error) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+
+	baseline := new(benchBaseline)
+	if err := dec.Decode(baseline); err != nil {
+		return nil, errors.Wrap(err, "failed to parse benchmark baseline")
+	}
+
+	for i, bt := range baseline.Thresholds {
+		if bt == nil {
+			return nil, errors.Errorf("benchmark baseline threshold %d is empty", i)
+		}
+		found := false
+		for _, op := range benchOps {
+			if bt.Op == op {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, errors.Errorf("benchmark baseline threshold %d: invalid op %q (must be one of %s)",
+				i, bt.Op, strings.Join(benchOps, ", "))
+		}
+		if bt.MinBandwidth < 0 || bt.MinIOPS < 0 || bt.MaxLatencyP99 < 0 {
+			return nil, errors.Errorf("benchmark baseline threshold %d: thresholds must not be negative", i)
+		}
+		if bt.MinBandwidth == 0 && bt.MinIOPS == 0 && bt.MaxLatencyP99 == 0 {
+			return nil, errors.Errorf("benchmark baseline threshold %d: no thresholds set", i)
+		}
+	}
+
+	return baseline, nil
+}
+
+func readBenchBaseline(path string) (*benchBaseline, error) {
+	data, err := readInputFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read benchmark baseline %q", path)
+	}
+	return parseBenchBaseline(data)
+}
+
+// check compares the benchmark steps against all matching thresholds,
+// recording any regressions in the steps, and returns the number of steps
+// with regressions.
+func (bb *benchBaseline) check(steps []*benchStep) int {
+	nrRegressed := 0
+	for _, step := range steps {
+		for _, bt := range bb.Thresholds {
+			if bt.matches(step) {
+				step.Regressions = append(step.Regressions, bt.check(step)...)
+			}
+		}
+		if len(step.Regressions) > 0 {
+			nrRegressed++
+		}
+	}
+	return nrRegressed
+}
+
+// parseBenchSizes parses a comma-separated list of I/O sizes.
+func parseBenchSizes(in string) ([]uint64, error) {
+	var sizes []uint64
+	for _, str := range strings.Split(in, ",") {
+		str = strings.TrimSpace(str)
+		if str == "" {
+			continue
+		}
+		size, err := humanize.ParseBytes(str)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid benchmark I/O size %q", str)
+		}
+		if size == 0 || size > benchMaxIOSize {
+			return nil, errors.Errorf("benchmark I/O size %q must be between 1 byte and %s",
+				str, humanize.IBytes(benchMaxIOSize))
+		}
+		sizes = append(sizes, size)
+	}
+	if len(sizes) == 0 {
+		return nil, errors.New("no benchmark I/O sizes")
+	}
+	return sizes, nil
+}
+
+func printBenchSteps(out io.Writer, steps []*benchStep) {
+	opTitle := "Step"
+	oclassTitle := "OClass"
+	sizeTitle := "Size"
+	opsTitle := "Ops"
+	bwTitle := "MiB/s"
+	iopsTitle := "IOPS"
+	p50Title := "p50 (us)"
+	p99Title := "p99 (us)"
+	maxTitle := "Max (us)"
+	statusTitle := "Status"
+	titles := []string{opTitle, oclassTitle, sizeTitle, opsTitle, bwTitle, iopsTitle,
+		p50Title, p99Title, maxTitle, statusTitle}
+
+	table := []txtfmt.TableRow{}
+	for _, step := range steps {
+		bw := "-"
+		if step.Op != benchOpPunch {
+			bw = fmt.Sprintf("%.2f", step.Bandwidth)
+		}
+		status := "OK"
+		if len(step.Regressions) > 0 {
+			status = "FAIL: " + strings.Join(step.Regressions, "; ")
+		}
+		table = append(table, txtfmt.TableRow{
+			opTitle:     step.Op,
+			oclassTitle: step.ObjClass,
+			sizeTitle:   humanize.IBytes(step.IOSize),
+			opsTitle:    fmt.Sprintf("%d", step.Ops),
+			bwTitle:     bw,
+			iopsTitle:   fmt.Sprintf("%.2f", step.IOPS),
+			p50Title:    fmt.Sprintf("%.1f", step.Latency.P50),
+			p99Title:    fmt.Sprintf("%.1f", step.Latency.P99),
+			maxTitle:    fmt.Sprintf("%.1f", step.Latency.Max),
+			statusTitle: status,
+		})
+	}
+
+	tf := txtfmt.NewTableFormatter(titles...)
+	tf.InitWriter(out)
+	tf.Format(table)
+}
+
+// benchRunner runs benchmark steps against an open container. Each step
+// issues one operation at a time until either the step duration has
+// elapsed or the maximum number of operations has been issued.
+type benchRunner struct {
+	coh      C.daos_handle_t
+	duration time.Duration
+	maxOps   uint64
+	oidHi    uint64
+}
+
+// runLoop calls op with increasing indices until the step limits are
+// reached, or until limit operations have been issued if limit is non-zero.
+func (br *benchRunner) runLoop(limit uint64, op func(i uint64) error) (time.Duration, []time.Duration, error) {
+	var latencies []time.Duration
+	start := time.Now()
+	for i := uint64(0); i < br.maxOps && (limit == 0 || i < limit); i++ {
+		if time.Since(start) >= br.duration {
+			break
+		}
+		opStart := time.Now()
+		if err := op(i); err != nil {
+			return time.Since(start), latencies, err
+		}
+		latencies = append(latencies, time.Since(opStart))
+	}
+	return time.Since(start), latencies, nil
+}
+
+// run runs the benchmark steps for the given object class and I/O size on
+// a new object. Each operation targets a single value under its own dkey.
+func (br *benchRunner) run(oclass ObjClassFlag, ioSize uint64) ([]*benchStep, error) {
+	br.oidHi++
+	oid := makeOid(br.oidHi, 1)
+	rc := C.daos_obj_generate_oid2(br.coh, &oid, C.DAOS_OT_MULTI_HASHED, C.daos_oclass_id_t(oclass.Class), 0, 0)
+	if err := daosError(rc); err != nil {
+		return nil, errors.Wrapf(err, "failed to generate %s object ID", oclass.String())
+	}
+
+	var oh C.daos_handle_t
+	if err := daosError(C.daos_obj_open(br.coh, oid, C.DAOS_OO_RW, &oh, nil)); err != nil {
+		return nil, errors.Wrapf(err, "failed to open object %s", oidString(oid))
+	}
+	defer C.daos_obj_close(oh, nil)
+
+	// The dkey is the operation index, updated in place.
+	dkey := newObjKey(make(objKey, 8))
+	defer freeObjKey(dkey)
+	setDkey := func(i uint64) {
+		*(*uint64)(dkey.iov_buf) = i
+	}
+
+	akey := newObjKey(objKey("bench"))
+	defer freeObjKey(akey)
+	iod := (*C.daos_iod_t)(C.calloc(1, C.sizeof_daos_iod_t))
+	defer C.free(unsafe.Pointer(iod))
+	iod.iod_name = *akey
+	iod.iod_type = C.DAOS_IOD_SINGLE
+	iod.iod_size = C.daos_size_t(ioSize)
+	iod.iod_nr = 1
+
+	ob := newObjBuf(C.size_t(ioSize))
+	defer ob.free()
+	C.memset(ob.buf, 0xa5, C.size_t(ioSize))
+
+	var th C.daos_handle_t
+	var steps []*benchStep
+	stepErr := func(op string, err error) error {
+		return errors.Wrapf(err, "%s %s/%s failed", op, oclass.String(), humanize.IBytes(ioSize))
+	}
+
+	elapsed, latencies, err := br.runLoop(0, func(i uint64) error {
+		setDkey(i)
+		ob.reset(C.size_t(ioSize))
+		return daosError(C.daos_obj_update(oh, th, 0, dkey, 1, iod, ob.sgl, nil))
+	})
+	if err != nil {
+		return nil, stepErr(benchOpWrite, err)
+	}
+	written := uint64(len(latencies))
+	steps = append(steps, newBenchStep(benchOpWrite, oclass.String(), ioSize, elapsed, latencies))
+	if written == 0 {
+		return steps, nil
+	}
+
+	elapsed, latencies, err = br.runLoop(0, func(i uint64) error {
+		setDkey(i % written)
+		ob.reset(C.size_t(ioSize))
+		return daosError(C.daos_obj_fetch(oh, th, 0, dkey, 1, iod, ob.sgl, nil, nil))
+	})
+	if err != nil {
+		return nil, stepErr(benchOpRead, err)
+	}
+	steps = append(steps, newBenchStep(benchOpRead, oclass.String(), ioSize, elapsed, latencies))
+
+	elapsed, latencies, err = br.runLoop(written, func(i uint64) error {
+		setDkey(i)
+		return daosError(C.daos_obj_punch_dkeys(oh, th, 0, 1, dkey, nil))
+	})
+	if err != nil {
+		return nil, stepErr(benchOpPunch, err)
+	}
+	steps = append(steps, newBenchStep(benchOpPunch, oclass.String(), ioSize, elapsed, latencies))
+
+	return steps, nil
+}
+
+type poolBenchFlags struct {
+	Bench         bool          `long:"bench" description:"run performance benchmarks instead of smoke tests"`
+	BenchOclass   string        `long:"bench-oclass" default:"S1,SX" description:"comma-separated list of object classes to benchmark"`
+	BenchSize     string        `long:"bench-size" default:"4KiB,1MiB" description:"comma-separated list of I/O sizes to benchmark"`
+	BenchDuration time.Duration `long:"bench-duration" default:"5s" description:"maximum duration of each benchmark step"`
+	BenchMaxOps   uint64        `long:"bench-max-ops" default:"100000" description:"maximum number of operations in each benchmark step"`
+	BenchBaseline string        `long:"bench-baseline" description:"JSON file with performance thresholds to compare the results against"`
+}
+
+func (cmd *poolAutoTestCmd) runBench() error {
+	var oclasses []ObjClassFlag
+	for _, name := range strings.Split(cmd.BenchOclass, ",") {
+		if name = strings.TrimSpace(name); name == "" {
+			continue
+		}
+		var oclass ObjClassFlag
+		if err := oclass.UnmarshalFlag(name); err != nil {
+			return err
+		}
+		oclasses = append(oclasses, oclass)
+	}
+	if len(oclasses) == 0 {
+		return errors.New("no benchmark object classes")
+	}
+
+	sizes, err := parseBenchSizes(cmd.BenchSize)
+	if err != nil {
+		return err
+	}
+	if cmd.BenchDuration <= 0 {
+		return errors.New("benchmark duration must be positive")
+	}
+	if cmd.BenchMaxOps == 0 {
+		return errors.New("benchmark maximum operations must be positive")
+	}
+
+	var baseline *benchBaseline
+	if cmd.BenchBaseline != "" {
+		if baseline, err = readBenchBaseline(cmd.BenchBaseline); err != nil {
+			return err
+		}
+	}
+
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_PC_RW, nil)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	label := "autotest_bench_" + strings.Split(uuid.New().String(), "-")[0]
+	cLabel := C.CString(label)
+	defer freeString(cLabel)
+	if err := daosError(C.daos_cont_create_with_label(cmd.cPoolHandle, cLabel, nil, nil, nil)); err != nil {
+		return errors.Wrapf(err, "failed to create benchmark container in pool %s", cmd.PoolID())
+	}
+	defer func() {
+		if err := daosError(C.daos_cont_destroy(cmd.cPoolHandle, cLabel, 1, nil)); err != nil {
+			cmd.Errorf("failed to destroy benchmark container %s: %s", label, err)
+		}
+	}()
+
+	var coh C.daos_handle_t
+	if err := daosError(C.daos_cont_open(cmd.cPoolHandle, cLabel, C.DAOS_COO_RW, &coh, nil, nil)); err != nil {
+		return errors.Wrapf(err, "failed to open benchmark container %s", label)
+	}
+	defer func() {
+		if err := daosError(C.daos_cont_close(coh, nil)); err != nil {
+			cmd.Errorf("failed to close benchmark container %s: %s", label, err)
+		}
+	}()
+
+	runner := &benchRunner{
+		coh:      coh,
+		duration: cmd.BenchDuration,
+		maxOps:   cmd.BenchMaxOps,
+	}
+	steps := []*benchStep{}
+	for _, oclass := range oclasses {
+		for _, size := range sizes {
+			cmd.Debugf("benchmarking %s with %s I/O", oclass.String(), humanize.IBytes(size))
+			cellSteps, err := runner.run(oclass, size)
+			if err != nil {
+				return err
+			}
+			steps = append(steps, cellSteps...)
+		}
+	}
+
+	var benchErr error
+	if baseline != nil {
+		if nr := baseline.check(steps); nr > 0 {
+			benchErr = errors.Errorf("%d of %d benchmark steps did not meet the baseline in %s",
+				nr, len(steps), cmd.BenchBaseline)
+		}
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(steps, benchErr)
+	}
+
+	var bld strings.Builder
+	printBenchSteps(&bld, steps)
+	cmd.Info(bld.String())
+
+	return benchErr
+}
@@ -0,0 +1,237 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestDaos_newBenchStep(t *testing.T) {
+	latencies := make([]time.Duration, 100)
+	for i := range latencies {
+		// Reverse order to check that percentiles are computed on
+		// sorted latencies.
+		latencies[i] = time.Duration(100-i) * time.Microsecond
+	}
+
+	for name, tc := range map[string]struct {
+		op        string
+		latencies []time.Duration
+		expStep   *benchStep
+	}{
+		"no ops": {
+			op: benchOpWrite,
+			expStep: &benchStep{
+				Op:       benchOpWrite,
+				ObjClass: "SX",
+				IOSize:   1 << 20,
+				Seconds:  2,
+			},
+		},
+		"write": {
+			op:        benchOpWrite,
+			latencies: latencies,
+			expStep: &benchStep{
+				Op:        benchOpWrite,
+				ObjClass:  "SX",
+				IOSize:    1 << 20,
+				Ops:       100,
+				Seconds:   2,
+				Bandwidth: 50,
+				IOPS:      50,
+				Latency: benchLatency{
+					P50: 50,
+					P90: 90,
+					P99: 99,
+					Max: 100,
+				},
+			},
+		},
+		"punch": {
+			op:        benchOpPunch,
+			latencies: latencies[:10],
+			expStep: &benchStep{
+				Op:       benchOpPunch,
+				ObjClass: "SX",
+				IOSize:   1 << 20,
+				Ops:      10,
+				Seconds:  2,
+				IOPS:     5,
+				Latency: benchLatency{
+					P50: 95,
+					P90: 99,
+					P99: 100,
+					Max: 100,
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotStep := newBenchStep(tc.op, "SX", 1<<20, 2*time.Second, tc.latencies)
+			if diff := cmp.Diff(tc.expStep, gotStep); diff != "" {
+				t.Fatalf("unexpected step (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_parseBenchBaseline(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in          string
+		expBaseline *benchBaseline
+		expErr      error
+	}{
+		"empty": {
+			expErr: errors.New("failed to parse"),
+		},
+		"unknown field": {
+			in:     `{"thresholds":[{"op":"write","min_bw":1}]}`,
+			expErr: errors.New("unknown field"),
+		},
+		"invalid op": {
+			in:     `{"thresholds":[{"op":"delete","min_iops":1}]}`,
+			expErr: errors.New("invalid op"),
+		},
+		"no thresholds": {
+			in:     `{"thresholds":[{"op":"read","oclass":"SX"}]}`,
+			expErr: errors.New("no thresholds set"),
+		},
+		"negative threshold": {
+			in:     `{"thresholds":[{"op":"read","min_iops":-1}]}`,
+			expErr: errors.New("must not be negative"),
+		},
+		"valid": {
+			in: `{"thresholds":[
+				{"op":"write","oclass":"SX","io_size":1048576,"min_bandwidth_mib":100},
+				{"op":"punch","max_latency_p99_us":500}
+			]}`,
+			expBaseline: &benchBaseline{
+				Thresholds: []*benchThreshold{
+					{Op: benchOpWrite, ObjClass: "SX", IOSize: 1 << 20, MinBandwidth: 100},
+					{Op: benchOpPunch, MaxLatencyP99: 500},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotBaseline, gotErr := parseBenchBaseline([]byte(tc.in))
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expBaseline, gotBaseline); diff != "" {
+				t.Fatalf("unexpected baseline (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_benchBaseline_Check(t *testing.T) {
+	newSteps := func() []*benchStep {
+		return []*benchStep{
+			{Op: benchOpWrite, ObjClass: "S1", IOSize: 4096, Bandwidth: 10, IOPS: 2560, Latency: benchLatency{P99: 800}},
+			{Op: benchOpWrite, ObjClass: "SX", IOSize: 1 << 20, Bandwidth: 900, IOPS: 900, Latency: benchLatency{P99: 2000}},
+			{Op: benchOpRead, ObjClass: "SX", IOSize: 1 << 20, Bandwidth: 1200, IOPS: 1200, Latency: benchLatency{P99: 1500}},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		thresholds     []*benchThreshold
+		expNrRegressed int
+		expRegressions [][]string
+	}{
+		"no thresholds": {
+			expRegressions: [][]string{nil, nil, nil},
+		},
+		"all met": {
+			thresholds: []*benchThreshold{
+				{Op: benchOpWrite, MinIOPS: 100},
+				{Op: benchOpRead, ObjClass: "sx", IOSize: 1 << 20, MinBandwidth: 1000},
+			},
+			expRegressions: [][]string{nil, nil, nil},
+		},
+		"regressions": {
+			thresholds: []*benchThreshold{
+				{Op: benchOpWrite, MinIOPS: 1000, MaxLatencyP99: 1000},
+				{Op: benchOpWrite, ObjClass: "SX", MinBandwidth: 1000},
+				{Op: benchOpRead, IOSize: 4096, MinIOPS: 1e6},
+			},
+			expNrRegressed: 1,
+			expRegressions: [][]string{
+				nil,
+				{
+					"IOPS 900.00 < 1000.00",
+					"p99 latency 2000.00us > 1000.00us",
+					"bandwidth 900.00 MiB/s < 1000.00 MiB/s",
+				},
+				nil,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			steps := newSteps()
+			baseline := &benchBaseline{Thresholds: tc.thresholds}
+
+			test.AssertEqual(t, tc.expNrRegressed, baseline.check(steps), "unexpected number of regressed steps")
+
+			var gotRegressions [][]string
+			for _, step := range steps {
+				gotRegressions = append(gotRegressions, step.Regressions)
+			}
+			if diff := cmp.Diff(tc.expRegressions, gotRegressions); diff != "" {
+				t.Fatalf("unexpected regressions (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_parseBenchSizes(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in       string
+		expSizes []uint64
+		expErr   error
+	}{
+		"empty": {
+			expErr: errors.New("no benchmark I/O sizes"),
+		},
+		"valid": {
+			in:       "4KiB, 1MiB,,128",
+			expSizes: []uint64{4096, 1 << 20, 128},
+		},
+		"invalid": {
+			in:     "4KiB,big",
+			expErr: errors.New("invalid benchmark I/O size \"big\""),
+		},
+		"zero": {
+			in:     "0",
+			expErr: errors.New("must be between"),
+		},
+		"too big": {
+			in:     "1GiB",
+			expErr: errors.New("must be between"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotSizes, gotErr := parseBenchSizes(tc.in)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expSizes, gotSizes); diff != "" {
+				t.Fatalf("unexpected sizes (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
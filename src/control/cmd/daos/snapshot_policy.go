@@ -0,0 +1,519 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+)
+
+/*
+#include "util.h"
+*/
+import "C"
+
+const (
+	// snapPolicyAttr is the container attribute used to store the
+	// container's snapshot policy.
+	snapPolicyAttr = "daos.snap_policy"
+
+	defSnapPolicyNameTemplate = "auto-%Y%m%d-%H%M%S"
+	minSnapPolicyInterval     = time.Minute
+)
+
+// snapPolicyTokens maps the time tokens supported in a snapshot name
+// template to their Go time layouts.
+var snapPolicyTokens = map[byte]string{
+	'Y': "2006",
+	'm': "01",
+	'd': "02",
+	'H': "15",
+	'M': "04",
+	'S': "05",
+}
+
+// snapPolicy defines how snapshots of a container are created and retained.
+// Snapshots created by the policy are identified by the name template; other
+// snapshots are never pruned.
+type snapPolicy struct {
+	Interval     time.Duration `json:"-"`
+	KeepLast     uint          `json:"keep_last,omitempty"`
+	KeepDaily    uint          `json:"keep_daily,omitempty"`
+	KeepWeekly   uint          `json:"keep_weekly,omitempty"`
+	NameTemplate string        `json:"name_template"`
+}
+
+func (p *snapPolicy) MarshalJSON() ([]byte, error) {
+	type toJSON snapPolicy
+	return json.Marshal(&struct {
+		Interval string `json:"interval"`
+		*toJSON
+	}{
+		Interval: p.Interval.String(),
+		toJSON:   (*toJSON)(p),
+	})
+}
+
+func (p *snapPolicy) UnmarshalJSON(data []byte) error {
+	type fromJSON snapPolicy
+	from := &struct {
+		Interval string `json:"interval"`
+		*fromJSON
+	}{
+		fromJSON: (*fromJSON)(p),
+	}
+	if err := json.Unmarshal(data, from); err != nil {
+		return err
+	}
+
+	interval, err := time.ParseDuration(from.Interval)
+	if err != nil {
+		return errors.Wrap(err, "invalid snapshot policy interval")
+	}
+	p.Interval = interval
+
+	return nil
+}
+
+func (p *snapPolicy) String() string {
+	retention := []string{}
+	for _, r := range []struct {
+		name string
+		nr   uint
+	}{
+		{"last", p.KeepLast},
+		{"daily", p.KeepDaily},
+		{"weekly", p.KeepWeekly},
+	} {
+		if r.nr > 0 {
+			retention = append(retention, fmt.Sprintf("%s=%d", r.name, r.nr))
+		}
+	}
+	if len(retention) == 0 {
+		retention = append(retention, "all")
+	}
+
+	return fmt.Sprintf("interval=%s keep=%s name=%s", p.Interval,
+		strings.Join(retention, ","), p.NameTemplate)
+}
+
+func (p *snapPolicy) validate() error {
+	if p.Interval < minSnapPolicyInterval {
+		return errors.Errorf("snapshot policy interval must be at least %s", minSnapPolicyInterval)
+	}
+
+	var seen []byte
+	for i := 0; i < len(p.NameTemplate); i++ {
+		if p.NameTemplate[i] != '%' {
+			continue
+		}
+		i++
+		if i == len(p.NameTemplate) {
+			return errors.Errorf("snapshot name template %q ends with %%", p.NameTemplate)
+		}
+		if p.NameTemplate[i] == '%' {
+			continue
+		}
+		if _, found := snapPolicyTokens[p.NameTemplate[i]]; !found {
+			return errors.Errorf("unknown token %%%c in snapshot name template", p.NameTemplate[i])
+		}
+		seen = append(seen, p.NameTemplate[i])
+	}
+
+	// Ensure that names are unique for snapshots taken at the minimum
+	// interval.
+	for _, tok := range []byte("YmdHM") {
+		if !strings.ContainsRune(string(seen), rune(tok)) {
+			return errors.Errorf("snapshot name template %q must include %%Y, %%m, %%d, %%H and %%M",
+				p.NameTemplate)
+		}
+	}
+
+	return nil
+}
+
+// expandTemplate walks the name template, calling literal for each run of
+// literal text and token for each time token.
+func (p *snapPolicy) expandTemplate(literal func(string), token func(byte)) {
+	var lit strings.Builder
+	for i := 0; i < len(p.NameTemplate); i++ {
+		c := p.NameTemplate[i]
+		if c != '%' || i+1 == len(p.NameTemplate) {
+			lit.WriteByte(c)
+			continue
+		}
+		i++
+		if p.NameTemplate[i] == '%' {
+			lit.WriteByte('%')
+			continue
+		}
+		if lit.Len() > 0 {
+			literal(lit.String())
+			lit.Reset()
+		}
+		token(p.NameTemplate[i])
+	}
+	if lit.Len() > 0 {
+		literal(lit.String())
+	}
+}
+
+// snapName returns the name of a policy snapshot taken at the given time.
+func (p *snapPolicy) snapName(t time.Time) string {
+	var bld strings.Builder
+	p.expandTemplate(
+		func(lit string) { bld.WriteString(lit) },
+		func(tok byte) { bld.WriteString(t.UTC().Format(snapPolicyTokens[tok])) },
+	)
+	return bld.String()
+}
+
+// nameRegexp returns a regular expression matching the names of snapshots
+// created by the policy.
+func (p *snapPolicy) nameRegexp() *regexp.Regexp {
+	var bld strings.Builder
+	bld.WriteString("^")
+	p.expandTemplate(
+		func(lit string) { bld.WriteString(regexp.QuoteMeta(lit)) },
+		func(tok byte) { fmt.Fprintf(&bld, `\d{%d}`, len(snapPolicyTokens[tok])) },
+	)
+	bld.WriteString("$")
+	return regexp.MustCompile(bld.String())
+}
+
+// managedSnapshots returns the snapshots created by the policy.
+func (p *snapPolicy) managedSnapshots(snaps []*snapshot) []*snapshot {
+	re := p.nameRegexp()
+
+	var managed []*snapshot
+	for _, snap := range snaps {
+		if re.MatchString(snap.Name) {
+			managed = append(managed, snap)
+		}
+	}
+	return managed
+}
+
+// sortSnapshots sorts snapshots from newest to oldest.
+func sortSnapshots(snaps []*snapshot) {
+	sort.Slice(snaps, func(i, j int) bool {
+		return snaps[i].Epoch > snaps[j].Epoch
+	})
+}
+
+// snapsToPrune returns the policy snapshots that are not retained by the
+// policy, oldest first. The newest KeepLast snapshots are kept, along with
+// the newest snapshot of each of the last KeepDaily days and KeepWeekly ISO
+// weeks (UTC) in which a snapshot was taken. If no retention is set, all
+// snapshots are kept.
+func (p *snapPolicy) snapsToPrune(snaps []*snapshot, snapTime func(*snapshot) time.Time) []*snapshot {
+	if p.KeepLast == 0 && p.KeepDaily == 0 && p.KeepWeekly == 0 {
+		return nil
+	}
+
+	sorted := make([]*snapshot, len(snaps))
+	copy(sorted, snaps)
+	sortSnapshots(sorted)
+
+	keep := make(map[*snapshot]bool)
+	for i := 0; i < int(p.KeepLast) && i < len(sorted); i++ {
+		keep[sorted[i]] = true
+	}
+
+	keepPeriods := func(nr uint, period func(time.Time) string) {
+		seen := make(map[string]bool)
+		for _, snap := range sorted {
+			if uint(len(seen)) >= nr {
+				return
+			}
+			key := period(snapTime(snap).UTC())
+			if !seen[key] {
+				seen[key] = true
+				keep[snap] = true
+			}
+		}
+	}
+	keepPeriods(p.KeepDaily, func(t time.Time) string {
+		return t.Format("2006-01-02")
+	})
+	keepPeriods(p.KeepWeekly, func(t time.Time) string {
+		year, week := t.ISOWeek()
+		return fmt.Sprintf("%d-%02d", year, week)
+	})
+
+	var prune []*snapshot
+	for i := len(sorted) - 1; i >= 0; i-- {
+		if !keep[sorted[i]] {
+			prune = append(prune, sorted[i])
+		}
+	}
+	return prune
+}
+
+// nextDue returns the time at which the next policy snapshot is due, given
+// the policy's existing snapshots.
+func (p *snapPolicy) nextDue(managed []*snapshot, snapTime func(*snapshot) time.Time) time.Time {
+	var latest time.Time
+	for _, snap := range managed {
+		if t := snapTime(snap); t.After(latest) {
+			latest = t
+		}
+	}
+	if latest.IsZero() {
+		return latest
+	}
+	return latest.Add(p.Interval)
+}
+
+func hlcSnapTime(snap *snapshot) time.Time {
+	return daos.HLC(snap.Epoch).ToTime()
+}
+
+func getSnapPolicy(hdl C.daos_handle_t) (*snapPolicy, error) {
+	attr, err := getDaosAttribute(hdl, contAttr, snapPolicyAttr)
+	if err != nil {
+		if errors.Cause(err) == daos.Nonexistent {
+			return nil, errors.New("no snapshot policy is set")
+		}
+		return nil, errors.Wrap(err, "failed to get snapshot policy")
+	}
+
+	policy := new(snapPolicy)
+	if err := json.Unmarshal(attr.Value, policy); err != nil {
+		return nil, errors.Wrapf(err, "invalid snapshot policy in attribute %q", snapPolicyAttr)
+	}
+	if err := policy.validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid snapshot policy in attribute %q", snapPolicyAttr)
+	}
+
+	return policy, nil
+}
+
+type containerSnapPolicyCmd struct {
+	Set    containerSnapPolicySetCmd    `command:"set" description:"set a container's snapshot policy"`
+	Show   containerSnapPolicyShowCmd   `command:"show" description:"show a container's snapshot policy and policy snapshots"`
+	RunNow containerSnapPolicyRunNowCmd `command:"run-now" description:"create a policy snapshot and prune expired policy snapshots"`
+}
+
+type containerSnapPolicySetCmd struct {
+	existingContainerCmd
+
+	Interval     time.Duration `long:"interval" short:"i" required:"1" description:"time between policy snapshots (e.g. 30m, 6h)"`
+	KeepLast     uint          `long:"keep-last" description:"number of most recent policy snapshots to keep"`
+	KeepDaily    uint          `long:"keep-daily" description:"number of days for which to keep the last policy snapshot of the day"`
+	KeepWeekly   uint          `long:"keep-weekly" description:"number of weeks for which to keep the last policy snapshot of the week"`
+	NameTemplate string        `long:"name-template" default:"auto-%Y%m%d-%H%M%S" description:"policy snapshot name template (UTC; %Y, %m, %d, %H, %M and %S are replaced by the snapshot time)"`
+}
+
+func (cmd *containerSnapPolicySetCmd) Execute(_ []string) error {
+	policy := &snapPolicy{
+		Interval:     cmd.Interval,
+		KeepLast:     cmd.KeepLast,
+		KeepDaily:    cmd.KeepDaily,
+		KeepWeekly:   cmd.KeepWeekly,
+		NameTemplate: cmd.NameTemplate,
+	}
+	if err := policy.validate(); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+
+	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
+	if err != nil {
+		return err
+	}
+	defer deallocCmdArgs()
+
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_COO_RW, ap)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	if err := setDaosAttribute(cmd.cContHandle, contAttr, &attribute{
+		Name:  snapPolicyAttr,
+		Value: data,
+	}); err != nil {
+		return errors.Wrapf(err, "failed to set snapshot policy on container %s", cmd.ContainerID())
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(policy, nil)
+	}
+
+	cmd.Infof("Snapshot policy for container %s set to: %s", cmd.ContainerID(), policy)
+	return nil
+}
+
+type containerSnapPolicyShowCmd struct {
+	existingContainerCmd
+}
+
+func (cmd *containerSnapPolicyShowCmd) Execute(_ []string) error {
+	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
+	if err != nil {
+		return err
+	}
+	defer deallocCmdArgs()
+
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_COO_RO, ap)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	policy, err := getSnapPolicy(cmd.cContHandle)
+	if err != nil {
+		return errors.Wrapf(err, "container %s", cmd.ContainerID())
+	}
+
+	snaps, err := listContainerSnapshots(ap, cmd.ContainerID().String())
+	if err != nil {
+		return err
+	}
+	managed := policy.managedSnapshots(snaps)
+	sortSnapshots(managed)
+
+	var nextDue string
+	if due := policy.nextDue(managed, hlcSnapTime); !due.IsZero() {
+		nextDue = common.FormatTime(due)
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(struct {
+			Policy    *snapPolicy `json:"policy"`
+			Snapshots []*snapshot `json:"snapshots"`
+			NextDue   string      `json:"next_due,omitempty"`
+		}{
+			Policy:    policy,
+			Snapshots: managed,
+			NextDue:   nextDue,
+		}, nil)
+	}
+
+	var bld strings.Builder
+	fmt.Fprintf(&bld, "Snapshot policy for container %s:\n", cmd.ContainerID())
+	fmt.Fprintf(&bld, "  Interval: %s\n", policy.Interval)
+	fmt.Fprintf(&bld, "  Keep last: %d daily: %d weekly: %d\n", policy.KeepLast, policy.KeepDaily, policy.KeepWeekly)
+	fmt.Fprintf(&bld, "  Name template: %s\n", policy.NameTemplate)
+	if nextDue == "" {
+		fmt.Fprintf(&bld, "  Next snapshot due: now\n")
+	} else {
+		fmt.Fprintf(&bld, "  Next snapshot due: %s\n", nextDue)
+	}
+	fmt.Fprintf(&bld, "Policy snapshots (%d):\n", len(managed))
+	for _, snap := range managed {
+		fmt.Fprintf(&bld, "  0x%x %s %s\n", snap.Epoch, snap.Timestamp, snap.Name)
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+type containerSnapPolicyRunNowCmd struct {
+	existingContainerCmd
+
+	IfDue  bool `long:"if-due" description:"only create a snapshot if the policy interval has elapsed since the last policy snapshot"`
+	DryRun bool `long:"dry-run" short:"n" description:"show the actions that would be taken without taking them"`
+}
+
+type snapPolicyRunResult struct {
+	Created *snapshot   `json:"created,omitempty"`
+	Pruned  []*snapshot `json:"pruned"`
+}
+
+func (cmd *containerSnapPolicyRunNowCmd) Execute(_ []string) error {
+	ap, deallocCmdArgs, err := allocCmdArgs(cmd.Logger)
+	if err != nil {
+		return err
+	}
+	defer deallocCmdArgs()
+
+	cleanup, err := cmd.resolveAndConnect(C.DAOS_COO_RW, ap)
+	if err != nil {
+		return err
+	}
+	defer cleanup()
+
+	policy, err := getSnapPolicy(cmd.cContHandle)
+	if err != nil {
+		return errors.Wrapf(err, "container %s", cmd.ContainerID())
+	}
+
+	snaps, err := listContainerSnapshots(ap, cmd.ContainerID().String())
+	if err != nil {
+		return err
+	}
+	managed := policy.managedSnapshots(snaps)
+
+	result := &snapPolicyRunResult{Pruned: []*snapshot{}}
+	now := time.Now()
+	if due := policy.nextDue(managed, hlcSnapTime); cmd.IfDue && now.Before(due) {
+		cmd.Infof("No snapshot of container %s is due until %s", cmd.ContainerID(), common.FormatTime(due))
+	} else {
+		snap := &snapshot{
+			Name:      policy.snapName(now),
+			Timestamp: common.FormatTime(now),
+		}
+		if !cmd.DryRun {
+			cSnapName := C.CString(snap.Name)
+			defer freeString(cSnapName)
+
+			var cEpoch C.uint64_t
+			if err := daosError(C.daos_cont_create_snap(ap.cont, &cEpoch, cSnapName, nil)); err != nil {
+				return errors.Wrapf(err, "failed to create snapshot of container %s", cmd.ContainerID())
+			}
+			snap.Epoch = uint64(cEpoch)
+			snap.Timestamp = common.FormatTime(hlcSnapTime(snap))
+		}
+		result.Created = snap
+		managed = append(managed, snap)
+	}
+
+	for _, snap := range policy.snapsToPrune(managed, hlcSnapTime) {
+		if !cmd.DryRun {
+			var epr C.daos_epoch_range_t
+			epr.epr_lo = C.uint64_t(snap.Epoch)
+			epr.epr_hi = epr.epr_lo
+			if err := daosError(C.daos_cont_destroy_snap(ap.cont, epr, nil)); err != nil {
+				return errors.Wrapf(err, "failed to destroy snapshot %s of container %s",
+					snap.Name, cmd.ContainerID())
+			}
+		}
+		result.Pruned = append(result.Pruned, snap)
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(result, nil)
+	}
+
+	created, destroyed := "Created", "Destroyed"
+	if cmd.DryRun {
+		created, destroyed = "Would create", "Would destroy"
+	}
+	if result.Created != nil {
+		cmd.Infof("%s snapshot %s", created, result.Created.Name)
+	}
+	for _, snap := range result.Pruned {
+		cmd.Infof("%s snapshot 0x%x %s", destroyed, snap.Epoch, snap.Name)
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestDaos_snapPolicy_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		policy snapPolicy
+		expErr error
+	}{
+		"default template": {
+			policy: snapPolicy{Interval: time.Hour, NameTemplate: defSnapPolicyNameTemplate},
+		},
+		"interval too short": {
+			policy: snapPolicy{Interval: time.Second, NameTemplate: defSnapPolicyNameTemplate},
+			expErr: errors.New("at least 1m0s"),
+		},
+		"escaped percent": {
+			policy: snapPolicy{Interval: time.Hour, NameTemplate: "100%%-%Y%m%d%H%M"},
+		},
+		"unknown token": {
+			policy: snapPolicy{Interval: time.Hour, NameTemplate: "%Y%m%d%H%M-%j"},
+			expErr: errors.New("unknown token %j"),
+		},
+		"trailing percent": {
+			policy: snapPolicy{Interval: time.Hour, NameTemplate: "%Y%m%d%H%M%"},
+			expErr: errors.New("ends with %"),
+		},
+		"not unique": {
+			policy: snapPolicy{Interval: time.Hour, NameTemplate: "daily-%Y%m%d"},
+			expErr: errors.New("must include"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.policy.validate())
+		})
+	}
+}
+
+func TestDaos_snapPolicy_Names(t *testing.T) {
+	policy := &snapPolicy{
+		Interval:     time.Hour,
+		NameTemplate: "nightly.%Y-%m-%d_%H%M%S (100%%)",
+	}
+	ts := time.Date(2023, 3, 7, 4, 5, 6, 0, time.UTC)
+
+	test.AssertEqual(t, "nightly.2023-03-07_040506 (100%)", policy.snapName(ts), "unexpected name")
+	test.AssertEqual(t, "nightly.2023-03-07_040506 (100%)",
+		policy.snapName(ts.In(time.FixedZone("x", 3600))), "name not in UTC")
+
+	snaps := []*snapshot{
+		{Epoch: 1, Name: policy.snapName(ts)},
+		{Epoch: 2, Name: "nightly.2023-03-07_040506 (100%) copy"},
+		{Epoch: 3, Name: "nightlyx2023-03-07_040506 (100%)"},
+		{Epoch: 4},
+		{Epoch: 5, Name: policy.snapName(ts.Add(time.Hour))},
+	}
+	gotEpochs := []uint64{}
+	for _, snap := range policy.managedSnapshots(snaps) {
+		gotEpochs = append(gotEpochs, snap.Epoch)
+	}
+	if diff := cmp.Diff([]uint64{1, 5}, gotEpochs); diff != "" {
+		t.Fatalf("unexpected managed snapshots (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestDaos_snapPolicy_JSON(t *testing.T) {
+	policy := &snapPolicy{
+		Interval:     90 * time.Minute,
+		KeepLast:     3,
+		NameTemplate: defSnapPolicyNameTemplate,
+	}
+
+	data, err := json.Marshal(policy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, `{"interval":"1h30m0s","keep_last":3,"name_template":"auto-%Y%m%d-%H%M%S"}`,
+		string(data), "unexpected JSON")
+
+	got := new(snapPolicy)
+	if err := json.Unmarshal(data, got); err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff(policy, got); diff != "" {
+		t.Fatalf("unexpected policy (-want, +got):\n%s\n", diff)
+	}
+
+	test.CmpErr(t, errors.New("invalid snapshot policy interval"),
+		json.Unmarshal([]byte(`{"interval":"often"}`), got))
+}
+
+func TestDaos_snapPolicy_SnapsToPrune(t *testing.T) {
+	// Snapshots every 12 hours, from Monday 2023-03-06 00:00 to
+	// Tuesday 2023-03-21 12:00 (UTC). The epoch is the hour offset.
+	start := time.Date(2023, 3, 6, 0, 0, 0, 0, time.UTC)
+	var snaps []*snapshot
+	for h := 0; h <= 15*24+12; h += 12 {
+		snaps = append(snaps, &snapshot{Epoch: uint64(h)})
+	}
+	snapTime := func(snap *snapshot) time.Time {
+		return start.Add(time.Duration(snap.Epoch) * time.Hour)
+	}
+	allBut := func(keep ...uint64) []uint64 {
+		kept := make(map[uint64]bool)
+		for _, epoch := range keep {
+			kept[epoch] = true
+		}
+		var epochs []uint64
+		for _, snap := range snaps {
+			if !kept[snap.Epoch] {
+				epochs = append(epochs, snap.Epoch)
+			}
+		}
+		return epochs
+	}
+
+	for name, tc := range map[string]struct {
+		policy    snapPolicy
+		expPruned []uint64
+	}{
+		"no retention": {},
+		"keep last": {
+			policy:    snapPolicy{KeepLast: 2},
+			expPruned: allBut(372, 360),
+		},
+		"keep more than exist": {
+			policy: snapPolicy{KeepLast: 100},
+		},
+		"keep daily": {
+			policy:    snapPolicy{KeepDaily: 3},
+			expPruned: allBut(372, 348, 324),
+		},
+		"keep weekly": {
+			policy:    snapPolicy{KeepWeekly: 2},
+			expPruned: allBut(372, 324),
+		},
+		"combined": {
+			policy:    snapPolicy{KeepLast: 1, KeepDaily: 2, KeepWeekly: 3},
+			expPruned: allBut(372, 348, 324, 156),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotPruned []uint64
+			for _, snap := range tc.policy.snapsToPrune(snaps, snapTime) {
+				gotPruned = append(gotPruned, snap.Epoch)
+			}
+			if diff := cmp.Diff(tc.expPruned, gotPruned); diff != "" {
+				t.Fatalf("unexpected pruned snapshots (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestDaos_snapPolicy_NextDue(t *testing.T) {
+	start := time.Date(2023, 3, 6, 0, 0, 0, 0, time.UTC)
+	snapTime := func(snap *snapshot) time.Time {
+		return start.Add(time.Duration(snap.Epoch) * time.Hour)
+	}
+	policy := &snapPolicy{Interval: 6 * time.Hour}
+
+	test.AssertTrue(t, policy.nextDue(nil, snapTime).IsZero(), "expected zero time with no snapshots")
+	test.AssertEqual(t, start.Add(8*time.Hour),
+		policy.nextDue([]*snapshot{{Epoch: 2}, {Epoch: 1}}, snapTime), "unexpected next due time")
+}
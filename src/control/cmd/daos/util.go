@@ -9,13 +9,17 @@ package main
 import (
 	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"os"
+	"path/filepath"
+	"strings"
 	"unsafe"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
 
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
@@ -310,3 +314,67 @@ func _writeDunsPath(path, ct string, poolUUID uuid.UUID, contUUID uuid.UUID) err
 
 	return nil
 }
+
+const (
+	fileFormatJSON = "json"
+	fileFormatYAML = "yaml"
+)
+
+// resolveFileFormat resolves the format of a structured output file. If
+// no format is specified, JSON is used for files with a .json extension
+// and YAML otherwise.
+func resolveFileFormat(format, path string) (string, error) {
+	switch strings.ToLower(format) {
+	case "":
+		if strings.EqualFold(filepath.Ext(path), ".json") {
+			return fileFormatJSON, nil
+		}
+		return fileFormatYAML, nil
+	case fileFormatJSON:
+		return fileFormatJSON, nil
+	case fileFormatYAML, "yml":
+		return fileFormatYAML, nil
+	default:
+		return "", errors.Errorf("invalid file format %q (must be %s or %s)",
+			format, fileFormatJSON, fileFormatYAML)
+	}
+}
+
+// writeFileFormat writes the value to the output in the given format.
+func writeFileFormat(out io.Writer, format string, in interface{}) error {
+	var data []byte
+	var err error
+	switch format {
+	case fileFormatJSON:
+		data, err = json.MarshalIndent(in, "", "  ")
+		data = append(data, '\n')
+	default:
+		data, err = yaml.Marshal(in)
+	}
+	if err != nil {
+		return err
+	}
+
+	_, err = out.Write(data)
+	return err
+}
+
+// readInputFile reads the contents of a command input file. A path of "-"
+// reads from stdin.
+func readInputFile(path string) ([]byte, error) {
+	if path == "-" {
+		return io.ReadAll(os.Stdin)
+	}
+	return os.ReadFile(path)
+}
+
+// createOutputFile creates a file for command output. Unless force is set,
+// an existing file is not overwritten.
+func createOutputFile(path string, force bool) (*os.File, error) {
+	flags := os.O_CREATE | os.O_WRONLY | os.O_TRUNC
+	if !force {
+		flags |= os.O_EXCL
+	}
+
+	return os.OpenFile(path, flags, 0644)
+}
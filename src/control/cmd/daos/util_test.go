@@ -0,0 +1,59 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestDaos_resolveFileFormat(t *testing.T) {
+	for name, tc := range map[string]struct {
+		format    string
+		path      string
+		expFormat string
+		expErr    error
+	}{
+		"default": {
+			expFormat: fileFormatYAML,
+		},
+		"json extension": {
+			path:      "cont.JSON",
+			expFormat: fileFormatJSON,
+		},
+		"yaml extension": {
+			path:      "cont.yaml",
+			expFormat: fileFormatYAML,
+		},
+		"explicit format overrides extension": {
+			format:    "yaml",
+			path:      "cont.json",
+			expFormat: fileFormatYAML,
+		},
+		"yml": {
+			format:    "yml",
+			expFormat: fileFormatYAML,
+		},
+		"invalid": {
+			format: "toml",
+			expErr: errors.New("invalid file format"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotFormat, gotErr := resolveFileFormat(tc.format, tc.path)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expFormat, gotFormat, "unexpected format")
+		})
+	}
+}
@@ -147,9 +147,28 @@ func (ci *cachedAttachInfo) Refresh(ctx context.Context) error {
 
 	ci.lastResponse = resp
 	ci.lastCached = time.Now()
+	updateAccessPoints(ci.rpcClient, resp)
 	return nil
 }
 
+// hostListSetter is implemented by control clients whose default host list
+// can be updated at runtime.
+type hostListSetter interface {
+	SetHostList([]string)
+}
+
+// updateAccessPoints points the control client at the current set of MS
+// replicas, which may have changed since the agent was started.
+func updateAccessPoints(rpcClient control.UnaryInvoker, resp *control.GetAttachInfoResp) {
+	if resp == nil || len(resp.MSReplicas) == 0 {
+		return
+	}
+
+	if hls, ok := rpcClient.(hostListSetter); ok {
+		hls.SetHostList(resp.MSReplicas)
+	}
+}
+
 type cachedFabricInfo struct {
 	cacheItem
 	fetch       fabricScanFn
@@ -338,6 +357,10 @@ func copyGetAttachInfoResp(orig *control.GetAttachInfoResp) *control.GetAttachIn
 	_ = copy(cp.MSRanks, orig.MSRanks)
 	cp.ServiceRanks = make([]*control.PrimaryServiceRank, len(orig.ServiceRanks))
 	_ = copy(cp.ServiceRanks, orig.ServiceRanks)
+	if orig.MSReplicas != nil {
+		cp.MSReplicas = make([]string, len(orig.MSReplicas))
+		_ = copy(cp.MSReplicas, orig.MSReplicas)
+	}
 
 	if orig.ClientNetHint.EnvVars != nil {
 		cp.ClientNetHint.EnvVars = make([]string, len(orig.ClientNetHint.EnvVars))
@@ -361,6 +384,8 @@ func (c *InfoCache) getAttachInfoRemote(ctx context.Context, sys string) (*contr
 	if resp.ClientNetHint.Provider == "" {
 		return nil, errors.New("GetAttachInfo response contained no provider")
 	}
+	updateAccessPoints(c.client, resp)
+
 	return resp, nil
 }
 
@@ -185,6 +185,15 @@ func TestAgent_cachedAttachInfo_NeedsRefresh(t *testing.T) {
 	}
 }
 
+type mockHostListInvoker struct {
+	control.UnaryInvoker
+	hostList []string
+}
+
+func (mi *mockHostListInvoker) SetHostList(hostList []string) {
+	mi.hostList = hostList
+}
+
 func TestAgent_cachedAttachInfo_Refresh(t *testing.T) {
 	resp1 := &control.GetAttachInfoResp{
 		System: "resp1",
@@ -224,6 +233,16 @@ func TestAgent_cachedAttachInfo_Refresh(t *testing.T) {
 		},
 	}
 
+	resp3 := &control.GetAttachInfoResp{
+		System:  "resp3",
+		MSRanks: []uint32{1, 3},
+		ClientNetHint: control.ClientNetworkHint{
+			Provider:    "other",
+			NetDevClass: uint32(hardware.Infiniband),
+		},
+		MSReplicas: []string{"10.0.0.1:10001", "10.0.0.3:10001"},
+	}
+
 	for name, tc := range map[string]struct {
 		nilCache      bool
 		ctlResult     *control.GetAttachInfoResp
@@ -231,6 +250,7 @@ func TestAgent_cachedAttachInfo_Refresh(t *testing.T) {
 		alreadyCached *control.GetAttachInfoResp
 		expErr        error
 		expCached     *control.GetAttachInfoResp
+		expHostList   []string
 	}{
 		"nil": {
 			nilCache: true,
@@ -249,11 +269,18 @@ func TestAgent_cachedAttachInfo_Refresh(t *testing.T) {
 			alreadyCached: resp1,
 			expCached:     resp2,
 		},
+		"replicas updated": {
+			ctlResult:     resp3,
+			alreadyCached: resp1,
+			expCached:     resp3,
+			expHostList:   []string{"10.0.0.1:10001", "10.0.0.3:10001"},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			var ai *cachedAttachInfo
+			client := &mockHostListInvoker{UnaryInvoker: control.DefaultClient()}
 			if !tc.nilCache {
-				ai = newCachedAttachInfo(0, "test", control.DefaultClient(),
+				ai = newCachedAttachInfo(0, "test", client,
 					func(_ context.Context, _ control.UnaryInvoker, _ *control.GetAttachInfoReq) (*control.GetAttachInfoResp, error) {
 						return tc.ctlResult, tc.ctlErr
 					})
@@ -274,6 +301,9 @@ func TestAgent_cachedAttachInfo_Refresh(t *testing.T) {
 			if diff := cmp.Diff(tc.expCached, ai.lastResponse); diff != "" {
 				t.Fatalf("-want, +got:\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.expHostList, client.hostList); diff != "" {
+				t.Fatalf("unexpected host list (-want, +got):\n%s", diff)
+			}
 		})
 	}
 }
@@ -7,8 +7,10 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -16,6 +18,7 @@ import (
 	"github.com/daos-stack/daos/src/control/build"
 	"github.com/daos-stack/daos/src/control/common"
 	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/lib/txtfmt"
 	"github.com/daos-stack/daos/src/control/server"
 	"github.com/daos-stack/daos/src/control/system"
@@ -26,6 +29,9 @@ type msCmdRoot struct {
 	Status  msStatusCmd   `command:"status" description:"Show status of the local management service replica"`
 	Recover msRecoveryCmd `command:"recover" description:"Recover the management service using this replica"`
 	Restore msRestoreCmd  `command:"restore" description:"Restore the management service from a snapshot"`
+	Export  msExportCmd   `command:"export" description:"Export the local management service database"`
+	Import  msImportCmd   `command:"import" description:"Import the management service database from an export file"`
+	Migrate msMigrateCmd  `command:"migrate" description:"Migrate the local management service database to the current schema"`
 }
 
 type dbCfgCmd struct {
@@ -55,6 +61,7 @@ func printSnapshotDetails(out io.Writer, sInfo *sdb.SnapshotDetails) error {
 	fmt.Fprintf(ew, "Index: %d\n", sInfo.Metadata.Index)
 	fmt.Fprintf(ew, "Term: %d\n", sInfo.Metadata.Term)
 	fmt.Fprintf(ew, "DB Version: %d\n", sInfo.Version)
+	fmt.Fprintf(ew, "Schema Version: %d\n", sInfo.SchemaVersion)
 	fmt.Fprintf(ew, "System Ranks: %s\n", sInfo.MemberRanks)
 	fmt.Fprintf(ew, "System Pools: %s\n", strings.Join(sInfo.Pools, ","))
 
@@ -211,7 +218,7 @@ type msRestoreCmd struct {
 	dbCfgCmd
 
 	Force bool   `short:"f" long:"force" description:"Don't prompt for confirmation"`
-	Path  string `short:"p" long:"path" description:"Path to snapshot file" required:"1"`
+	Path  string `short:"p" long:"path" description:"Path to snapshot or backup directory" required:"1"`
 }
 
 func (cmd *msRestoreCmd) Execute([]string) error {
@@ -281,3 +288,258 @@ they join the quorum.
 
 	return nil
 }
+
+type msExportCmd struct {
+	dbCfgCmd
+	cmdutil.JSONOutputCmd
+
+	Output string `short:"O" long:"output" description:"Write the exported database to the specified file"`
+}
+
+func printDatabaseExport(out io.Writer, de *sdb.DatabaseExport) error {
+	ew := txtfmt.NewErrWriter(out)
+
+	fmt.Fprintf(ew, "Schema Version: %d\n", de.SchemaVersion)
+	fmt.Fprintf(ew, "DB Version: %d\n", de.Version)
+	fmt.Fprintf(ew, "Map Version: %d\n", de.MapVersion)
+	fmt.Fprintf(ew, "Next Rank: %d\n", de.NextRank)
+	fmt.Fprintf(ew, "Replicas: %s\n", strings.Join(de.Replicas, ","))
+	fmt.Fprintf(ew, "Members: %d\n", len(de.Members))
+	for _, m := range de.Members {
+		fmt.Fprintf(ew, "  Rank %d: %s %s (%s)\n", m.Rank, m.UUID, m.Addr, m.State)
+	}
+	fmt.Fprintf(ew, "Pools: %d\n", len(de.Pools))
+	for _, ps := range de.Pools {
+		fmt.Fprintf(ew, "  %s: %s (%s) replicas %s\n", ps.PoolLabel, ps.PoolUUID, ps.State,
+			ranklist.RankSetFromRanks(ps.Replicas))
+	}
+	fmt.Fprintf(ew, "System Attributes: %d\n", len(de.SystemAttrs))
+
+	return ew.Err
+}
+
+func (cmd *msExportCmd) Execute([]string) error {
+	if err := common.CheckDupeProcess(); err != nil {
+		return err
+	}
+
+	dbCfg, err := cmd.getDatabaseConfig()
+	if err != nil {
+		return err
+	}
+
+	de, err := sdb.ExportLocalReplica(cmd.Logger, dbCfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to export local replica")
+	}
+
+	if cmd.Output != "" {
+		buf, err := json.MarshalIndent(de, "", "  ")
+		if err != nil {
+			return err
+		}
+		if err := ioutil.WriteFile(cmd.Output, append(buf, '\n'), 0600); err != nil {
+			return errors.Wrapf(err, "failed to write %q", cmd.Output)
+		}
+		cmd.Noticef("Exported %s database to %s", build.ManagementServiceName, cmd.Output)
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(de, nil)
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "%s DB export:\n", build.ManagementServiceName)
+	printDatabaseExport(txtfmt.NewIndentWriter(&buf, txtfmt.WithPadCount(2)), de)
+	cmd.Info(buf.String())
+
+	return nil
+}
+
+// readDatabaseExport reads an export document from the supplied file. Both
+// the plain document written with --output and the --json command output are
+// accepted.
+func readDatabaseExport(path string) (*sdb.DatabaseExport, error) {
+	buf, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read %q", path)
+	}
+
+	var wrapped struct {
+		Response *sdb.DatabaseExport `json:"response"`
+	}
+	if err := json.Unmarshal(buf, &wrapped); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %q", path)
+	}
+	if wrapped.Response != nil {
+		return wrapped.Response, nil
+	}
+
+	de := new(sdb.DatabaseExport)
+	if err := json.Unmarshal(buf, de); err != nil {
+		return nil, errors.Wrapf(err, "failed to decode %q", path)
+	}
+	return de, nil
+}
+
+type msImportCmd struct {
+	dbCfgCmd
+
+	Force bool   `short:"f" long:"force" description:"Don't prompt for confirmation"`
+	Path  string `short:"p" long:"path" description:"Path to exported database JSON file" required:"1"`
+}
+
+func (cmd *msImportCmd) Execute([]string) error {
+	if err := common.CheckDupeProcess(); err != nil {
+		return err
+	}
+
+	dbCfg, err := cmd.getDatabaseConfig()
+	if err != nil {
+		return err
+	}
+
+	msg := `
+Running this command will replace the management service database on this
+replica with the contents of the supplied export file. Upon successful import,
+the raft configuration will be updated to force this node to be re-bootstrapped
+into single-node mode. Peer replicas will re-join the quorum as they are
+restarted.
+
+WARNING: This is a potentially-destructive operation. Any local data on this
+replica will be replaced by the imported data, and any uncommitted logs
+on peer replicas will be discarded in favor of the data on this replica.
+
+Requirements:
+  - Export file must pass validation
+  - All other control plane servers must be stopped
+
+After successful completion of this command, the control plane service
+may be started normally across the system. This node will serve as the
+initial leader and will send the latest snapshot to other replicas as
+they join the quorum.
+
+`
+
+	de, err := readDatabaseExport(cmd.Path)
+	if err != nil {
+		return err
+	}
+
+	if !cmd.Force {
+		cmd.Info(msg)
+
+		var buf strings.Builder
+		fmt.Fprintf(&buf, "Database export read from %s:\n", cmd.Path)
+		printDatabaseExport(txtfmt.NewIndentWriter(&buf, txtfmt.WithPadCount(2)), de)
+		cmd.Infof("%s\n", buf.String())
+
+		if !common.GetConsent(cmd.Logger) {
+			return nil
+		}
+	}
+
+	if err := sdb.ImportLocalReplica(cmd.Logger, dbCfg, de); err != nil {
+		return err
+	}
+
+	sInfo, err := sdb.GetLatestSnapshot(cmd.Logger, dbCfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest snapshot after import")
+	}
+
+	cmd.Info("Successfully imported management service database")
+
+	var buf strings.Builder
+	fmt.Fprintln(&buf, "Latest snapshot info:")
+	printSnapshotDetails(txtfmt.NewIndentWriter(&buf, txtfmt.WithPadCount(2)), sInfo)
+	cmd.Info(buf.String())
+
+	return nil
+}
+
+type msMigrateCmd struct {
+	dbCfgCmd
+
+	Force  bool `short:"f" long:"force" description:"Don't prompt for confirmation"`
+	DryRun bool `short:"n" long:"dry-run" description:"Show the migrations that would be run without making any changes"`
+}
+
+func printMigrationDetails(out io.Writer, steps []*sdb.MigrationDetails) error {
+	ew := txtfmt.NewErrWriter(out)
+
+	for _, step := range steps {
+		fmt.Fprintf(ew, "%d -> %d: %s\n", step.From, step.To, step.Description)
+	}
+
+	return ew.Err
+}
+
+func (cmd *msMigrateCmd) Execute([]string) error {
+	if err := common.CheckDupeProcess(); err != nil {
+		return err
+	}
+
+	dbCfg, err := cmd.getDatabaseConfig()
+	if err != nil {
+		return err
+	}
+
+	steps, err := sdb.MigrateLocalReplica(cmd.Logger, dbCfg, true)
+	if err != nil {
+		return errors.Wrap(err, "failed to check schema migrations")
+	}
+	if len(steps) == 0 {
+		cmd.Infof("%s database schema is up to date (version %d)", build.ManagementServiceName,
+			sdb.CurrentSchemaVersion)
+		return nil
+	}
+
+	var buf strings.Builder
+	fmt.Fprintf(&buf, "%s database schema migrations to be run:\n", build.ManagementServiceName)
+	printMigrationDetails(txtfmt.NewIndentWriter(&buf, txtfmt.WithPadCount(2)), steps)
+	cmd.Info(buf.String())
+
+	if cmd.DryRun {
+		return nil
+	}
+
+	msg := `
+Running this command will migrate the management service database on this
+replica to the current schema version. Upon successful migration, the raft
+configuration will be updated to force this node to be re-bootstrapped into
+single-node mode. Peer replicas will re-join the quorum as they are restarted.
+
+NOTE: Migrations are also run automatically via the raft log when a replica
+running this version of the software becomes the leader. This command is only
+required in order to migrate the database before the control plane is started.
+
+Requirements:
+  - All other control plane servers must be stopped
+
+`
+	if !cmd.Force {
+		cmd.Info(msg)
+		if !common.GetConsent(cmd.Logger) {
+			return nil
+		}
+	}
+
+	if _, err := sdb.MigrateLocalReplica(cmd.Logger, dbCfg, false); err != nil {
+		return err
+	}
+
+	sInfo, err := sdb.GetLatestSnapshot(cmd.Logger, dbCfg)
+	if err != nil {
+		return errors.Wrap(err, "failed to get latest snapshot after migration")
+	}
+
+	cmd.Info("Successfully migrated management service database")
+
+	buf.Reset()
+	fmt.Fprintln(&buf, "Latest snapshot info:")
+	printSnapshotDetails(txtfmt.NewIndentWriter(&buf, txtfmt.WithPadCount(2)), sInfo)
+	cmd.Info(buf.String())
+
+	return nil
+}
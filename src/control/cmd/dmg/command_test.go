@@ -134,6 +134,10 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemStartResp{})
 	case *control.SystemExcludeReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemExcludeResp{})
+	case *control.SystemDrainReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemDrainResp{})
+	case *control.SystemMaintenanceReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemMaintenanceResp{})
 	case *control.SystemQueryReq:
 		if req.FailOnUnavailable {
 			resp = control.MockMSResponse("", system.ErrRaftUnavail, nil)
@@ -149,11 +153,35 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 	case *control.ContSetOwnerReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.ContSetOwnerResp{})
 	case *control.PoolQueryReq:
-		resp = control.MockMSResponse("", nil, &mgmtpb.PoolQueryResp{})
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolQueryResp{
+			Rebuild: &mgmtpb.PoolRebuildStatus{
+				State: mgmtpb.PoolRebuildStatus_DONE,
+			},
+		})
 	case *control.PoolQueryTargetReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolQueryTargetResp{})
 	case *control.PoolUpgradeReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.PoolUpgradeResp{})
+	case *control.PoolProfileCreateReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolProfileCreateResp{})
+	case *control.PoolProfileDeleteReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolProfileDeleteResp{})
+	case *control.PoolTagReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.PoolTagResp{})
+	case *control.PoolProfileListReq:
+		listResp := &mgmtpb.PoolProfileListResp{}
+		for _, name := range req.Names {
+			listResp.Profiles = append(listResp.Profiles, &mgmtpb.PoolProfile{
+				Name:       name,
+				Properties: map[string]string{"reclaim": "lazy"},
+				Acl:        []string{"A::OWNER@:rw"},
+				TotalBytes: 1 << 40,
+				TierRatio:  []float64{0.1, 0.9},
+				NumSvcReps: 3,
+				Ranks:      "0-3",
+			})
+		}
+		resp = control.MockMSResponse("", nil, listResp)
 	case *control.PoolGetACLReq, *control.PoolOverwriteACLReq,
 		*control.PoolUpdateACLReq, *control.PoolDeleteACLReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.ACLResp{})
@@ -173,6 +201,30 @@ func (bci *bridgeConnInvoker) InvokeUnaryRPC(ctx context.Context, uReq control.U
 		resp = control.MockMSResponse("", nil, &mgmtpb.DaosResp{})
 	case *control.SystemGetPropReq:
 		resp = control.MockMSResponse("", nil, &mgmtpb.SystemGetPropResp{})
+	case *control.SystemEventsListReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemEventsListResp{})
+	case *control.SystemAuditListReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemAuditListResp{})
+	case *control.SystemReplicaReq, *control.SystemListReplicasReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemReplicasResp{})
+	case *control.SystemLeaderTransferReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemLeaderTransferResp{
+			OldLeader: "host1",
+			NewLeader: "host2",
+			Replicas:  []string{"host1", "host2"},
+		})
+	case *control.ReplicaLeaderQueryReq:
+		resp = control.MockMSResponse("host2", nil, &mgmtpb.LeaderQueryResp{
+			CurrentLeader: "host2",
+		})
+	case *control.SystemBackupReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemBackupResp{
+			Backup: &mgmtpb.SystemBackup{},
+		})
+	case *control.SystemListBackupsReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemListBackupsResp{})
+	case *control.SystemEventsWatchReq:
+		resp = control.MockMSResponse("", nil, &mgmtpb.SystemEventsWatchResp{})
 	case *control.NetworkScanReq:
 		resp = &control.UnaryResponse{
 			Responses: []*control.HostResponse{
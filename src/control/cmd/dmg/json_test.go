@@ -92,8 +92,18 @@ func TestDmg_JsonOutput(t *testing.T) {
 				testArgs = append(testArgs, test.MockUUID(), "-a", aclPath)
 			case "pool delete-acl":
 				testArgs = append(testArgs, test.MockUUID(), "-p", "foo@")
+			case "pool check-access":
+				testArgs = append(testArgs, test.MockUUID(), "-u", "foo@")
 			case "pool set-prop":
 				testArgs = append(testArgs, test.MockUUID(), "label:foo")
+			case "pool tag set":
+				testArgs = append(testArgs, test.MockUUID(), "project=climate")
+			case "pool tag unset":
+				testArgs = append(testArgs, test.MockUUID(), "project")
+			case "pool profile create":
+				testArgs = append(testArgs, "gold", "-z", "10%")
+			case "pool profile show", "pool profile delete":
+				testArgs = append(testArgs, "gold")
 			case "pool get-prop":
 				testArgs = append(testArgs, test.MockUUID(), "label")
 			case "pool extend":
@@ -117,6 +127,12 @@ func TestDmg_JsonOutput(t *testing.T) {
 				testArgs = append(testArgs, "--ranks", "0")
 			case "system clear-exclude":
 				testArgs = append(testArgs, "--ranks", "0")
+			case "system drain", "system reintegrate":
+				testArgs = append(testArgs, "--ranks", "0")
+			case "system maintenance start", "system maintenance finish":
+				testArgs = append(testArgs, "--ranks", "0")
+			case "system ms add-replica", "system ms remove-replica":
+				testArgs = append(testArgs, "hostname")
 			}
 
 			// replace os.Stdout so that we can verify the generated output
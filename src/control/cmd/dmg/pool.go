@@ -13,6 +13,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -25,6 +26,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/ui"
 	"github.com/daos-stack/daos/src/control/logging"
 	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 // PoolCmd is the struct representing the top-level pool subcommand.
@@ -43,9 +45,12 @@ type PoolCmd struct {
 	OverwriteACL PoolOverwriteACLCmd `command:"overwrite-acl" description:"Overwrite a DAOS pool's Access Control List"`
 	UpdateACL    PoolUpdateACLCmd    `command:"update-acl" description:"Update entries in a DAOS pool's Access Control List"`
 	DeleteACL    PoolDeleteACLCmd    `command:"delete-acl" description:"Delete an entry from a DAOS pool's Access Control List"`
+	CheckAccess  PoolCheckAccessCmd  `command:"check-access" description:"Evaluate a DAOS pool's Access Control List for a user"`
 	SetProp      PoolSetPropCmd      `command:"set-prop" description:"Set pool property"`
 	GetProp      PoolGetPropCmd      `command:"get-prop" description:"Get pool properties"`
 	Upgrade      PoolUpgradeCmd      `command:"upgrade" description:"Upgrade pool to latest format"`
+	Profile      PoolProfileCmd      `command:"profile" description:"Manage named pool creation profiles"`
+	Tag          PoolTagCmd          `command:"tag" description:"Manage operator-defined pool tags"`
 }
 
 var (
@@ -202,6 +207,9 @@ type PoolCreateCmd struct {
 	NVMeSize   sizeFlag            `short:"n" long:"nvme-size" description:"Per-engine NVMe allocation for DAOS pool (manual)"`
 	MetaSize   sizeFlag            `long:"meta-size" description:"In MD-on-SSD mode specify meta blob size to be used in DAOS pool (manual)"`
 	RankList   ui.RankSetFlag      `short:"r" long:"ranks" description:"Storage engine unique identifiers (ranks) for DAOS pool"`
+	DryRun     bool                `long:"dry-run" description:"Display the resolved ranks and storage allocations without creating the pool"`
+	Profile    string              `long:"profile" description:"Named pool profile providing defaults for options which are not set explicitly"`
+	Tags       []string            `long:"tag" description:"Operator-defined pool tag in key=value format (may be repeated)"`
 
 	Args struct {
 		PoolLabel string `positional-arg-name:"<pool label>" required:"1"`
@@ -308,8 +316,38 @@ func (cmd *PoolCreateCmd) storageManual(req *control.PoolCreateReq) error {
 	return nil
 }
 
+func (cmd *PoolCreateCmd) dryRun(ctx context.Context, req *control.PoolCreateReq) error {
+	resp, err := control.PoolCreatePlan(ctx, cmd.ctlInvoker, req)
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintPoolCreatePlanResponse(resp, &bld); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
+
 // Execute is run when PoolCreateCmd subcommand is activated
 func (cmd *PoolCreateCmd) Execute(args []string) error {
+	ctx := context.Background()
+
+	var profile *system.PoolProfile
+	if cmd.Profile != "" {
+		var err error
+		if profile, err = cmd.applyProfile(ctx); err != nil {
+			return err
+		}
+	}
+
 	if err := cmd.checkSizeArgs(); err != nil {
 		return err
 	}
@@ -325,13 +363,19 @@ func (cmd *PoolCreateCmd) Execute(args []string) error {
 		}
 	}
 
-	ctx := context.Background()
+	tags, err := system.ParsePoolTags(cmd.Tags)
+	if err != nil {
+		return err
+	}
+
 	req := &control.PoolCreateReq{
 		User:       cmd.UserName.String(),
 		UserGroup:  cmd.GroupName.String(),
 		NumSvcReps: cmd.NumSvcReps,
 		Properties: cmd.Properties.ToSet,
 		Ranks:      cmd.RankList.Ranks(),
+		Tags:       tags,
+		Profile:    cmd.Profile,
 	}
 
 	if cmd.ACLFile != "" {
@@ -340,6 +384,8 @@ func (cmd *PoolCreateCmd) Execute(args []string) error {
 		if err != nil {
 			return err
 		}
+	} else if profile != nil && len(profile.ACL) > 0 {
+		req.ACL = &control.AccessControlList{Entries: profile.ACL}
 	}
 
 	// Validate supported input values and set request fields.
@@ -364,6 +410,10 @@ func (cmd *PoolCreateCmd) Execute(args []string) error {
 		}
 	}
 
+	if cmd.DryRun {
+		return cmd.dryRun(ctx, req)
+	}
+
 	resp, err := control.PoolCreate(ctx, cmd.ctlInvoker, req)
 
 	if cmd.JSONOutputEnabled() {
@@ -389,9 +439,10 @@ type PoolListCmd struct {
 	cfgCmd
 	ctlInvokerCmd
 	cmdutil.JSONOutputCmd
-	Verbose     bool `short:"v" long:"verbose" description:"Add pool UUIDs and service replica lists to display"`
-	NoQuery     bool `short:"n" long:"no-query" description:"Disable query of listed pools"`
-	RebuildOnly bool `short:"r" long:"rebuild-only" description:"List only pools which rebuild stats is not idle"`
+	Verbose     bool     `short:"v" long:"verbose" description:"Add pool UUIDs and service replica lists to display"`
+	NoQuery     bool     `short:"n" long:"no-query" description:"Disable query of listed pools"`
+	RebuildOnly bool     `short:"r" long:"rebuild-only" description:"List only pools which rebuild stats is not idle"`
+	Tags        []string `long:"tag" description:"List only pools with the given tag in key=value format (may be repeated)"`
 }
 
 // Execute is run when PoolListCmd activates
@@ -404,8 +455,14 @@ func (cmd *PoolListCmd) Execute(_ []string) (errOut error) {
 		return errors.New("no configuration loaded")
 	}
 
+	tags, err := system.ParsePoolTags(cmd.Tags)
+	if err != nil {
+		return err
+	}
+
 	req := &control.ListPoolsReq{
 		NoQuery: cmd.NoQuery,
+		Tags:    tags,
 	}
 
 	initialResp, err := control.ListPools(context.Background(), cmd.ctlInvoker, req)
@@ -636,8 +693,41 @@ func (cmd *PoolReintegrateCmd) Execute(args []string) error {
 // PoolQueryCmd is the struct representing the command to query a DAOS pool.
 type PoolQueryCmd struct {
 	poolCmd
-	ShowEnabledRanks  bool `short:"e" long:"show-enabled" description:"Show engine unique identifiers (ranks) which are enabled"`
-	ShowDisabledRanks bool `short:"b" long:"show-disabled" description:"Show engine unique identifiers (ranks) which are disabled"`
+	ShowEnabledRanks  bool          `short:"e" long:"show-enabled" description:"Show engine unique identifiers (ranks) which are enabled"`
+	ShowDisabledRanks bool          `short:"b" long:"show-disabled" description:"Show engine unique identifiers (ranks) which are disabled"`
+	Watch             bool          `short:"w" long:"watch" description:"Repeatedly query the pool and display rebuild progress until rebuild completes"`
+	Interval          time.Duration `short:"i" long:"interval" default:"5s" description:"Interval between queries in watch mode"`
+}
+
+// watchRebuild displays pool rebuild progress samples until the rebuild
+// completes or fails.
+func (cmd *PoolQueryCmd) watchRebuild() error {
+	req := &control.PoolRebuildWatchReq{
+		ID:       cmd.PoolID().String(),
+		Interval: cmd.Interval,
+	}
+
+	var outErr error
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := control.PoolRebuildWatch(ctx, cmd.ctlInvoker, req, func(p *control.PoolRebuildProgress) {
+		if cmd.JSONOutputEnabled() {
+			outErr = cmd.OutputJSONLine(p)
+		} else {
+			var out strings.Builder
+			outErr = pretty.PrintPoolRebuildProgress(&out, p)
+			cmd.Info(strings.TrimSuffix(out.String(), "\n"))
+		}
+		if outErr != nil {
+			cancel()
+		}
+	})
+	if outErr != nil {
+		return outErr
+	}
+
+	return errors.Wrap(err, "pool query watch failed")
 }
 
 // Execute is run when PoolQueryCmd subcommand is activated
@@ -650,6 +740,12 @@ func (cmd *PoolQueryCmd) Execute(args []string) error {
 	if cmd.ShowEnabledRanks && cmd.ShowDisabledRanks {
 		return errIncompatFlags("show-enabled-ranks", "show-disabled-ranks")
 	}
+	if cmd.Watch {
+		if cmd.ShowEnabledRanks || cmd.ShowDisabledRanks {
+			return errIncompatFlags("watch", "show-enabled", "show-disabled")
+		}
+		return cmd.watchRebuild()
+	}
 	req.IncludeEnabledRanks = cmd.ShowEnabledRanks
 	req.IncludeDisabledRanks = cmd.ShowDisabledRanks
 
@@ -982,3 +1078,44 @@ func (cmd *PoolDeleteACLCmd) Execute(args []string) error {
 
 	return nil
 }
+
+// PoolCheckAccessCmd represents the command to evaluate the Access Control
+// List of a DAOS pool for a user.
+type PoolCheckAccessCmd struct {
+	poolCmd
+	User   ui.ACLPrincipalFlag   `short:"u" long:"user" required:"1" description:"User to evaluate the ACL for, format name@domain"`
+	Groups []ui.ACLPrincipalFlag `short:"g" long:"group" description:"Group the user belongs to, format name@domain (may be repeated)"`
+	Perms  string                `short:"p" long:"perms" description:"Permissions to check for, in ACE format (e.g. rc)"`
+}
+
+// Execute is run when the PoolCheckAccessCmd subcommand is activated
+func (cmd *PoolCheckAccessCmd) Execute(args []string) error {
+	req := &control.PoolGetACLReq{ID: cmd.PoolID().String()}
+
+	resp, err := control.PoolGetACL(context.Background(), cmd.ctlInvoker, req)
+	if err != nil {
+		if cmd.JSONOutputEnabled() {
+			return cmd.OutputJSON(nil, err)
+		}
+		return errors.Wrap(err, "Pool-check-access command failed")
+	}
+
+	groups := make([]string, len(cmd.Groups))
+	for i, g := range cmd.Groups {
+		groups[i] = g.String()
+	}
+
+	access, err := control.CheckACLAccess(resp.ACL, control.ACLResourcePool,
+		cmd.User.String(), groups, cmd.Perms)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(access, err)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "Pool-check-access command failed")
+	}
+
+	cmd.Info(control.FormatACLAccess(access))
+
+	return nil
+}
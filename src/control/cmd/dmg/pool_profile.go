@@ -0,0 +1,270 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// PoolProfileCmd is the struct representing the command group for managing
+// named pool creation profiles.
+type PoolProfileCmd struct {
+	Create PoolProfileCreateCmd `command:"create" description:"Create a named pool profile"`
+	List   PoolProfileListCmd   `command:"list" alias:"ls" description:"List named pool profiles"`
+	Show   PoolProfileShowCmd   `command:"show" description:"Display the settings of a named pool profile"`
+	Delete PoolProfileDeleteCmd `command:"delete" description:"Delete a named pool profile"`
+}
+
+type poolProfileCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+
+	Args struct {
+		Name string `positional-arg-name:"<profile name>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// poolProfilePropsFlag accumulates the pool properties supplied for a profile
+// in their original form so that they can be parsed again when the profile
+// is applied.
+type poolProfilePropsFlag struct {
+	PoolSetPropsFlag
+
+	values map[string]string
+}
+
+func (f *poolProfilePropsFlag) UnmarshalFlag(fv string) error {
+	if err := f.PoolSetPropsFlag.UnmarshalFlag(fv); err != nil {
+		return err
+	}
+
+	if f.values == nil {
+		f.values = make(map[string]string)
+	}
+	for key, val := range f.ParsedProps {
+		f.values[key] = val
+	}
+
+	return nil
+}
+
+// PoolProfileCreateCmd is the struct representing the command to create a
+// named pool profile.
+type PoolProfileCreateCmd struct {
+	poolProfileCmd
+	Description string               `short:"d" long:"description" description:"Description of the pool profile"`
+	Properties  poolProfilePropsFlag `short:"P" long:"properties" description:"Pool properties to be set on pools created with the profile"`
+	ACLFile     string               `short:"a" long:"acl-file" description:"Access Control List file path for pools created with the profile"`
+	Size        poolSizeFlag         `short:"z" long:"size" description:"Total size of pools created with the profile or its percentage ratio"`
+	TierRatio   tierRatioFlag        `short:"t" long:"tier-ratio" description:"Percentage of storage tiers for pool storage"`
+	NumRanks    uint32               `short:"k" long:"nranks" description:"Number of ranks to use"`
+	NumSvcReps  uint32               `short:"v" long:"nsvc" description:"Number of pool service replicas"`
+	RankList    ui.RankSetFlag       `short:"r" long:"ranks" description:"Storage engine ranks that pools created with the profile may use"`
+	Replace     bool                 `long:"replace" description:"Replace an existing profile with the same name"`
+}
+
+// Execute is run when PoolProfileCreateCmd subcommand is activated
+func (cmd *PoolProfileCreateCmd) Execute(args []string) error {
+	pp := &system.PoolProfile{
+		Name:        cmd.Args.Name,
+		Description: cmd.Description,
+		NumRanks:    cmd.NumRanks,
+		NumSvcReps:  cmd.NumSvcReps,
+		Properties:  cmd.Properties.values,
+		Ranks:       cmd.RankList.String(),
+	}
+
+	if cmd.ACLFile != "" {
+		acl, err := control.ReadACLFile(cmd.ACLFile)
+		if err != nil {
+			return err
+		}
+		pp.ACL = acl.Entries
+	}
+
+	if cmd.Size.IsRatio() {
+		pp.SizeRatio = uint32(cmd.Size.availRatio)
+	} else {
+		pp.TotalBytes = cmd.Size.bytes
+	}
+	if cmd.TierRatio.IsSet() {
+		pp.TierRatio = cmd.TierRatio.Ratios()
+	}
+
+	req := &control.PoolProfileCreateReq{
+		Profile: pp,
+		Replace: cmd.Replace,
+	}
+	err := control.PoolProfileCreate(context.Background(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("Pool profile %q created", pp.Name)
+
+	return nil
+}
+
+// PoolProfileListCmd is the struct representing the command to list named
+// pool profiles.
+type PoolProfileListCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when PoolProfileListCmd subcommand is activated
+func (cmd *PoolProfileListCmd) Execute(args []string) error {
+	resp, err := control.PoolProfileList(context.Background(), cmd.ctlInvoker,
+		&control.PoolProfileListReq{})
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintPoolProfileListResponse(&bld, resp); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// PoolProfileShowCmd is the struct representing the command to display the
+// settings of a named pool profile.
+type PoolProfileShowCmd struct {
+	poolProfileCmd
+}
+
+// Execute is run when PoolProfileShowCmd subcommand is activated
+func (cmd *PoolProfileShowCmd) Execute(args []string) error {
+	pp, err := control.GetPoolProfile(context.Background(), cmd.ctlInvoker, cmd.Args.Name)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(pp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if err := pretty.PrintPoolProfile(&bld, pp); err != nil {
+		return err
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// PoolProfileDeleteCmd is the struct representing the command to delete a
+// named pool profile.
+type PoolProfileDeleteCmd struct {
+	poolProfileCmd
+}
+
+// Execute is run when PoolProfileDeleteCmd subcommand is activated
+func (cmd *PoolProfileDeleteCmd) Execute(args []string) error {
+	req := &control.PoolProfileDeleteReq{Name: cmd.Args.Name}
+	err := control.PoolProfileDelete(context.Background(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(nil, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("Pool profile %q deleted", cmd.Args.Name)
+
+	return nil
+}
+
+// applyProfile fetches the named pool profile and uses its values for any
+// pool create options which were not explicitly set on the command line.
+func (cmd *PoolCreateCmd) applyProfile(ctx context.Context) (*system.PoolProfile, error) {
+	pp, err := control.GetPoolProfile(ctx, cmd.ctlInvoker, cmd.Profile)
+	if err != nil {
+		return nil, err
+	}
+
+	propHdlrs := daos.PoolProperties()
+	for _, name := range pp.PropertyNames() {
+		var isSet bool
+		for _, prop := range cmd.Properties.ToSet {
+			if prop.Name == name {
+				isSet = true
+				break
+			}
+		}
+		if isSet {
+			continue
+		}
+
+		prop, err := propHdlrs.GetProperty(name)
+		if err != nil {
+			return nil, errors.Wrapf(err, "pool profile %q", pp.Name)
+		}
+		if err := prop.SetValue(pp.Properties[name]); err != nil {
+			return nil, errors.Wrapf(err, "pool profile %q", pp.Name)
+		}
+		cmd.Properties.ToSet = append(cmd.Properties.ToSet, prop)
+	}
+
+	if cmd.NumSvcReps == 0 {
+		cmd.NumSvcReps = pp.NumSvcReps
+	}
+
+	// Profile sizing is only used if no size option has been supplied.
+	if !cmd.Size.IsSet() && !cmd.ScmSize.IsSet() && !cmd.NVMeSize.IsSet() {
+		cmd.Size.bytes = pp.TotalBytes
+		cmd.Size.availRatio = uint64(pp.SizeRatio)
+	}
+	if !cmd.Size.IsRatio() && cmd.Size.IsSet() {
+		if !cmd.TierRatio.IsSet() {
+			cmd.TierRatio.ratios = pp.TierRatio
+		}
+		if cmd.NumRanks == 0 && cmd.RankList.Empty() {
+			cmd.NumRanks = pp.NumRanks
+		}
+	}
+
+	allowed, err := pp.AllowedRanks()
+	if err != nil {
+		return nil, errors.Wrapf(err, "pool profile %q", pp.Name)
+	}
+	if allowed != nil {
+		if cmd.NumRanks > 0 {
+			return nil, errors.Errorf("--nranks may not be used with pool profile %q which "+
+				"restricts ranks to %s; use --ranks instead", pp.Name, allowed)
+		}
+		if cmd.RankList.Empty() {
+			cmd.RankList.Replace(allowed)
+		}
+		if err := pp.CheckRanks(cmd.NumRanks, cmd.RankList.Ranks()); err != nil {
+			return nil, err
+		}
+	}
+
+	return pp, nil
+}
@@ -0,0 +1,92 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// PoolTagCmd is the struct representing the command group for managing
+// operator-defined pool tags.
+type PoolTagCmd struct {
+	Set   PoolTagSetCmd   `command:"set" description:"Set tags on a DAOS pool"`
+	Unset PoolTagUnsetCmd `command:"unset" description:"Remove tags from a DAOS pool"`
+}
+
+func (cmd *poolCmd) updateTags(req *control.PoolTagReq) error {
+	req.ID = cmd.PoolID().String()
+
+	resp, err := control.PoolTag(context.Background(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var bld strings.Builder
+	if len(resp.Tags) == 0 {
+		fmt.Fprintf(&bld, "Pool %s has no tags\n", req.ID)
+	} else {
+		fmt.Fprintf(&bld, "Pool %s tags:\n", req.ID)
+		for _, tag := range resp.Tags.Strings() {
+			fmt.Fprintf(&bld, "  %s\n", tag)
+		}
+	}
+	cmd.Info(bld.String())
+
+	return nil
+}
+
+// PoolTagSetCmd is the struct representing the command to set tags on a pool.
+type PoolTagSetCmd struct {
+	poolCmd
+
+	Args struct {
+		Tags []string `positional-arg-name:"<key=value>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when PoolTagSetCmd subcommand is activated.
+func (cmd *PoolTagSetCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "pool tag set failed")
+	}()
+
+	tags, err := system.ParsePoolTags(cmd.Args.Tags)
+	if err != nil {
+		return err
+	}
+
+	return cmd.updateTags(&control.PoolTagReq{Set: tags})
+}
+
+// PoolTagUnsetCmd is the struct representing the command to remove tags from
+// a pool.
+type PoolTagUnsetCmd struct {
+	poolCmd
+
+	Args struct {
+		Keys []string `positional-arg-name:"<key>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+// Execute is run when PoolTagUnsetCmd subcommand is activated.
+func (cmd *PoolTagUnsetCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "pool tag unset failed")
+	}()
+
+	return cmd.updateTags(&control.PoolTagReq{Unset: cmd.Args.Keys})
+}
@@ -224,6 +224,33 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool with tags",
+			fmt.Sprintf("pool create --size %s --tag project=climate --tag cost-center=1234 foo", testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateReq{
+					TotalBytes: uint64(testSize),
+					TierRatio:  []float64{0.06, 0.94},
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					Ranks:      []ranklist.Rank{},
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", "foo"),
+					},
+					Tags: system.PoolTags{
+						"project":     "climate",
+						"cost-center": "1234",
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with invalid tag",
+			fmt.Sprintf("pool create --size %s --tag project foo", testSizeStr),
+			"",
+			errors.New("expected key=value"),
+		},
 		{
 			"Create pool with missing size",
 			"pool create label",
@@ -423,6 +450,112 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool dry run",
+			fmt.Sprintf("pool create label --size %s --ranks 1,2 --dry-run", testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.PoolCreateReq{
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					Ranks:      []ranklist.Rank{1, 2},
+					TotalBytes: uint64(testSize),
+					TierRatio:  []float64{0.06, 0.94},
+					Properties: []*daos.PoolProperty{
+						propWithVal("label", "label"),
+					},
+				}),
+				printRequest(t, &control.StorageScanReq{Usage: true}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with profile",
+			"pool create label --profile scratch",
+			strings.Join([]string{
+				printRequest(t, &control.PoolProfileListReq{
+					Names: []string{"scratch"},
+				}),
+				printRequest(t, &control.PoolCreateReq{
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					NumSvcReps: 3,
+					Ranks:      []ranklist.Rank{0, 1, 2, 3},
+					TotalBytes: 1 << 40,
+					TierRatio:  []float64{0.1, 0.9},
+					Properties: []*daos.PoolProperty{
+						propWithVal("reclaim", "lazy"),
+						propWithVal("label", "label"),
+					},
+					Profile: "scratch",
+					ACL: &control.AccessControlList{
+						Entries: []string{"A::OWNER@:rw"},
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with profile and explicit overrides",
+			fmt.Sprintf("pool create label --profile scratch --size %s --tier-ratio 2 --nsvc 5 "+
+				"--ranks 1,2 --properties reclaim:disabled --acl-file %s", testSizeStr, testACLFile),
+			strings.Join([]string{
+				printRequest(t, &control.PoolProfileListReq{
+					Names: []string{"scratch"},
+				}),
+				printRequest(t, &control.PoolCreateReq{
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					NumSvcReps: 5,
+					Ranks:      []ranklist.Rank{1, 2},
+					TotalBytes: uint64(testSize),
+					TierRatio:  []float64{0.02, 0.98},
+					Properties: []*daos.PoolProperty{
+						propWithVal("reclaim", "disabled"),
+						propWithVal("label", "label"),
+					},
+					Profile: "scratch",
+					ACL:     testACL,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with profile and manual storage parameters",
+			fmt.Sprintf("pool create label --profile scratch --scm-size %s", testSizeStr),
+			strings.Join([]string{
+				printRequest(t, &control.PoolProfileListReq{
+					Names: []string{"scratch"},
+				}),
+				printRequest(t, &control.PoolCreateReq{
+					User:       eUsr.Username + "@",
+					UserGroup:  eGrp.Name + "@",
+					NumSvcReps: 3,
+					Ranks:      []ranklist.Rank{0, 1, 2, 3},
+					TierBytes:  []uint64{uint64(testSize), 0},
+					Properties: []*daos.PoolProperty{
+						propWithVal("reclaim", "lazy"),
+						propWithVal("label", "label"),
+					},
+					Profile: "scratch",
+					ACL: &control.AccessControlList{
+						Entries: []string{"A::OWNER@:rw"},
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool with profile and ranks outside of profile",
+			"pool create label --profile scratch --ranks 2,5",
+			"",
+			errors.New("rank 5 is not allowed by pool profile"),
+		},
+		{
+			"Create pool with profile restricting ranks and nranks",
+			fmt.Sprintf("pool create label --profile scratch --size %s --nranks 2", testSizeStr),
+			"",
+			errors.New("--nranks may not be used"),
+		},
 		{
 			"Create pool with auto storage parameters",
 			fmt.Sprintf("pool create label --size %s --tier-ratio 2,98 --nranks 8", testSizeStr),
@@ -594,6 +727,89 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Create pool profile",
+			"pool profile create scratch --description scratch --properties reclaim:lazy,rf:1 " +
+				"--size 1TB --tier-ratio 10 --nsvc 3 --ranks 0-3",
+			strings.Join([]string{
+				printRequest(t, &control.PoolProfileCreateReq{
+					Profile: &system.PoolProfile{
+						Name:        "scratch",
+						Description: "scratch",
+						Properties: map[string]string{
+							"reclaim": "lazy",
+							"rd_fac":  "1",
+						},
+						TotalBytes: 1000000000000,
+						TierRatio:  []float64{0.1, 0.9},
+						NumSvcReps: 3,
+						Ranks:      "0-3",
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Replace pool profile with ACL and size ratio",
+			fmt.Sprintf("pool profile create scratch --size 10%% --acl-file %s --replace", testACLFile),
+			strings.Join([]string{
+				printRequest(t, &control.PoolProfileCreateReq{
+					Profile: &system.PoolProfile{
+						Name:      "scratch",
+						ACL:       testACL.Entries,
+						SizeRatio: 10,
+					},
+					Replace: true,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Create pool profile with size ratio and tier ratio",
+			"pool profile create scratch --size 10% --tier-ratio 10",
+			"",
+			errors.New("both a size ratio and a tier ratio"),
+		},
+		{
+			"Create pool profile with label property",
+			"pool profile create scratch --properties label:foo",
+			"",
+			errors.New("label property"),
+		},
+		{
+			"Create pool profile with missing name",
+			"pool profile create",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"List pool profiles",
+			"pool profile list",
+			strings.Join([]string{
+				printRequest(t, &control.PoolProfileListReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"Show pool profile",
+			"pool profile show scratch",
+			strings.Join([]string{
+				printRequest(t, &control.PoolProfileListReq{
+					Names: []string{"scratch"},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Delete pool profile",
+			"pool profile delete scratch",
+			strings.Join([]string{
+				printRequest(t, &control.PoolProfileDeleteReq{
+					Name: "scratch",
+				}),
+			}, " "),
+			nil,
+		},
 		/* TODO: Tests need to be fixed after pull pool info */
 		{
 			"Extend pool with missing arguments",
@@ -707,6 +923,62 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"List pools with tag filter",
+			"pool list --tag project=climate --tag owner=bob",
+			strings.Join([]string{
+				printRequest(t, &control.ListPoolsReq{
+					Tags: system.PoolTags{
+						"project": "climate",
+						"owner":   "bob",
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"List pools with invalid tag filter",
+			"pool list --tag project",
+			"",
+			errors.New("expected key=value"),
+		},
+		{
+			"Set pool tags",
+			"pool tag set pool1 project=climate owner=bob",
+			strings.Join([]string{
+				printRequest(t, &control.PoolTagReq{
+					ID: "pool1",
+					Set: system.PoolTags{
+						"project": "climate",
+						"owner":   "bob",
+					},
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Set pool tags with invalid tag",
+			"pool tag set pool1 bad key=value",
+			"",
+			errors.New("expected key=value"),
+		},
+		{
+			"Set pool tags without tags",
+			"pool tag set pool1",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"Unset pool tags",
+			"pool tag unset pool1 project owner",
+			strings.Join([]string{
+				printRequest(t, &control.PoolTagReq{
+					ID:    "pool1",
+					Unset: []string{"project", "owner"},
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Set pool properties",
 			"pool set-prop 031bcaf8-f0f5-42ef-b3c5-ee048676dceb label:foo,space_rb:42",
@@ -952,6 +1224,32 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Check pool access without user flag",
+			"pool check-access 12345678-1234-1234-1234-1234567890ab",
+			"",
+			dmgTestErr("the required flag `-u, --user' was not specified"),
+		},
+		{
+			"Check pool access with invalid requested permissions",
+			"pool check-access 12345678-1234-1234-1234-1234567890ab --user bob --perms rA",
+			strings.Join([]string{
+				printRequest(t, &control.PoolGetACLReq{
+					ID: "12345678-1234-1234-1234-1234567890ab",
+				}),
+			}, " "),
+			errors.New("do not apply to pools"),
+		},
+		{
+			"Check pool access",
+			"pool check-access 12345678-1234-1234-1234-1234567890ab --user bob -g users -g proj@ --perms rw",
+			strings.Join([]string{
+				printRequest(t, &control.PoolGetACLReq{
+					ID: "12345678-1234-1234-1234-1234567890ab",
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Query pool with UUID",
 			"pool query 12345678-1234-1234-1234-1234567890ab",
@@ -1016,6 +1314,28 @@ func TestPoolCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"Query pool with watch",
+			"pool query --watch --interval 10ms test_label",
+			strings.Join([]string{
+				printRequest(t, &control.PoolQueryReq{
+					ID: "test_label",
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"Query pool with watch and enabled ranks",
+			"pool query -w -e test_label",
+			"",
+			errors.New("may not be mixed"),
+		},
+		{
+			"Query pool with watch and invalid interval",
+			"pool query -w -i 0s test_label",
+			"",
+			errors.New("invalid pool rebuild watch interval"),
+		},
 		{
 			"Query pool with empty ID",
 			"pool query \"\"",
@@ -0,0 +1,88 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func printAuditRecordsVerbose(out io.Writer, recs []*system.AuditRecord) {
+	for _, rec := range recs {
+		attrs := []txtfmt.TableRow{
+			{"Timestamp": common.FormatTime(rec.Timestamp)},
+			{"Host": rec.Host},
+			{"Method": rec.Method},
+			{"Caller": rec.Caller},
+			{"Peer": rec.Peer},
+			{"Status": rec.Status},
+			{"Duration": rec.Duration.String()},
+			{"Request": rec.Request},
+		}
+		if rec.Error != "" {
+			attrs = append(attrs, txtfmt.TableRow{"Error": rec.Error})
+		}
+
+		fmt.Fprintln(out, txtfmt.FormatEntity(fmt.Sprintf("Audit record %d", rec.Sequence), attrs))
+	}
+}
+
+func printAuditRecords(out io.Writer, recs []*system.AuditRecord) {
+	seqTitle := "Seq"
+	tsTitle := "Timestamp"
+	hostTitle := "Host"
+	callerTitle := "Caller"
+	peerTitle := "Peer"
+	methodTitle := "Method"
+	statusTitle := "Status"
+	durTitle := "Duration"
+
+	formatter := txtfmt.NewTableFormatter(seqTitle, tsTitle, hostTitle, callerTitle,
+		peerTitle, methodTitle, statusTitle, durTitle)
+	var table []txtfmt.TableRow
+
+	for _, rec := range recs {
+		row := txtfmt.TableRow{seqTitle: fmt.Sprintf("%d", rec.Sequence)}
+		row[tsTitle] = common.FormatTime(rec.Timestamp)
+		row[hostTitle] = rec.Host
+		row[callerTitle] = rec.Caller
+		row[peerTitle] = rec.Peer
+		row[methodTitle] = rec.ShortMethod()
+		row[statusTitle] = rec.Status
+		row[durTitle] = rec.Duration.String()
+
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintSystemAuditListResponse generates a human-readable representation of the
+// supplied SystemAuditListResp struct and writes it to the supplied io.Writer.
+func PrintSystemAuditListResponse(out io.Writer, resp *control.SystemAuditListResp, opts ...PrintConfigOption) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	switch {
+	case len(resp.Records) == 0:
+		fmt.Fprintln(out, "No matching audit records found")
+	case getPrintConfig(opts...).Verbose:
+		printAuditRecordsVerbose(out, resp.Records)
+	default:
+		printAuditRecords(out, resp.Records)
+	}
+
+	return nil
+}
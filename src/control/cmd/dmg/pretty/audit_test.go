@@ -0,0 +1,107 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestPretty_PrintSystemAuditListResp(t *testing.T) {
+	ts := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	recs := []*system.AuditRecord{
+		{
+			Sequence:  1,
+			Timestamp: ts,
+			Host:      "foo",
+			Method:    "/mgmt.MgmtSvc/PoolCreate",
+			Caller:    "admin",
+			Peer:      "10.0.0.1:40000",
+			Request:   `{"uuid":"pool1"}`,
+			Status:    system.AuditStatusOK,
+			Duration:  1500 * time.Millisecond,
+		},
+		{
+			Sequence:  2,
+			Timestamp: ts.Add(time.Minute),
+			Host:      "barbaz",
+			Method:    "/mgmt.MgmtSvc/PoolDestroy",
+			Caller:    "admin",
+			Peer:      "10.0.0.2:40000",
+			Request:   `{"id":"pool1"}`,
+			Status:    "Unknown",
+			Error:     "pool busy",
+			Duration:  2 * time.Millisecond,
+		},
+	}
+	ts1 := common.FormatTime(recs[0].Timestamp)
+	ts2 := common.FormatTime(recs[1].Timestamp)
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemAuditListResp
+		verbose     bool
+		expPrintStr string
+	}{
+		"empty response": {
+			resp: &control.SystemAuditListResp{},
+			expPrintStr: `
+No matching audit records found
+`,
+		},
+		"normal response": {
+			resp: &control.SystemAuditListResp{
+				Records: recs,
+			},
+			expPrintStr: fmt.Sprintf(`
+Seq Timestamp                     Host   Caller Peer           Method      Status  Duration 
+--- ---------                     ----   ------ ----           ------      ------  -------- 
+1   %[1]s foo    admin  10.0.0.1:40000 PoolCreate  OK      1.5s     
+2   %[2]s barbaz admin  10.0.0.2:40000 PoolDestroy Unknown 2ms      
+
+`, ts1, ts2),
+		},
+		"verbose response": {
+			resp: &control.SystemAuditListResp{
+				Records: recs[1:],
+			},
+			verbose: true,
+			expPrintStr: fmt.Sprintf(`
+Audit record 2
+--------------
+  Timestamp : %[1]s
+  Host      : barbaz                       
+  Method    : /mgmt.MgmtSvc/PoolDestroy    
+  Caller    : admin                        
+  Peer      : 10.0.0.2:40000               
+  Status    : Unknown                      
+  Duration  : 2ms                          
+  Request   : {"id":"pool1"}               
+  Error     : pool busy                    
+
+`, ts2),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintSystemAuditListResponse(&bld, tc.resp, PrintWithVerboseOutput(tc.verbose)); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,94 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+)
+
+func rasEventRank(evt *events.RASEvent) string {
+	if evt.Rank == math.MaxUint32 {
+		return "-"
+	}
+	return fmt.Sprintf("%d", evt.Rank)
+}
+
+func printRASEventsVerbose(out io.Writer, evts []*events.RASEvent) {
+	for _, evt := range evts {
+		fmt.Fprintln(out, evt.PrintRAS())
+	}
+}
+
+func printRASEvents(out io.Writer, evts []*events.RASEvent) {
+	tsTitle := "Timestamp"
+	hostTitle := "Host"
+	rankTitle := "Rank"
+	sevTitle := "Severity"
+	idTitle := "Event"
+	msgTitle := "Message"
+
+	formatter := txtfmt.NewTableFormatter(tsTitle, hostTitle, rankTitle, sevTitle, idTitle, msgTitle)
+	var table []txtfmt.TableRow
+
+	for _, evt := range evts {
+		row := txtfmt.TableRow{tsTitle: evt.Timestamp}
+		row[hostTitle] = evt.Hostname
+		row[rankTitle] = rasEventRank(evt)
+		row[sevTitle] = evt.Severity.String()
+		row[idTitle] = evt.ID.String()
+		row[msgTitle] = evt.Msg
+
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+}
+
+// PrintSystemEventsListResponse generates a human-readable representation of the
+// supplied SystemEventsListResp struct and writes it to the supplied io.Writer.
+func PrintSystemEventsListResponse(out io.Writer, resp *control.SystemEventsListResp, opts ...PrintConfigOption) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	switch {
+	case len(resp.Events) == 0:
+		fmt.Fprintln(out, "No matching events found")
+	case getPrintConfig(opts...).Verbose:
+		printRASEventsVerbose(out, resp.Events)
+	default:
+		printRASEvents(out, resp.Events)
+	}
+
+	return nil
+}
+
+// PrintRASEvent generates a single-line human-readable representation of the
+// supplied RAS event and writes it to the supplied io.Writer.
+func PrintRASEvent(out io.Writer, evt *events.RASEvent, opts ...PrintConfigOption) error {
+	if evt == nil {
+		return errors.Errorf("nil %T", evt)
+	}
+
+	if getPrintConfig(opts...).Verbose {
+		printRASEventsVerbose(out, []*events.RASEvent{evt})
+		return nil
+	}
+
+	fmt.Fprintf(out, "%s %s rank %s %s %s: %s\n", evt.Timestamp, evt.Hostname,
+		rasEventRank(evt), evt.Severity, evt.ID, evt.Msg)
+
+	return nil
+}
@@ -0,0 +1,136 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+func TestPretty_PrintSystemEventsListResp(t *testing.T) {
+	evts := []*events.RASEvent{
+		{
+			ID:        events.RASEngineDied,
+			Timestamp: "2023-01-01T00:00:00Z",
+			Type:      events.RASTypeStateChange,
+			Severity:  events.RASSeverityError,
+			Msg:       "engine exited",
+			Hostname:  "foo",
+			Rank:      1,
+		},
+		{
+			ID:        events.RASSystemStopFailed,
+			Timestamp: "2023-01-01T00:01:00Z",
+			Type:      events.RASTypeInfoOnly,
+			Severity:  events.RASSeverityNotice,
+			Msg:       "stop failed",
+			Hostname:  "barbaz",
+			Rank:      math.MaxUint32,
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		resp        *control.SystemEventsListResp
+		verbose     bool
+		expPrintStr string
+	}{
+		"empty response": {
+			resp: &control.SystemEventsListResp{},
+			expPrintStr: `
+No matching events found
+`,
+		},
+		"normal response": {
+			resp: &control.SystemEventsListResp{
+				Events: evts,
+			},
+			expPrintStr: `
+Timestamp            Host   Rank Severity Event              Message       
+---------            ----   ---- -------- -----              -------       
+2023-01-01T00:00:00Z foo    1    ERROR    engine_died        engine exited 
+2023-01-01T00:01:00Z barbaz -    NOTICE   system_stop_failed stop failed   
+
+`,
+		},
+		"verbose response": {
+			resp: &control.SystemEventsListResp{
+				Events: evts[:1],
+			},
+			verbose:     true,
+			expPrintStr: "\n" + evts[0].PrintRAS() + "\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintSystemEventsListResponse(&bld, tc.resp, PrintWithVerboseOutput(tc.verbose)); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintRASEvent(t *testing.T) {
+	evt := &events.RASEvent{
+		ID:        events.RASEngineDied,
+		Timestamp: "2023-01-01T00:00:00Z",
+		Type:      events.RASTypeStateChange,
+		Severity:  events.RASSeverityError,
+		Msg:       "engine exited",
+		Hostname:  "foo",
+		Rank:      1,
+	}
+
+	for name, tc := range map[string]struct {
+		evt         *events.RASEvent
+		verbose     bool
+		expPrintStr string
+		expErr      bool
+	}{
+		"nil event": {
+			expErr: true,
+		},
+		"normal": {
+			evt: evt,
+			expPrintStr: `
+2023-01-01T00:00:00Z foo rank 1 ERROR engine_died: engine exited
+`,
+		},
+		"verbose": {
+			evt:         evt,
+			verbose:     true,
+			expPrintStr: "\n" + evt.PrintRAS() + "\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			err := PrintRASEvent(&bld, tc.evt, PrintWithVerboseOutput(tc.verbose))
+			if tc.expErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ package pretty
 import (
 	"fmt"
 	"io"
+	"strings"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/pkg/errors"
@@ -77,6 +79,45 @@ func PrintPoolQueryResponse(pqr *control.PoolQueryResp, out io.Writer, opts ...P
 	return w.Err
 }
 
+// PrintPoolRebuildProgress generates a single-line human-readable
+// representation of the supplied PoolRebuildProgress struct and writes it to
+// the supplied io.Writer.
+func PrintPoolRebuildProgress(out io.Writer, p *control.PoolRebuildProgress) error {
+	if p == nil {
+		return errors.Errorf("nil %T", p)
+	}
+	w := txtfmt.NewErrWriter(out)
+
+	fmt.Fprintf(w, "%s ", p.Timestamp.Format("15:04:05"))
+	switch {
+	case p.Failed():
+		fmt.Fprintf(w, "Rebuild failed, status=%d\n", p.Status)
+	case p.State == control.PoolRebuildStateDone:
+		fmt.Fprintf(w, "Rebuild done, %d objs, %d recs", p.Objects, p.Records)
+		if p.Elapsed > 0 {
+			fmt.Fprintf(w, " in %s", p.Elapsed.Round(time.Second))
+		}
+		fmt.Fprintln(w)
+	case p.State == control.PoolRebuildStateBusy:
+		if p.TotalObjects > 0 {
+			fmt.Fprintf(w, "Rebuild busy, %d/%d objs (%.1f%%)", p.Objects, p.TotalObjects, p.Percent)
+		} else {
+			fmt.Fprintf(w, "Rebuild busy, %d objs", p.Objects)
+		}
+		fmt.Fprintf(w, ", %d recs, %.1f objs/s", p.Records, p.ObjectRate)
+		if p.Remaining > 0 {
+			fmt.Fprintf(w, ", ETA %s", p.Remaining)
+		} else {
+			fmt.Fprint(w, ", ETA unknown")
+		}
+		fmt.Fprintln(w)
+	default:
+		fmt.Fprintf(w, "Rebuild %s, no rebuild in progress\n", p.State)
+	}
+
+	return w.Err
+}
+
 // PrintPoolQueryTargetResponse generates a human-readable representation of the supplied
 // PoolQueryTargetResp struct and writes it to the supplied io.Writer.
 func PrintPoolQueryTargetResponse(pqtr *control.PoolQueryTargetResp, out io.Writer, opts ...PrintConfigOption) error {
@@ -159,6 +200,97 @@ func PrintPoolCreateResponse(pcr *control.PoolCreateResp, out io.Writer, opts ..
 	return err
 }
 
+func poolCreatePlanTierFree(plan *control.PoolCreatePlanResp, rp *control.PoolCreateRankPlan, tierIdx int) (string, string) {
+	if tierIdx >= len(rp.TierFree) {
+		return "unknown", "unknown"
+	}
+	free := humanize.Bytes(rp.TierFree[tierIdx])
+	if tierIdx < len(plan.TierBytes) && plan.TierBytes[tierIdx] > rp.TierFree[tierIdx] {
+		return free, "insufficient"
+	}
+	return free, humanize.Bytes(rp.TierFreeAfter[tierIdx])
+}
+
+// PrintPoolCreatePlanResponse generates a human-readable representation of the
+// pool create plan response and prints it to the supplied io.Writer.
+func PrintPoolCreatePlanResponse(plan *control.PoolCreatePlanResp, out io.Writer, opts ...PrintConfigOption) error {
+	if plan == nil {
+		return errors.New("nil response")
+	}
+	if len(plan.TierBytes) == 0 {
+		return errors.New("create plan had 0 storage tiers")
+	}
+	if len(plan.TgtRanks) == 0 {
+		return errors.New("create plan had 0 target ranks")
+	}
+
+	var totalSize uint64
+	for _, tierBytes := range plan.TierBytes {
+		totalSize += tierBytes
+	}
+
+	numRanks := uint64(len(plan.TgtRanks))
+	fmtArgs := make([]txtfmt.TableRow, 0, 7)
+	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Storage Ranks": formatRanks(plan.TgtRanks)})
+	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Targets per Rank": fmt.Sprintf("%d", plan.TgtsPerRank)})
+	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Fault Domains": strings.Join(plan.FaultDomains, ",")})
+	fmtArgs = append(fmtArgs, txtfmt.TableRow{"Total Size": humanize.Bytes(totalSize * numRanks)})
+
+	title := "Pool would be created with "
+	tierName := "SCM"
+	for tierIdx, tierBytes := range plan.TierBytes {
+		if tierIdx > 0 {
+			title += ","
+			tierName = "NVMe"
+		}
+
+		var tierRatio float64
+		if tierIdx < len(plan.TierRatio) {
+			tierRatio = plan.TierRatio[tierIdx]
+		}
+		var tgtBytes uint64
+		if tierIdx < len(plan.TgtTierBytes) {
+			tgtBytes = plan.TgtTierBytes[tierIdx]
+		}
+
+		title += PrintTierRatio(tierRatio)
+		fmtName := fmt.Sprintf("Storage tier %d (%s)", tierIdx, tierName)
+		fmtArgs = append(fmtArgs, txtfmt.TableRow{fmtName: fmt.Sprintf("%s (%s / rank, %s / target)",
+			humanize.Bytes(tierBytes*numRanks), humanize.Bytes(tierBytes), humanize.Bytes(tgtBytes))})
+	}
+	title += " storage tier ratio"
+	if plan.MetaBytes > 0 {
+		fmtArgs = append(fmtArgs, txtfmt.TableRow{"Meta Blob Size": fmt.Sprintf("%s / rank", humanize.Bytes(plan.MetaBytes))})
+	}
+
+	if _, err := fmt.Fprintln(out, txtfmt.FormatEntity(title, fmtArgs)); err != nil {
+		return err
+	}
+
+	if len(plan.Ranks) == 0 {
+		return nil
+	}
+
+	rankTitle := "Rank"
+	scmFreeTitle := "SCM Free"
+	scmAfterTitle := "SCM Free After"
+	nvmeFreeTitle := "NVMe Free"
+	nvmeAfterTitle := "NVMe Free After"
+
+	formatter := txtfmt.NewTableFormatter(rankTitle, scmFreeTitle, scmAfterTitle,
+		nvmeFreeTitle, nvmeAfterTitle)
+	var table []txtfmt.TableRow
+	for _, rp := range plan.Ranks {
+		row := txtfmt.TableRow{rankTitle: rp.Rank.String()}
+		row[scmFreeTitle], row[scmAfterTitle] = poolCreatePlanTierFree(plan, rp, 0)
+		row[nvmeFreeTitle], row[nvmeAfterTitle] = poolCreatePlanTierFree(plan, rp, 1)
+		table = append(table, row)
+	}
+
+	_, err := fmt.Fprintln(out, formatter.Format(table))
+	return err
+}
+
 func poolListCreateRow(pool *control.Pool, upgrade bool) txtfmt.TableRow {
 	// display size of the largest non-empty tier
 	var size uint64
@@ -276,6 +408,7 @@ func poolListCreateRowVerbose(pool *control.Pool) txtfmt.TableRow {
 		"Disabled":       fmt.Sprintf("%d/%d", pool.TargetsDisabled, pool.TargetsTotal),
 		"UpgradeNeeded?": upgrade,
 		"Rebuild State":  pool.RebuildState,
+		"Tags":           pool.Tags.String(),
 	}
 
 	for _, tu := range pool.Usage {
@@ -304,6 +437,12 @@ func printListPoolsRespVerbose(noQuery bool, out io.Writer, resp *control.ListPo
 	if !noQuery {
 		titles = append(titles, "Rebuild State")
 	}
+	for _, pool := range resp.Pools {
+		if len(pool.Tags) > 0 {
+			titles = append(titles, "Tags")
+			break
+		}
+	}
 	formatter := txtfmt.NewTableFormatter(titles...)
 
 	var table []txtfmt.TableRow
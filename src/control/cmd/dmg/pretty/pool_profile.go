@@ -0,0 +1,131 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/txtfmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func poolProfileSize(pp *system.PoolProfile) string {
+	switch {
+	case pp.TotalBytes > 0:
+		return humanize.Bytes(pp.TotalBytes)
+	case pp.SizeRatio > 0:
+		return fmt.Sprintf("%d%%", pp.SizeRatio)
+	default:
+		return "-"
+	}
+}
+
+func poolProfileTierRatio(pp *system.PoolProfile) string {
+	if len(pp.TierRatio) == 0 {
+		return "-"
+	}
+
+	ratios := make([]string, 0, len(pp.TierRatio))
+	for _, ratio := range pp.TierRatio {
+		ratios = append(ratios, PrintTierRatio(ratio))
+	}
+	return strings.Join(ratios, ",")
+}
+
+func poolProfileRanks(pp *system.PoolProfile) string {
+	switch {
+	case pp.Ranks != "":
+		return pp.Ranks
+	case pp.NumRanks > 0:
+		return fmt.Sprintf("any %d", pp.NumRanks)
+	default:
+		return "all"
+	}
+}
+
+// PrintPoolProfileListResponse generates a human-readable representation of
+// the supplied PoolProfileListResp struct and writes it to the supplied
+// io.Writer.
+func PrintPoolProfileListResponse(out io.Writer, resp *control.PoolProfileListResp) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	if len(resp.Profiles) == 0 {
+		fmt.Fprintln(out, "No pool profiles defined")
+		return nil
+	}
+
+	nameTitle := "Profile"
+	sizeTitle := "Size"
+	ratioTitle := "Tier Ratio"
+	ranksTitle := "Ranks"
+	descTitle := "Description"
+
+	formatter := txtfmt.NewTableFormatter(nameTitle, sizeTitle, ratioTitle, ranksTitle, descTitle)
+	var table []txtfmt.TableRow
+
+	for _, pp := range resp.Profiles {
+		table = append(table, txtfmt.TableRow{
+			nameTitle:  pp.Name,
+			sizeTitle:  poolProfileSize(pp),
+			ratioTitle: poolProfileTierRatio(pp),
+			ranksTitle: poolProfileRanks(pp),
+			descTitle:  pp.Description,
+		})
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+
+	return nil
+}
+
+// PrintPoolProfile generates a human-readable representation of the supplied
+// pool profile and writes it to the supplied io.Writer.
+func PrintPoolProfile(out io.Writer, pp *system.PoolProfile) error {
+	if pp == nil {
+		return errors.Errorf("nil %T", pp)
+	}
+
+	svcReps := "default"
+	if pp.NumSvcReps > 0 {
+		svcReps = fmt.Sprintf("%d", pp.NumSvcReps)
+	}
+
+	attrs := []txtfmt.TableRow{
+		{"Description": pp.Description},
+		{"Size": poolProfileSize(pp)},
+		{"Tier Ratio": poolProfileTierRatio(pp)},
+		{"Ranks": poolProfileRanks(pp)},
+		{"Service Replicas": svcReps},
+	}
+	fmt.Fprintln(out, txtfmt.FormatEntity(fmt.Sprintf("Pool profile %s", pp.Name), attrs))
+
+	fmt.Fprintln(out, "Properties:")
+	if len(pp.Properties) == 0 {
+		fmt.Fprintln(out, "  None")
+	}
+	for _, name := range pp.PropertyNames() {
+		fmt.Fprintf(out, "  %s:%s\n", name, pp.Properties[name])
+	}
+
+	fmt.Fprintln(out, "ACL:")
+	if len(pp.ACL) == 0 {
+		fmt.Fprintln(out, "  None")
+	}
+	for _, ace := range pp.ACL {
+		fmt.Fprintf(out, "  %s\n", ace)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package pretty
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockPoolProfiles() []*system.PoolProfile {
+	return []*system.PoolProfile{
+		{
+			Name:        "archive",
+			Description: "long-term archive",
+			TotalBytes:  100000000000000,
+			TierRatio:   []float64{0.03, 0.97},
+			NumRanks:    4,
+		},
+		{
+			Name:        "scratch",
+			Description: "scratch pools",
+			Properties: map[string]string{
+				"reclaim": "lazy",
+				"rd_fac":  "1",
+			},
+			ACL:        []string{"A::OWNER@:rw", "A:G:GROUP@:r"},
+			SizeRatio:  10,
+			NumSvcReps: 3,
+			Ranks:      "0-3,8",
+		},
+		{
+			Name: "plain",
+		},
+	}
+}
+
+func TestPretty_PrintPoolProfileListResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.PoolProfileListResp
+		expPrintStr string
+	}{
+		"empty response": {
+			resp: &control.PoolProfileListResp{},
+			expPrintStr: `
+No pool profiles defined
+`,
+		},
+		"profiles": {
+			resp: &control.PoolProfileListResp{
+				Profiles: mockPoolProfiles(),
+			},
+			expPrintStr: `
+Profile Size   Tier Ratio   Ranks Description       
+------- ----   ----------   ----- -----------       
+archive 100 TB 3.00%,97.00% any 4 long-term archive 
+scratch 10%    -            0-3,8 scratch pools     
+plain   -      -            all                     
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintPoolProfileListResponse(&bld, tc.resp); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintPoolProfile(t *testing.T) {
+	for name, tc := range map[string]struct {
+		profile     *system.PoolProfile
+		expPrintStr string
+	}{
+		"full profile": {
+			profile: mockPoolProfiles()[1],
+			expPrintStr: `
+Pool profile scratch
+--------------------
+  Description      : scratch pools    
+  Size             : 10%              
+  Tier Ratio       : -                
+  Ranks            : 0-3,8            
+  Service Replicas : 3                
+
+Properties:
+  rd_fac:1
+  reclaim:lazy
+ACL:
+  A::OWNER@:rw
+  A:G:GROUP@:r
+`,
+		},
+		"empty profile": {
+			profile: mockPoolProfiles()[2],
+			expPrintStr: `
+Pool profile plain
+------------------
+  Description      :                  
+  Size             : -                
+  Tier Ratio       : -                
+  Ranks            : all              
+  Service Replicas : default          
+
+Properties:
+  None
+ACL:
+  None
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			if err := PrintPoolProfile(&bld, tc.profile); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
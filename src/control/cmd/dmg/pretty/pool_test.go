@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -272,6 +273,96 @@ Rebuild failed, rc=0, status=2
 	}
 }
 
+func TestPretty_PrintPoolRebuildProgress(t *testing.T) {
+	ts := time.Date(2023, 6, 1, 12, 30, 15, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		progress    *control.PoolRebuildProgress
+		expPrintStr string
+		expErr      error
+	}{
+		"nil progress": {
+			expErr: errors.New("nil *control.PoolRebuildProgress"),
+		},
+		"idle": {
+			progress: &control.PoolRebuildProgress{
+				Timestamp: ts,
+				State:     control.PoolRebuildStateIdle,
+			},
+			expPrintStr: "12:30:15 Rebuild idle, no rebuild in progress\n",
+		},
+		"busy first sample": {
+			progress: &control.PoolRebuildProgress{
+				Timestamp:    ts,
+				State:        control.PoolRebuildStateBusy,
+				Objects:      10,
+				Records:      100,
+				TotalObjects: 40,
+				Percent:      25,
+			},
+			expPrintStr: "12:30:15 Rebuild busy, 10/40 objs (25.0%), 100 recs, 0.0 objs/s, ETA unknown\n",
+		},
+		"busy with eta": {
+			progress: &control.PoolRebuildProgress{
+				Timestamp:    ts,
+				State:        control.PoolRebuildStateBusy,
+				Objects:      60,
+				Records:      600,
+				TotalObjects: 110,
+				Percent:      float64(60) * 100 / 110,
+				ObjectRate:   5,
+				RecordRate:   50,
+				Elapsed:      10 * time.Second,
+				Remaining:    90 * time.Second,
+			},
+			expPrintStr: "12:30:15 Rebuild busy, 60/110 objs (54.5%), 600 recs, 5.0 objs/s, ETA 1m30s\n",
+		},
+		"busy without total": {
+			progress: &control.PoolRebuildProgress{
+				Timestamp:  ts,
+				State:      control.PoolRebuildStateBusy,
+				Objects:    60,
+				Records:    600,
+				ObjectRate: 2.5,
+			},
+			expPrintStr: "12:30:15 Rebuild busy, 60 objs, 600 recs, 2.5 objs/s, ETA unknown\n",
+		},
+		"done": {
+			progress: &control.PoolRebuildProgress{
+				Timestamp:    ts,
+				State:        control.PoolRebuildStateDone,
+				Objects:      110,
+				Records:      1100,
+				TotalObjects: 110,
+				Percent:      100,
+				Elapsed:      22*time.Second + 400*time.Millisecond,
+			},
+			expPrintStr: "12:30:15 Rebuild done, 110 objs, 1100 recs in 22s\n",
+		},
+		"failed": {
+			progress: &control.PoolRebuildProgress{
+				Timestamp: ts,
+				State:     control.PoolRebuildStateDone,
+				Status:    -1012,
+			},
+			expPrintStr: "12:30:15 Rebuild failed, status=-1012\n",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintPoolRebuildProgress(&bld, tc.progress)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expPrintStr, bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestPretty_PrintPoolQueryTargetResp(t *testing.T) {
 	for name, tc := range map[string]struct {
 		pqtr        *control.PoolQueryTargetResp
@@ -958,6 +1049,104 @@ Pool created with 100.00%% storage tier ratio
 	}
 }
 
+func TestPretty_PrintPoolCreatePlanResponse(t *testing.T) {
+	mockPlan := func() *control.PoolCreatePlanResp {
+		return &control.PoolCreatePlanResp{
+			TgtRanks:     []uint32{0, 1, 2},
+			TgtsPerRank:  8,
+			TierBytes:    []uint64{6 * humanize.GByte, 94 * humanize.GByte},
+			TgtTierBytes: []uint64{750 * humanize.MByte, 11750 * humanize.MByte},
+			TierRatio:    []float64{0.06, 0.94},
+			FaultDomains: []string{"/rack0/node0", "/rack0/node1", "/rack1/node2"},
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		plan        *control.PoolCreatePlanResp
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil response"),
+		},
+		"empty response": {
+			plan:   &control.PoolCreatePlanResp{},
+			expErr: errors.New("0 storage tiers"),
+		},
+		"no rank free space": {
+			plan: func() *control.PoolCreatePlanResp {
+				plan := mockPlan()
+				plan.MetaBytes = 10 * humanize.GByte
+				return plan
+			}(),
+			expPrintStr: `
+Pool would be created with 6.00%,94.00% storage tier ratio
+----------------------------------------------------------
+  Storage Ranks        : [0-2]                                 
+  Targets per Rank     : 8                                     
+  Fault Domains        : /rack0/node0,/rack0/node1,/rack1/node2
+  Total Size           : 300 GB                                
+  Storage tier 0 (SCM) : 18 GB (6.0 GB / rank, 750 MB / target)
+  Storage tier 1 (NVMe): 282 GB (94 GB / rank, 12 GB / target) 
+  Meta Blob Size       : 10 GB / rank                          
+
+`,
+		},
+		"rank free space": {
+			plan: func() *control.PoolCreatePlanResp {
+				plan := mockPlan()
+				plan.Ranks = []*control.PoolCreateRankPlan{
+					{
+						Rank:          0,
+						TierFree:      []uint64{100 * humanize.GByte, humanize.TByte},
+						TierFreeAfter: []uint64{94 * humanize.GByte, 906 * humanize.GByte},
+						Fits:          true,
+					},
+					{
+						Rank:          1,
+						TierFree:      []uint64{4 * humanize.GByte, humanize.TByte},
+						TierFreeAfter: []uint64{0, 906 * humanize.GByte},
+					},
+					{
+						Rank: 2,
+					},
+				}
+				return plan
+			}(),
+			expPrintStr: `
+Pool would be created with 6.00%,94.00% storage tier ratio
+----------------------------------------------------------
+  Storage Ranks        : [0-2]                                 
+  Targets per Rank     : 8                                     
+  Fault Domains        : /rack0/node0,/rack0/node1,/rack1/node2
+  Total Size           : 300 GB                                
+  Storage tier 0 (SCM) : 18 GB (6.0 GB / rank, 750 MB / target)
+  Storage tier 1 (NVMe): 282 GB (94 GB / rank, 12 GB / target) 
+
+Rank SCM Free SCM Free After NVMe Free NVMe Free After 
+---- -------- -------------- --------- --------------- 
+0    100 GB   94 GB          1.0 TB    906 GB          
+1    4.0 GB   insufficient   1.0 TB    906 GB          
+2    unknown  unknown        unknown   unknown         
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintPoolCreatePlanResponse(tc.plan, &bld)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestPretty_PrintListPoolsResponse(t *testing.T) {
 	exampleUsage := []*control.PoolTierUsage{
 		{
@@ -1266,6 +1455,39 @@ Label UUID                                 State      SvcReps SCM Size SCM Used
 one   00000001-0001-0001-0001-000000000001 Ready      [0-2]   100 GB   80 GB    12%           6.0 TB    5.0 TB    1%             0/16     1->2           idle          
 two   00000002-0002-0002-0002-000000000002 Destroying [3-5]   100 GB   80 GB    12%           6.0 TB    5.0 TB    1%             8/64     None           done          
 
+`,
+		},
+		"verbose; two pools; one tagged": {
+			resp: &control.ListPoolsResp{
+				Pools: []*control.Pool{
+					{
+						Label:           "one",
+						UUID:            test.MockUUID(1),
+						ServiceReplicas: []ranklist.Rank{0, 1, 2},
+						Usage:           exampleUsage,
+						TargetsTotal:    16,
+						State:           system.PoolServiceStateReady.String(),
+						RebuildState:    "idle",
+						Tags:            system.PoolTags{"project": "climate", "owner": "bob"},
+					},
+					{
+						Label:           "two",
+						UUID:            test.MockUUID(2),
+						ServiceReplicas: []ranklist.Rank{3, 4, 5},
+						Usage:           exampleUsage,
+						TargetsTotal:    16,
+						State:           system.PoolServiceStateReady.String(),
+						RebuildState:    "idle",
+					},
+				},
+			},
+			verbose: true,
+			expPrintStr: `
+Label UUID                                 State SvcReps SCM Size SCM Used SCM Imbalance NVME Size NVME Used NVME Imbalance Disabled UpgradeNeeded? Rebuild State Tags                      
+----- ----                                 ----- ------- -------- -------- ------------- --------- --------- -------------- -------- -------------- ------------- ----                      
+one   00000001-0001-0001-0001-000000000001 Ready [0-2]   100 GB   80 GB    12%           6.0 TB    5.0 TB    1%             0/16     None           idle          owner=bob,project=climate 
+two   00000002-0002-0002-0002-000000000002 Ready [3-5]   100 GB   80 GB    12%           6.0 TB    5.0 TB    1%             0/16     None           idle                                    
+
 `,
 		},
 		"verbose; one pools; rebuild state busy": {
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,7 +10,9 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
+	"github.com/dustin/go-humanize"
 	"github.com/dustin/go-humanize/english"
 	"github.com/pkg/errors"
 
@@ -226,3 +228,129 @@ func PrintSystemCleanupResponse(out, outErr io.Writer, resp *control.SystemClean
 	fmt.Fprintln(out, "System Cleanup Success")
 	return nil
 }
+
+// PrintSystemListBackupsResponse generates a human-readable representation of
+// the supplied SystemListBackupsResp struct and writes it to the supplied
+// io.Writer.
+func PrintSystemListBackupsResponse(out io.Writer, resp *control.SystemListBackupsResp) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	fmt.Fprintf(out, "Backups on %s in %s:\n", resp.Leader, resp.Dir)
+	if len(resp.Backups) == 0 {
+		fmt.Fprintln(out, "  No backups found")
+		return nil
+	}
+
+	nameTitle := "Name"
+	timeTitle := "Time"
+	indexTitle := "Index"
+	sizeTitle := "Size"
+	statusTitle := "Status"
+
+	formatter := txtfmt.NewTableFormatter(nameTitle, timeTitle, indexTitle, sizeTitle, statusTitle)
+	var table []txtfmt.TableRow
+
+	for _, bd := range resp.Backups {
+		row := txtfmt.TableRow{nameTitle: bd.Name}
+		row[timeTitle] = bd.Time.UTC().Format(time.RFC3339)
+		row[indexTitle] = fmt.Sprintf("%d", bd.Index)
+		row[sizeTitle] = humanize.IBytes(uint64(bd.Size))
+		row[statusTitle] = "OK"
+		if bd.Error != "" {
+			row[statusTitle] = "INVALID: " + bd.Error
+		}
+
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+
+	return nil
+}
+
+// PrintSystemDrainResponse generates a human-readable representation of the
+// supplied SystemDrainResp struct and writes it to the supplied io.Writer.
+func PrintSystemDrainResponse(out io.Writer, resp *control.SystemDrainResp) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	if len(resp.Results) == 0 {
+		fmt.Fprintln(out, "No pools have targets on the specified ranks")
+		return nil
+	}
+
+	poolTitle := "Pool"
+	ranksTitle := "Ranks"
+	resultTitle := "Result"
+	reasonTitle := "Reason"
+
+	formatter := txtfmt.NewTableFormatter(poolTitle, ranksTitle, resultTitle, reasonTitle)
+	var table []txtfmt.TableRow
+
+	for _, r := range resp.Results {
+		row := txtfmt.TableRow{
+			poolTitle:   r.PoolID,
+			ranksTitle:  r.Ranks,
+			resultTitle: "OK",
+			reasonTitle: "-",
+		}
+		if r.Status != 0 {
+			row[resultTitle] = "FAIL"
+			row[reasonTitle] = r.Msg
+		}
+
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+
+	return nil
+}
+
+// PrintSystemMaintenanceResponse generates a human-readable representation of
+// the supplied SystemMaintenanceResp struct and writes it to the supplied
+// io.Writer.
+func PrintSystemMaintenanceResponse(out io.Writer, resp *control.SystemMaintenanceResp) error {
+	if resp == nil {
+		return errors.Errorf("nil %T", resp)
+	}
+
+	if len(resp.Members) == 0 {
+		fmt.Fprintln(out, "No ranks are undergoing maintenance")
+		return nil
+	}
+
+	rankTitle := "Rank"
+	addrTitle := "Address"
+	stateTitle := "State"
+	phaseTitle := "Phase"
+	poolsTitle := "Pools"
+	errorTitle := "Error"
+
+	formatter := txtfmt.NewTableFormatter(rankTitle, addrTitle, stateTitle, phaseTitle,
+		poolsTitle, errorTitle)
+	var table []txtfmt.TableRow
+
+	for _, m := range resp.Members {
+		row := txtfmt.TableRow{
+			rankTitle:  m.Rank.String(),
+			addrTitle:  m.Addr,
+			stateTitle: m.State,
+			phaseTitle: m.Phase,
+			poolsTitle: fmt.Sprintf("%d", len(m.Pools)),
+			errorTitle: "-",
+		}
+		if m.Error != "" {
+			row[errorTitle] = m.Error
+		}
+
+		table = append(table, row)
+	}
+
+	fmt.Fprintln(out, formatter.Format(table))
+
+	return nil
+}
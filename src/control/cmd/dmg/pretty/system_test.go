@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +10,7 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -523,3 +524,173 @@ Unknown 3 hosts: foo[7-9]
 		})
 	}
 }
+
+func TestPretty_PrintSystemListBackupsResp(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemListBackupsResp
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no backups": {
+			resp: &control.SystemListBackupsResp{
+				Leader: "10.0.0.1:10001",
+				Dir:    "/backups",
+			},
+			expPrintStr: `
+Backups on 10.0.0.1:10001 in /backups:
+  No backups found
+`,
+		},
+		"backups": {
+			resp: &control.SystemListBackupsResp{
+				Leader: "10.0.0.1:10001",
+				Dir:    "/backups",
+				Backups: []*control.SystemBackup{
+					{
+						Name:  "backup-20230101T030405Z-2-40",
+						Time:  time.Date(2023, 1, 1, 3, 4, 5, 0, time.UTC),
+						Index: 40,
+						Size:  2048,
+					},
+					{
+						Name:  "backup-20230102T030405Z-2-42",
+						Time:  time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+						Index: 42,
+						Size:  4096,
+						Error: "checksum mismatch",
+					},
+				},
+			},
+			expPrintStr: `
+Backups on 10.0.0.1:10001 in /backups:
+Name                         Time                 Index Size    Status                     
+----                         ----                 ----- ----    ------                     
+backup-20230101T030405Z-2-40 2023-01-01T03:04:05Z 40    2.0 KiB OK                         
+backup-20230102T030405Z-2-42 2023-01-02T03:04:05Z 42    4.0 KiB INVALID: checksum mismatch 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintSystemListBackupsResponse(&bld, tc.resp)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemDrainResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemDrainResp
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no results": {
+			resp: &control.SystemDrainResp{},
+			expPrintStr: `
+No pools have targets on the specified ranks
+`,
+		},
+		"results": {
+			resp: &control.SystemDrainResp{
+				Results: control.PoolRankResults{
+					{PoolID: "pool-a", Ranks: "0,2"},
+					{Status: -1, Msg: "not found", PoolID: "pool-a", Ranks: "1"},
+					{PoolID: "pool-b", Ranks: "2"},
+				},
+			},
+			expPrintStr: `
+Pool   Ranks Result Reason    
+----   ----- ------ ------    
+pool-a 0,2   OK     -         
+pool-a 1     FAIL   not found 
+pool-b 2     OK     -         
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintSystemDrainResponse(&bld, tc.resp)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestPretty_PrintSystemMaintenanceResponse(t *testing.T) {
+	for name, tc := range map[string]struct {
+		resp        *control.SystemMaintenanceResp
+		expPrintStr string
+		expErr      error
+	}{
+		"nil response": {
+			expErr: errors.New("nil"),
+		},
+		"no members": {
+			resp: &control.SystemMaintenanceResp{},
+			expPrintStr: `
+No ranks are undergoing maintenance
+`,
+		},
+		"members": {
+			resp: &control.SystemMaintenanceResp{
+				Members: []*control.MaintenanceMember{
+					{
+						Rank:  1,
+						Addr:  "10.0.0.1:10001",
+						State: "stopped",
+						Phase: "serviceable",
+						Pools: []string{test.MockUUID(1), test.MockUUID(2)},
+					},
+					{
+						Rank:  3,
+						Addr:  "10.0.0.2:10001",
+						State: "joined",
+						Phase: "draining",
+						Error: "drain from pool x: busy",
+					},
+				},
+			},
+			expPrintStr: `
+Rank Address        State   Phase       Pools Error                   
+---- -------        -----   -----       ----- -----                   
+1    10.0.0.1:10001 stopped serviceable 2     -                       
+3    10.0.0.2:10001 joined  draining    0     drain from pool x: busy 
+
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var bld strings.Builder
+			gotErr := PrintSystemMaintenanceResponse(&bld, tc.resp)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(strings.TrimLeft(tc.expPrintStr, "\n"), bld.String()); diff != "" {
+				t.Fatalf("unexpected format string (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/jessevdk/go-flags"
 	"github.com/pkg/errors"
@@ -26,20 +27,27 @@ import (
 
 // SystemCmd is the struct representing the top-level system subcommand.
 type SystemCmd struct {
-	LeaderQuery  leaderQueryCmd        `command:"leader-query" description:"Query for current Management Service leader"`
-	Query        systemQueryCmd        `command:"query" description:"Query DAOS system status"`
-	Stop         systemStopCmd         `command:"stop" description:"Perform controlled shutdown of DAOS system"`
-	Start        systemStartCmd        `command:"start" description:"Perform start of stopped DAOS system"`
-	Exclude      systemExcludeCmd      `command:"exclude" description:"Exclude ranks from DAOS system"`
-	ClearExclude systemClearExcludeCmd `command:"clear-exclude" description:"Clear excluded state for ranks"`
-	Erase        systemEraseCmd        `command:"erase" description:"Erase system metadata prior to reformat"`
-	ListPools    PoolListCmd           `command:"list-pools" description:"List all pools in the DAOS system"`
-	Cleanup      systemCleanupCmd      `command:"cleanup" description:"Clean up all resources associated with the specified machine"`
-	SetAttr      systemSetAttrCmd      `command:"set-attr" description:"Set system attributes"`
-	GetAttr      systemGetAttrCmd      `command:"get-attr" description:"Get system attributes"`
-	DelAttr      systemDelAttrCmd      `command:"del-attr" description:"Delete system attributes"`
-	SetProp      systemSetPropCmd      `command:"set-prop" description:"Set system properties"`
-	GetProp      systemGetPropCmd      `command:"get-prop" description:"Get system properties"`
+	LeaderQuery    leaderQueryCmd        `command:"leader-query" description:"Query for current Management Service leader"`
+	LeaderTransfer leaderTransferCmd     `command:"leader-transfer" description:"Transfer Management Service leadership to another replica"`
+	Query          systemQueryCmd        `command:"query" description:"Query DAOS system status"`
+	Stop           systemStopCmd         `command:"stop" description:"Perform controlled shutdown of DAOS system"`
+	Start          systemStartCmd        `command:"start" description:"Perform start of stopped DAOS system"`
+	Exclude        systemExcludeCmd      `command:"exclude" description:"Exclude ranks from DAOS system"`
+	ClearExclude   systemClearExcludeCmd `command:"clear-exclude" description:"Clear excluded state for ranks"`
+	Drain          systemDrainCmd        `command:"drain" description:"Drain ranks from all pools with targets on them"`
+	Reintegrate    systemReintCmd        `command:"reintegrate" alias:"reint" description:"Reintegrate ranks into all pools with targets on them"`
+	Maintenance    systemMaintenanceCmd  `command:"maintenance" description:"Perform rolling maintenance of ranks"`
+	Erase          systemEraseCmd        `command:"erase" description:"Erase system metadata prior to reformat"`
+	ListPools      PoolListCmd           `command:"list-pools" description:"List all pools in the DAOS system"`
+	Cleanup        systemCleanupCmd      `command:"cleanup" description:"Clean up all resources associated with the specified machine"`
+	SetAttr        systemSetAttrCmd      `command:"set-attr" description:"Set system attributes"`
+	GetAttr        systemGetAttrCmd      `command:"get-attr" description:"Get system attributes"`
+	DelAttr        systemDelAttrCmd      `command:"del-attr" description:"Delete system attributes"`
+	SetProp        systemSetPropCmd      `command:"set-prop" description:"Set system properties"`
+	GetProp        systemGetPropCmd      `command:"get-prop" description:"Get system properties"`
+	Events         systemEventsCmd       `command:"events" description:"Query RAS events recorded by the management service"`
+	MS             systemMSCmd           `command:"ms" description:"Manage the management service replicas"`
+	Audit          systemAuditCmd        `command:"audit" description:"Query the audit trail of mutating control-plane requests"`
 }
 
 type leaderQueryCmd struct {
@@ -81,6 +89,34 @@ func (cmd *leaderQueryCmd) Execute(_ []string) (errOut error) {
 	return nil
 }
 
+type leaderTransferCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	To string `long:"to" description:"Replica to transfer leadership to (default: most up-to-date replica)"`
+}
+
+func (cmd *leaderTransferCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "leader transfer failed")
+	}()
+
+	req := &control.SystemLeaderTransferReq{Target: cmd.To}
+	resp, err := control.SystemLeaderTransfer(context.Background(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("Leadership transferred from %s to %s in %s\n", resp.OldLeader, resp.NewLeader,
+		resp.Elapsed.Round(time.Millisecond))
+
+	return nil
+}
+
 // rankListCmd enables rank or host list to be supplied with command to filter
 // which ranks are operated upon.
 type rankListCmd struct {
@@ -269,6 +305,136 @@ func (cmd *systemClearExcludeCmd) Execute(_ []string) error {
 	return cmd.execute(true)
 }
 
+// drainWaitInterval is the interval between pool queries when waiting for the
+// rebuilds resulting from a system drain or reintegrate to complete.
+const drainWaitInterval = 5 * time.Second
+
+// drainRebuildStartTimeout is the time allowed for the rebuilds to start after
+// a drain or reintegrate before a completed rebuild status is accepted. It is
+// measured from the drain request, not from the start of each pool's wait.
+const drainRebuildStartTimeout = time.Minute
+
+type baseDrainCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	rankListCmd
+	Wait bool `long:"wait" short:"w" description:"Wait for the resulting pool rebuilds to complete"`
+}
+
+// waitRebuilds waits for the rebuild of each pool that was successfully
+// drained or reintegrated to complete. The rebuilds are all expected to have
+// started by the supplied deadline.
+func (cmd *baseDrainCmd) waitRebuilds(ctx context.Context, resp *control.SystemDrainResp, startDeadline time.Time) error {
+	var pools []string
+	seen := make(map[string]bool)
+	for _, r := range resp.Results {
+		if r.Status != int32(daos.Success) || seen[r.PoolID] {
+			continue
+		}
+		seen[r.PoolID] = true
+		pools = append(pools, r.PoolID)
+	}
+
+	var failed []string
+	for _, poolID := range pools {
+		req := &control.PoolRebuildWatchReq{
+			ID:            poolID,
+			Interval:      drainWaitInterval,
+			StartDeadline: startDeadline,
+		}
+		err := control.PoolRebuildWatch(ctx, cmd.ctlInvoker, req, func(p *control.PoolRebuildProgress) {
+			if cmd.JSONOutputEnabled() {
+				return
+			}
+			var out strings.Builder
+			if err := pretty.PrintPoolRebuildProgress(&out, p); err != nil {
+				cmd.Error(err.Error())
+				return
+			}
+			cmd.Infof("%s: %s", poolID, strings.TrimSuffix(out.String(), "\n"))
+		})
+		if err != nil {
+			cmd.Errorf("%s: %s", poolID, err)
+			failed = append(failed, poolID)
+		}
+	}
+
+	if len(failed) > 0 {
+		return errors.Errorf("rebuild failed for pool(s): %s", strings.Join(failed, ", "))
+	}
+
+	return nil
+}
+
+func (cmd *baseDrainCmd) execute(reint bool) error {
+	if err := cmd.validateHostsRanks(); err != nil {
+		return err
+	}
+	if cmd.Ranks.Count() == 0 && cmd.Hosts.Count() == 0 {
+		return errors.New("no ranks or hosts specified")
+	}
+
+	req := &control.SystemDrainReq{Reint: reint}
+	req.Hosts.Replace(&cmd.Hosts.HostSet)
+	req.Ranks.Replace(&cmd.Ranks.RankSet)
+
+	ctx := context.Background()
+	startDeadline := time.Now().Add(drainRebuildStartTimeout)
+	resp, err := control.SystemDrain(ctx, cmd.ctlInvoker, req)
+	if err != nil {
+		return err // control api returned an error, disregard response
+	}
+
+	if !cmd.JSONOutputEnabled() {
+		var out strings.Builder
+		if err := pretty.PrintSystemDrainResponse(&out, resp); err != nil {
+			return err
+		}
+		cmd.Info(out.String())
+	}
+
+	outErr := resp.Errors()
+	if cmd.Wait {
+		if err := cmd.waitRebuilds(ctx, resp, startDeadline); err != nil && outErr == nil {
+			outErr = err
+		}
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, outErr)
+	}
+
+	return outErr
+}
+
+type systemDrainCmd struct {
+	baseDrainCmd
+}
+
+// Execute is run when systemDrainCmd activates.
+func (cmd *systemDrainCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system drain failed")
+	}()
+
+	return cmd.execute(false)
+}
+
+type systemReintCmd struct {
+	baseDrainCmd
+}
+
+// Execute is run when systemReintCmd activates.
+func (cmd *systemReintCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system reintegrate failed")
+	}()
+
+	return cmd.execute(true)
+}
+
 // systemStartCmd is the struct representing the command to start system.
 type systemStartCmd struct {
 	baseCmd
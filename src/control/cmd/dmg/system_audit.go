@@ -0,0 +1,75 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// systemAuditCmd is the struct representing the command group for
+// interacting with the control-plane audit trail.
+type systemAuditCmd struct {
+	List systemAuditListCmd `command:"list" description:"List mutating control-plane requests recorded by the management service"`
+}
+
+// systemAuditListCmd is the struct representing the command to list
+// audit records held by the management service.
+type systemAuditListCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	Method  string        `long:"method" short:"m" description:"Only match requests for the given gRPC method (e.g. PoolDestroy)"`
+	Caller  string        `long:"caller" short:"c" description:"Only match requests made by the given certificate identity (e.g. admin)"`
+	Peer    string        `long:"peer" short:"p" description:"Only match requests made from the given address"`
+	Since   eventTimeFlag `long:"since" description:"Only match requests made after the given time (RFC3339 timestamp or duration ago, e.g. 2h)"`
+	Until   eventTimeFlag `long:"until" description:"Only match requests made before the given time (RFC3339 timestamp or duration ago, e.g. 30m)"`
+	Failed  bool          `long:"failed" short:"f" description:"Only match requests that failed"`
+	Limit   uint32        `long:"limit" short:"n" description:"Only display the most recent N matching requests"`
+	Verbose bool          `long:"verbose" short:"v" description:"Display sanitized request and error details"`
+}
+
+// Execute is run when systemAuditListCmd activates.
+func (cmd *systemAuditListCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system audit list failed")
+	}()
+
+	req := &control.SystemAuditListReq{
+		Method:     cmd.Method,
+		Caller:     cmd.Caller,
+		Peer:       cmd.Peer,
+		Since:      cmd.Since.Time,
+		Until:      cmd.Until.Time,
+		FailedOnly: cmd.Failed,
+		Limit:      cmd.Limit,
+	}
+
+	resp, err := control.SystemAuditList(context.Background(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintSystemAuditListResponse(&out, resp,
+		pretty.PrintWithVerboseOutput(cmd.Verbose)); err != nil {
+		return err
+	}
+	cmd.Info(out.String())
+
+	return nil
+}
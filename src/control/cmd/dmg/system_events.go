@@ -0,0 +1,237 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jessevdk/go-flags"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ui"
+)
+
+var (
+	_ flags.Unmarshaler = &rasIDsFlag{}
+	_ flags.Unmarshaler = &rasSeverityFlag{}
+	_ flags.Unmarshaler = &rasTypeFlag{}
+	_ flags.Unmarshaler = &eventTimeFlag{}
+)
+
+// rasIDsFlag is a go-flags compatible flag type for handling a comma-separated
+// list of RAS event names or numeric identifiers.
+type rasIDsFlag struct {
+	IDs []events.RASID
+}
+
+// UnmarshalFlag implements the go-flags.Unmarshaler interface.
+func (f *rasIDsFlag) UnmarshalFlag(fv string) error {
+	f.IDs = nil
+	for _, tok := range strings.Split(fv, ",") {
+		tok = strings.TrimSpace(tok)
+		if tok == "" {
+			continue
+		}
+
+		if num, err := strconv.ParseUint(tok, 10, 32); err == nil {
+			f.IDs = append(f.IDs, events.RASID(num))
+			continue
+		}
+		id, err := events.RASIDFromString(tok)
+		if err != nil {
+			return err
+		}
+		f.IDs = append(f.IDs, id)
+	}
+
+	return nil
+}
+
+// rasSeverityFlag is a go-flags compatible flag type for handling a RAS event
+// severity name.
+type rasSeverityFlag struct {
+	Severity events.RASSeverityID
+}
+
+// UnmarshalFlag implements the go-flags.Unmarshaler interface.
+func (f *rasSeverityFlag) UnmarshalFlag(fv string) (err error) {
+	f.Severity, err = events.RASSeverityFromString(strings.TrimSpace(fv))
+	return
+}
+
+// Complete implements the go-flags.Completer interface.
+func (f *rasSeverityFlag) Complete(match string) (comps []flags.Completion) {
+	for _, sev := range []events.RASSeverityID{events.RASSeverityError, events.RASSeverityWarning, events.RASSeverityNotice} {
+		if strings.HasPrefix(sev.String(), strings.ToUpper(match)) {
+			comps = append(comps, flags.Completion{Item: sev.String()})
+		}
+	}
+	return
+}
+
+// rasTypeFlag is a go-flags compatible flag type for handling a RAS event
+// type name.
+type rasTypeFlag struct {
+	Type events.RASTypeID
+}
+
+// UnmarshalFlag implements the go-flags.Unmarshaler interface.
+func (f *rasTypeFlag) UnmarshalFlag(fv string) (err error) {
+	f.Type, err = events.RASTypeFromString(strings.TrimSpace(fv))
+	return
+}
+
+// eventTimeFlag is a go-flags compatible flag type for handling either an
+// absolute RFC3339 timestamp or a duration relative to the current time
+// (e.g. "2h" to specify two hours ago).
+type eventTimeFlag struct {
+	Time time.Time
+}
+
+// UnmarshalFlag implements the go-flags.Unmarshaler interface.
+func (f *eventTimeFlag) UnmarshalFlag(fv string) error {
+	if d, err := time.ParseDuration(fv); err == nil {
+		if d < 0 {
+			return errors.Errorf("invalid negative duration %q", fv)
+		}
+		f.Time = time.Now().Add(-d)
+		return nil
+	}
+
+	ts, err := common.ParseTime(fv)
+	if err != nil {
+		return errors.Errorf("invalid time %q (expected RFC3339 timestamp or duration)", fv)
+	}
+	f.Time = ts
+
+	return nil
+}
+
+// systemEventsCmd is the struct representing the command group for
+// interacting with RAS events recorded by the management service.
+type systemEventsCmd struct {
+	List  systemEventsListCmd  `command:"list" description:"List RAS events recorded in the system event journal"`
+	Watch systemEventsWatchCmd `command:"watch" description:"Display RAS events as they are raised in the system"`
+}
+
+// eventsFilterCmd enables the set of RAS events operated upon to be filtered.
+type eventsFilterCmd struct {
+	IDs      rasIDsFlag      `long:"events" short:"e" description:"Comma-separated list of RAS event names or IDs to match"`
+	Severity rasSeverityFlag `long:"severity" short:"s" description:"Only match events at least as severe as the given severity (ERROR, WARNING, NOTICE)"`
+	Ranks    ui.RankSetFlag  `long:"ranks" short:"r" description:"Only match events raised by the given ranks"`
+}
+
+// systemEventsListCmd is the struct representing the command to list
+// journaled RAS events.
+type systemEventsListCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	eventsFilterCmd
+	Type    rasTypeFlag    `long:"type" short:"t" description:"Only match events of the given type (STATE_CHANGE, INFO)"`
+	Hosts   ui.HostSetFlag `long:"hosts" short:"H" description:"Only match events raised on the given hosts"`
+	Since   eventTimeFlag  `long:"since" description:"Only match events raised after the given time (RFC3339 timestamp or duration ago, e.g. 2h)"`
+	Until   eventTimeFlag  `long:"until" description:"Only match events raised before the given time (RFC3339 timestamp or duration ago, e.g. 30m)"`
+	Limit   uint32         `long:"limit" short:"n" description:"Only display the most recent N matching events"`
+	Verbose bool           `long:"verbose" short:"v" description:"Display full event details"`
+}
+
+// Execute is run when systemEventsListCmd activates.
+func (cmd *systemEventsListCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system events list failed")
+	}()
+
+	req := &control.SystemEventsListReq{
+		IDs:         cmd.IDs.IDs,
+		MinSeverity: cmd.Severity.Severity,
+		Type:        cmd.Type.Type,
+		Since:       cmd.Since.Time,
+		Until:       cmd.Until.Time,
+		Limit:       cmd.Limit,
+	}
+	if !cmd.Ranks.Empty() {
+		req.Ranks = &cmd.Ranks.RankSet
+	}
+	if !cmd.Hosts.Empty() {
+		req.Hosts = &cmd.Hosts.HostSet
+	}
+
+	resp, err := control.SystemEventsList(context.Background(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintSystemEventsListResponse(&out, resp,
+		pretty.PrintWithVerboseOutput(cmd.Verbose)); err != nil {
+		return err
+	}
+	cmd.Info(out.String())
+
+	return nil
+}
+
+// systemEventsWatchCmd is the struct representing the command to stream
+// RAS events as they are received by the management service.
+type systemEventsWatchCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	eventsFilterCmd
+	Verbose bool `long:"verbose" short:"v" description:"Display full event details"`
+}
+
+// Execute is run when systemEventsWatchCmd activates.
+func (cmd *systemEventsWatchCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system events watch failed")
+	}()
+
+	req := &control.SystemEventsWatchReq{
+		IDs:         cmd.IDs.IDs,
+		MinSeverity: cmd.Severity.Severity,
+	}
+	if !cmd.Ranks.Empty() {
+		req.Ranks = &cmd.Ranks.RankSet
+	}
+
+	var outErr error
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	err := control.SystemEventsWatch(ctx, cmd.ctlInvoker, req, func(evt *events.RASEvent) {
+		if cmd.JSONOutputEnabled() {
+			outErr = cmd.OutputJSONLine(evt)
+		} else {
+			var out strings.Builder
+			outErr = pretty.PrintRASEvent(&out, evt, pretty.PrintWithVerboseOutput(cmd.Verbose))
+			cmd.Info(strings.TrimSuffix(out.String(), "\n"))
+		}
+		if outErr != nil {
+			cancel()
+		}
+	})
+	if outErr != nil {
+		return outErr
+	}
+
+	return err
+}
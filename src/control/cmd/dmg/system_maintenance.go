@@ -0,0 +1,162 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// maintenanceWaitInterval is the interval between status requests when
+// waiting for ranks to reach the end of a maintenance phase.
+const maintenanceWaitInterval = 5 * time.Second
+
+// systemMaintenanceCmd is the struct representing the rolling maintenance
+// commands.
+type systemMaintenanceCmd struct {
+	Start  systemMaintenanceStartCmd  `command:"start" description:"Drain and stop ranks so that they may be serviced"`
+	Finish systemMaintenanceFinishCmd `command:"finish" description:"Restart and reintegrate ranks after servicing"`
+	Status systemMaintenanceStatusCmd `command:"status" description:"Show the maintenance state of ranks"`
+}
+
+type baseMaintenanceCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	rankListCmd
+}
+
+// waitPhase polls the maintenance state of the requested ranks until all of
+// them have reached the target phase, reporting phase changes as they occur.
+func (cmd *baseMaintenanceCmd) waitPhase(ctx context.Context, req *control.SystemMaintenanceReq, target string) (*control.SystemMaintenanceResp, error) {
+	statusReq := &control.SystemMaintenanceReq{Action: control.SystemMaintenanceStatus}
+	statusReq.Hosts.Replace(&req.Hosts)
+	statusReq.Ranks.Replace(&req.Ranks)
+
+	reported := make(map[ranklist.Rank]string)
+	for {
+		resp, err := control.SystemMaintenance(ctx, cmd.ctlInvoker, statusReq)
+		if err != nil {
+			return nil, err
+		}
+
+		done := true
+		for _, m := range resp.Members {
+			if m.Phase != target {
+				done = false
+			}
+
+			status := m.Phase
+			if m.Error != "" {
+				status += ": " + m.Error
+			}
+			if reported[m.Rank] != status && !cmd.JSONOutputEnabled() {
+				cmd.Infof("rank %d: %s", m.Rank, status)
+			}
+			reported[m.Rank] = status
+		}
+		if done {
+			return resp, nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(maintenanceWaitInterval):
+		}
+	}
+}
+
+func (cmd *baseMaintenanceCmd) execute(action control.SystemMaintenanceAction, wait bool, target string) error {
+	if err := cmd.validateHostsRanks(); err != nil {
+		return err
+	}
+	if action != control.SystemMaintenanceStatus && cmd.Ranks.Count() == 0 && cmd.Hosts.Count() == 0 {
+		return errors.New("no ranks or hosts specified")
+	}
+
+	req := &control.SystemMaintenanceReq{Action: action}
+	req.Hosts.Replace(&cmd.Hosts.HostSet)
+	req.Ranks.Replace(&cmd.Ranks.RankSet)
+
+	ctx := context.Background()
+	resp, err := control.SystemMaintenance(ctx, cmd.ctlInvoker, req)
+	if err == nil && wait {
+		resp, err = cmd.waitPhase(ctx, req, target)
+	}
+
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintSystemMaintenanceResponse(&out, resp); err != nil {
+		return err
+	}
+	cmd.Info(out.String())
+
+	return nil
+}
+
+// systemMaintenanceStartCmd is the struct representing the command to start
+// maintenance of ranks.
+type systemMaintenanceStartCmd struct {
+	baseMaintenanceCmd
+	Wait bool `long:"wait" short:"w" description:"Wait for the ranks to become serviceable"`
+}
+
+// Execute is run when systemMaintenanceStartCmd activates.
+func (cmd *systemMaintenanceStartCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system maintenance start failed")
+	}()
+
+	return cmd.execute(control.SystemMaintenanceStart, cmd.Wait, "serviceable")
+}
+
+// systemMaintenanceFinishCmd is the struct representing the command to finish
+// maintenance of ranks.
+type systemMaintenanceFinishCmd struct {
+	baseMaintenanceCmd
+	Wait bool `long:"wait" short:"w" description:"Wait for the ranks to be returned to service"`
+}
+
+// Execute is run when systemMaintenanceFinishCmd activates.
+func (cmd *systemMaintenanceFinishCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system maintenance finish failed")
+	}()
+
+	return cmd.execute(control.SystemMaintenanceFinish, cmd.Wait, "none")
+}
+
+// systemMaintenanceStatusCmd is the struct representing the command to show
+// the maintenance state of ranks.
+type systemMaintenanceStatusCmd struct {
+	baseMaintenanceCmd
+}
+
+// Execute is run when systemMaintenanceStatusCmd activates.
+func (cmd *systemMaintenanceStatusCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "system maintenance status failed")
+	}()
+
+	return cmd.execute(control.SystemMaintenanceStatus, false, "")
+}
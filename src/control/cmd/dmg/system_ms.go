@@ -0,0 +1,184 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package main
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/cmd/dmg/pretty"
+	"github.com/daos-stack/daos/src/control/common/cmdutil"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// systemMSCmd is the struct representing the management service commands.
+type systemMSCmd struct {
+	AddReplica    systemMSAddReplicaCmd    `command:"add-replica" description:"Add a management service replica"`
+	RemoveReplica systemMSRemoveReplicaCmd `command:"remove-replica" description:"Remove a management service replica"`
+	ListReplicas  systemMSListReplicasCmd  `command:"list-replicas" description:"List management service replicas"`
+	Backup        systemMSBackupCmd        `command:"backup" description:"Manage management service database backups"`
+}
+
+// systemMSReplicaCmd contains the common parameters for commands operating
+// on a single management service replica.
+type systemMSReplicaCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+	Args struct {
+		Host string `positional-arg-name:"<host>" required:"1"`
+	} `positional-args:"yes"`
+}
+
+func (cmd *systemMSReplicaCmd) invoke(op string, fn func(context.Context, control.UnaryInvoker, *control.SystemReplicaReq) (*control.SystemReplicasResp, error)) (errOut error) {
+	defer func() {
+		errOut = errors.Wrapf(errOut, "%s failed", op)
+	}()
+
+	req := &control.SystemReplicaReq{Host: cmd.Args.Host}
+	resp, err := fn(context.Background(), cmd.ctlInvoker, req)
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("Current Leader: %s\n   Replica Set: %s\n", resp.Leader,
+		strings.Join(resp.Replicas, ", "))
+
+	return nil
+}
+
+// systemMSAddReplicaCmd is the struct representing the command to add a
+// management service replica.
+type systemMSAddReplicaCmd struct {
+	systemMSReplicaCmd
+}
+
+// Execute is run when systemMSAddReplicaCmd activates.
+func (cmd *systemMSAddReplicaCmd) Execute(_ []string) error {
+	return cmd.invoke("add replica", control.SystemAddReplica)
+}
+
+// systemMSRemoveReplicaCmd is the struct representing the command to remove
+// a management service replica.
+type systemMSRemoveReplicaCmd struct {
+	systemMSReplicaCmd
+}
+
+// Execute is run when systemMSRemoveReplicaCmd activates.
+func (cmd *systemMSRemoveReplicaCmd) Execute(_ []string) error {
+	return cmd.invoke("remove replica", control.SystemRemoveReplica)
+}
+
+// systemMSListReplicasCmd is the struct representing the command to list
+// the management service replicas.
+type systemMSListReplicasCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when systemMSListReplicasCmd activates.
+func (cmd *systemMSListReplicasCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "list replicas failed")
+	}()
+
+	resp, err := control.SystemListReplicas(context.Background(), cmd.ctlInvoker,
+		new(control.SystemListReplicasReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("Current Leader: %s\n   Replica Set: %s\n", resp.Leader,
+		strings.Join(resp.Replicas, ", "))
+
+	return nil
+}
+
+// systemMSBackupCmd is the struct representing the management service
+// database backup commands.
+type systemMSBackupCmd struct {
+	Now  systemMSBackupNowCmd  `command:"now" description:"Take a backup of the management service database"`
+	List systemMSBackupListCmd `command:"list" description:"List management service database backups"`
+}
+
+// systemMSBackupNowCmd is the struct representing the command to take a
+// backup of the management service database.
+type systemMSBackupNowCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when systemMSBackupNowCmd activates.
+func (cmd *systemMSBackupNowCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "backup failed")
+	}()
+
+	resp, err := control.SystemBackupNow(context.Background(), cmd.ctlInvoker,
+		new(control.SystemBackupReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	cmd.Infof("Backup %s written to %s (index %d, %s)", resp.Backup.Name,
+		resp.Backup.Path, resp.Backup.Index, humanize.IBytes(uint64(resp.Backup.Size)))
+	for _, path := range resp.Removed {
+		cmd.Infof("Removed expired backup %s", path)
+	}
+
+	return nil
+}
+
+// systemMSBackupListCmd is the struct representing the command to list the
+// management service database backups.
+type systemMSBackupListCmd struct {
+	baseCmd
+	cfgCmd
+	ctlInvokerCmd
+	cmdutil.JSONOutputCmd
+}
+
+// Execute is run when systemMSBackupListCmd activates.
+func (cmd *systemMSBackupListCmd) Execute(_ []string) (errOut error) {
+	defer func() {
+		errOut = errors.Wrap(errOut, "list backups failed")
+	}()
+
+	resp, err := control.SystemListBackups(context.Background(), cmd.ctlInvoker,
+		new(control.SystemListBackupsReq))
+	if cmd.JSONOutputEnabled() {
+		return cmd.OutputJSON(resp, err)
+	}
+	if err != nil {
+		return err
+	}
+
+	var out strings.Builder
+	if err := pretty.PrintSystemListBackupsResponse(&out, resp); err != nil {
+		return err
+	}
+	cmd.Info(out.String())
+
+	return nil
+}
@@ -10,12 +10,14 @@ import (
 	"fmt"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hostlist"
@@ -24,6 +26,12 @@ import (
 	"github.com/daos-stack/daos/src/control/system"
 )
 
+func replicaLeaderQueryReq(host string) *control.ReplicaLeaderQueryReq {
+	req := new(control.ReplicaLeaderQueryReq)
+	req.SetHostList([]string{host})
+	return req
+}
+
 func TestDmg_SystemCommands(t *testing.T) {
 	withRanks := func(req control.UnaryRequest, ranks ...ranklist.Rank) control.UnaryRequest {
 		if rs, ok := req.(interface{ SetRanks(*ranklist.RankSet) }); ok {
@@ -192,6 +200,103 @@ func TestDmg_SystemCommands(t *testing.T) {
 			"",
 			errors.New("--ranks and --rank-hosts options cannot be set together"),
 		},
+		{
+			"system drain with no arguments",
+			"system drain",
+			"",
+			errors.New("no ranks or hosts specified"),
+		},
+		{
+			"system drain with multiple ranks",
+			"system drain --ranks 0,1,4",
+			strings.Join([]string{
+				printRequest(t, withRanks(&control.SystemDrainReq{}, 0, 1, 4)),
+			}, " "),
+			nil,
+		},
+		{
+			"system drain with multiple hosts and wait",
+			"system drain --rank-hosts bar9,foo-[0-100] --wait",
+			strings.Join([]string{
+				printRequest(t, withHosts(&control.SystemDrainReq{}, "foo-[0-100]", "bar9")),
+			}, " "),
+			nil,
+		},
+		{
+			"system drain with both hosts and ranks specified",
+			"system drain --rank-hosts bar9 --ranks 0",
+			"",
+			errors.New("--ranks and --rank-hosts options cannot be set together"),
+		},
+		{
+			"system reintegrate with single rank",
+			"system reintegrate --ranks 2",
+			strings.Join([]string{
+				printRequest(t, withRanks(&control.SystemDrainReq{Reint: true}, 2)),
+			}, " "),
+			nil,
+		},
+		{
+			"system reint alias with hosts",
+			"system reint --rank-hosts foo-1",
+			strings.Join([]string{
+				printRequest(t, withHosts(&control.SystemDrainReq{Reint: true}, "foo-1")),
+			}, " "),
+			nil,
+		},
+		{
+			"system maintenance start with no arguments",
+			"system maintenance start",
+			"",
+			errors.New("no ranks or hosts specified"),
+		},
+		{
+			"system maintenance start with ranks",
+			"system maintenance start --ranks 0-1",
+			strings.Join([]string{
+				printRequest(t, withRanks(&control.SystemMaintenanceReq{
+					Action: control.SystemMaintenanceStart,
+				}, 0, 1)),
+			}, " "),
+			nil,
+		},
+		{
+			"system maintenance start with hosts and wait",
+			"system maintenance start --rank-hosts foo-1 --wait",
+			strings.Join([]string{
+				printRequest(t, withHosts(&control.SystemMaintenanceReq{
+					Action: control.SystemMaintenanceStart,
+				}, "foo-1")),
+				printRequest(t, withHosts(&control.SystemMaintenanceReq{
+					Action: control.SystemMaintenanceStatus,
+				}, "foo-1")),
+			}, " "),
+			nil,
+		},
+		{
+			"system maintenance finish with ranks",
+			"system maintenance finish --ranks 3",
+			strings.Join([]string{
+				printRequest(t, withRanks(&control.SystemMaintenanceReq{
+					Action: control.SystemMaintenanceFinish,
+				}, 3)),
+			}, " "),
+			nil,
+		},
+		{
+			"system maintenance finish with both hosts and ranks specified",
+			"system maintenance finish --rank-hosts foo-1 --ranks 3",
+			"",
+			errors.New("--ranks and --rank-hosts options cannot be set together"),
+		},
+		{
+			"system maintenance status with no arguments",
+			"system maintenance status",
+			strings.Join([]string{
+				printRequest(t, &control.SystemMaintenanceReq{}),
+			}, " "),
+			nil,
+		},
 		{
 			"system start with no arguments",
 			"system start",
@@ -245,6 +350,24 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"leader transfer",
+			"system leader-transfer",
+			strings.Join([]string{
+				printRequest(t, &control.SystemLeaderTransferReq{}),
+				printRequest(t, replicaLeaderQueryReq("host2")),
+			}, " "),
+			nil,
+		},
+		{
+			"leader transfer to named replica",
+			"system leader-transfer --to host2",
+			strings.Join([]string{
+				printRequest(t, &control.SystemLeaderTransferReq{Target: "host2"}),
+				printRequest(t, replicaLeaderQueryReq("host2")),
+			}, " "),
+			nil,
+		},
 		{
 			"system list-pools with default config",
 			"system list-pools",
@@ -302,6 +425,147 @@ func TestDmg_SystemCommands(t *testing.T) {
 			}, " "),
 			nil,
 		},
+		{
+			"system events list with no filters",
+			"system events list",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsListReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events list with filters",
+			"system events list --events engine_died,3 --severity warning --type state_change " +
+				"--ranks 0-2 --hosts foo[1-2] --since 2023-01-01T00:00:00Z --until 2023-01-02T00:00:00Z -n 5",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsListReq{
+					IDs:         []events.RASID{events.RASEngineDied, events.RASID(3)},
+					MinSeverity: events.RASSeverityWarning,
+					Type:        events.RASTypeStateChange,
+					Ranks:       ranklist.MustCreateRankSet("0-2"),
+					Hosts:       hostlist.MustCreateSet("foo[1-2]"),
+					Since:       time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					Until:       time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+					Limit:       5,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events list with unknown event",
+			"system events list --events quack",
+			"",
+			errors.New("unknown RAS event"),
+		},
+		{
+			"system events list with unknown severity",
+			"system events list --severity quack",
+			"",
+			errors.New("unknown RAS event severity"),
+		},
+		{
+			"system events list with bad time",
+			"system events list --since yesterday",
+			"",
+			errors.New("invalid time"),
+		},
+		{
+			"system audit list with no filters",
+			"system audit list",
+			strings.Join([]string{
+				printRequest(t, &control.SystemAuditListReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system audit list with filters",
+			"system audit list --method PoolDestroy --caller admin --peer 10.0.0.1 " +
+				"--since 2023-01-01T00:00:00Z --until 2023-01-02T00:00:00Z --failed -n 5",
+			strings.Join([]string{
+				printRequest(t, &control.SystemAuditListReq{
+					Method:     "PoolDestroy",
+					Caller:     "admin",
+					Peer:       "10.0.0.1",
+					Since:      time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+					Until:      time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC),
+					FailedOnly: true,
+					Limit:      5,
+				}),
+			}, " "),
+			nil,
+		},
+		{
+			"system audit list with bad time",
+			"system audit list --until yesterday",
+			"",
+			errors.New("invalid time"),
+		},
+		{
+			"system ms add-replica",
+			"system ms add-replica host2",
+			strings.Join([]string{
+				printRequest(t, &control.SystemReplicaReq{Host: "host2"}),
+			}, " "),
+			nil,
+		},
+		{
+			"system ms add-replica without host",
+			"system ms add-replica",
+			"",
+			errors.New("required argument"),
+		},
+		{
+			"system ms remove-replica",
+			"system ms remove-replica host2:10001",
+			strings.Join([]string{
+				printRequest(t, &control.SystemReplicaReq{Host: "host2:10001"}),
+			}, " "),
+			nil,
+		},
+		{
+			"system ms list-replicas",
+			"system ms list-replicas",
+			strings.Join([]string{
+				printRequest(t, &control.SystemListReplicasReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system ms backup now",
+			"system ms backup now",
+			strings.Join([]string{
+				printRequest(t, &control.SystemBackupReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system ms backup list",
+			"system ms backup list",
+			strings.Join([]string{
+				printRequest(t, &control.SystemListBackupsReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events watch with no filters",
+			"system events watch",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsWatchReq{}),
+			}, " "),
+			nil,
+		},
+		{
+			"system events watch with filters",
+			"system events watch --events engine_died --severity error --ranks 1-3",
+			strings.Join([]string{
+				printRequest(t, &control.SystemEventsWatchReq{
+					IDs:         []events.RASID{events.RASEngineDied},
+					MinSeverity: events.RASSeverityError,
+					Ranks:       ranklist.MustCreateRankSet("1-3"),
+				}),
+			}, " "),
+			nil,
+		},
 		{
 			"Non-existent subcommand",
 			"system quack",
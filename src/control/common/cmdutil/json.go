@@ -90,3 +90,21 @@ func (cmd *JSONOutputCmd) OutputJSON(in interface{}, err error) error {
 
 	return nil
 }
+
+// OutputJSONLine writes the given data to the command's writer as a single
+// line of compact JSON. It may be called repeatedly by commands that stream
+// their output.
+func (cmd *JSONOutputCmd) OutputJSONLine(in interface{}) error {
+	if !cmd.JSONOutputEnabled() {
+		return nil
+	}
+
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	cmd.wroteJSON.SetTrue()
+
+	_, err = cmd.writer.Write(append(data, []byte("\n")...))
+	return err
+}
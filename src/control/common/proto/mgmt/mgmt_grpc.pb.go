@@ -32,6 +32,8 @@ type MgmtSvcClient interface {
 	LeaderQuery(ctx context.Context, in *LeaderQueryReq, opts ...grpc.CallOption) (*LeaderQueryResp, error)
 	// Create a DAOS pool allocated across a number of ranks
 	PoolCreate(ctx context.Context, in *PoolCreateReq, opts ...grpc.CallOption) (*PoolCreateResp, error)
+	// Resolve the placement of a DAOS pool without creating it
+	PoolCreatePlan(ctx context.Context, in *PoolCreateReq, opts ...grpc.CallOption) (*PoolCreatePlanResp, error)
 	// Destroy a DAOS pool allocated across a number of ranks.
 	PoolDestroy(ctx context.Context, in *PoolDestroyReq, opts ...grpc.CallOption) (*PoolDestroyResp, error)
 	// Evict a DAOS pool's connections.
@@ -90,6 +92,38 @@ type MgmtSvcClient interface {
 	SystemSetProp(ctx context.Context, in *SystemSetPropReq, opts ...grpc.CallOption) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(ctx context.Context, in *SystemGetPropReq, opts ...grpc.CallOption) (*SystemGetPropResp, error)
+	// List RAS events recorded in the system event journal.
+	SystemEventsList(ctx context.Context, in *SystemEventsListReq, opts ...grpc.CallOption) (*SystemEventsListResp, error)
+	// Subscribe to RAS events received by the management service.
+	SystemEventsWatch(ctx context.Context, in *SystemEventsWatchReq, opts ...grpc.CallOption) (MgmtSvc_SystemEventsWatchClient, error)
+	// Add a management service replica.
+	SystemAddReplica(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicasResp, error)
+	// Remove a management service replica.
+	SystemRemoveReplica(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicasResp, error)
+	// List the management service replicas.
+	SystemListReplicas(ctx context.Context, in *SystemListReplicasReq, opts ...grpc.CallOption) (*SystemReplicasResp, error)
+	// Notify a control plane instance of a change in the replica set.
+	SetReplicas(ctx context.Context, in *SetReplicasReq, opts ...grpc.CallOption) (*DaosResp, error)
+	// Take a backup of the management service database.
+	SystemBackup(ctx context.Context, in *SystemBackupReq, opts ...grpc.CallOption) (*SystemBackupResp, error)
+	// List the management service database backups.
+	SystemListBackups(ctx context.Context, in *SystemListBackupsReq, opts ...grpc.CallOption) (*SystemListBackupsResp, error)
+	// Transfer management service leadership to another replica.
+	SystemLeaderTransfer(ctx context.Context, in *SystemLeaderTransferReq, opts ...grpc.CallOption) (*SystemLeaderTransferResp, error)
+	// List summaries of mutating requests recorded by the management service.
+	SystemAuditList(ctx context.Context, in *SystemAuditListReq, opts ...grpc.CallOption) (*SystemAuditListResp, error)
+	// Create or replace a named pool profile.
+	PoolProfileCreate(ctx context.Context, in *PoolProfileCreateReq, opts ...grpc.CallOption) (*PoolProfileCreateResp, error)
+	// List named pool profiles.
+	PoolProfileList(ctx context.Context, in *PoolProfileListReq, opts ...grpc.CallOption) (*PoolProfileListResp, error)
+	// Delete a named pool profile.
+	PoolProfileDelete(ctx context.Context, in *PoolProfileDeleteReq, opts ...grpc.CallOption) (*PoolProfileDeleteResp, error)
+	// Drain or reintegrate ranks from all pools with targets on them.
+	SystemDrain(ctx context.Context, in *SystemDrainReq, opts ...grpc.CallOption) (*SystemDrainResp, error)
+	// Start, finish or query the maintenance workflow for ranks.
+	SystemMaintenance(ctx context.Context, in *SystemMaintenanceReq, opts ...grpc.CallOption) (*SystemMaintenanceResp, error)
+	// Set or remove operator-defined tags on a pool.
+	PoolTag(ctx context.Context, in *PoolTagReq, opts ...grpc.CallOption) (*PoolTagResp, error)
 }
 
 type mgmtSvcClient struct {
@@ -136,6 +170,15 @@ func (c *mgmtSvcClient) PoolCreate(ctx context.Context, in *PoolCreateReq, opts
 	return out, nil
 }
 
+func (c *mgmtSvcClient) PoolCreatePlan(ctx context.Context, in *PoolCreateReq, opts ...grpc.CallOption) (*PoolCreatePlanResp, error) {
+	out := new(PoolCreatePlanResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolCreatePlan", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mgmtSvcClient) PoolDestroy(ctx context.Context, in *PoolDestroyReq, opts ...grpc.CallOption) (*PoolDestroyResp, error) {
 	out := new(PoolDestroyResp)
 	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolDestroy", in, out, opts...)
@@ -397,6 +440,173 @@ func (c *mgmtSvcClient) SystemGetProp(ctx context.Context, in *SystemGetPropReq,
 	return out, nil
 }
 
+func (c *mgmtSvcClient) SystemEventsList(ctx context.Context, in *SystemEventsListReq, opts ...grpc.CallOption) (*SystemEventsListResp, error) {
+	out := new(SystemEventsListResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemEventsList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemEventsWatch(ctx context.Context, in *SystemEventsWatchReq, opts ...grpc.CallOption) (MgmtSvc_SystemEventsWatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MgmtSvc_ServiceDesc.Streams[0], "/mgmt.MgmtSvc/SystemEventsWatch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &mgmtSvcSystemEventsWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MgmtSvc_SystemEventsWatchClient interface {
+	Recv() (*SystemEventsWatchResp, error)
+	grpc.ClientStream
+}
+
+type mgmtSvcSystemEventsWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *mgmtSvcSystemEventsWatchClient) Recv() (*SystemEventsWatchResp, error) {
+	m := new(SystemEventsWatchResp)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *mgmtSvcClient) SystemAddReplica(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicasResp, error) {
+	out := new(SystemReplicasResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemAddReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemRemoveReplica(ctx context.Context, in *SystemReplicaReq, opts ...grpc.CallOption) (*SystemReplicasResp, error) {
+	out := new(SystemReplicasResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemRemoveReplica", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemListReplicas(ctx context.Context, in *SystemListReplicasReq, opts ...grpc.CallOption) (*SystemReplicasResp, error) {
+	out := new(SystemReplicasResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemListReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SetReplicas(ctx context.Context, in *SetReplicasReq, opts ...grpc.CallOption) (*DaosResp, error) {
+	out := new(DaosResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SetReplicas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemBackup(ctx context.Context, in *SystemBackupReq, opts ...grpc.CallOption) (*SystemBackupResp, error) {
+	out := new(SystemBackupResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemBackup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemListBackups(ctx context.Context, in *SystemListBackupsReq, opts ...grpc.CallOption) (*SystemListBackupsResp, error) {
+	out := new(SystemListBackupsResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemListBackups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemLeaderTransfer(ctx context.Context, in *SystemLeaderTransferReq, opts ...grpc.CallOption) (*SystemLeaderTransferResp, error) {
+	out := new(SystemLeaderTransferResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemLeaderTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemAuditList(ctx context.Context, in *SystemAuditListReq, opts ...grpc.CallOption) (*SystemAuditListResp, error) {
+	out := new(SystemAuditListResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemAuditList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolProfileCreate(ctx context.Context, in *PoolProfileCreateReq, opts ...grpc.CallOption) (*PoolProfileCreateResp, error) {
+	out := new(PoolProfileCreateResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolProfileCreate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolProfileList(ctx context.Context, in *PoolProfileListReq, opts ...grpc.CallOption) (*PoolProfileListResp, error) {
+	out := new(PoolProfileListResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolProfileList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolProfileDelete(ctx context.Context, in *PoolProfileDeleteReq, opts ...grpc.CallOption) (*PoolProfileDeleteResp, error) {
+	out := new(PoolProfileDeleteResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolProfileDelete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemDrain(ctx context.Context, in *SystemDrainReq, opts ...grpc.CallOption) (*SystemDrainResp, error) {
+	out := new(SystemDrainResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemDrain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) SystemMaintenance(ctx context.Context, in *SystemMaintenanceReq, opts ...grpc.CallOption) (*SystemMaintenanceResp, error) {
+	out := new(SystemMaintenanceResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/SystemMaintenance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mgmtSvcClient) PoolTag(ctx context.Context, in *PoolTagReq, opts ...grpc.CallOption) (*PoolTagResp, error) {
+	out := new(PoolTagResp)
+	err := c.cc.Invoke(ctx, "/mgmt.MgmtSvc/PoolTag", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MgmtSvcServer is the server API for MgmtSvc service.
 // All implementations must embed UnimplementedMgmtSvcServer
 // for forward compatibility
@@ -410,6 +620,8 @@ type MgmtSvcServer interface {
 	LeaderQuery(context.Context, *LeaderQueryReq) (*LeaderQueryResp, error)
 	// Create a DAOS pool allocated across a number of ranks
 	PoolCreate(context.Context, *PoolCreateReq) (*PoolCreateResp, error)
+	// Resolve the placement of a DAOS pool without creating it
+	PoolCreatePlan(context.Context, *PoolCreateReq) (*PoolCreatePlanResp, error)
 	// Destroy a DAOS pool allocated across a number of ranks.
 	PoolDestroy(context.Context, *PoolDestroyReq) (*PoolDestroyResp, error)
 	// Evict a DAOS pool's connections.
@@ -468,6 +680,38 @@ type MgmtSvcServer interface {
 	SystemSetProp(context.Context, *SystemSetPropReq) (*DaosResp, error)
 	// Get a system property or properties.
 	SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error)
+	// List RAS events recorded in the system event journal.
+	SystemEventsList(context.Context, *SystemEventsListReq) (*SystemEventsListResp, error)
+	// Subscribe to RAS events received by the management service.
+	SystemEventsWatch(*SystemEventsWatchReq, MgmtSvc_SystemEventsWatchServer) error
+	// Add a management service replica.
+	SystemAddReplica(context.Context, *SystemReplicaReq) (*SystemReplicasResp, error)
+	// Remove a management service replica.
+	SystemRemoveReplica(context.Context, *SystemReplicaReq) (*SystemReplicasResp, error)
+	// List the management service replicas.
+	SystemListReplicas(context.Context, *SystemListReplicasReq) (*SystemReplicasResp, error)
+	// Notify a control plane instance of a change in the replica set.
+	SetReplicas(context.Context, *SetReplicasReq) (*DaosResp, error)
+	// Take a backup of the management service database.
+	SystemBackup(context.Context, *SystemBackupReq) (*SystemBackupResp, error)
+	// List the management service database backups.
+	SystemListBackups(context.Context, *SystemListBackupsReq) (*SystemListBackupsResp, error)
+	// Transfer management service leadership to another replica.
+	SystemLeaderTransfer(context.Context, *SystemLeaderTransferReq) (*SystemLeaderTransferResp, error)
+	// List summaries of mutating requests recorded by the management service.
+	SystemAuditList(context.Context, *SystemAuditListReq) (*SystemAuditListResp, error)
+	// Create or replace a named pool profile.
+	PoolProfileCreate(context.Context, *PoolProfileCreateReq) (*PoolProfileCreateResp, error)
+	// List named pool profiles.
+	PoolProfileList(context.Context, *PoolProfileListReq) (*PoolProfileListResp, error)
+	// Delete a named pool profile.
+	PoolProfileDelete(context.Context, *PoolProfileDeleteReq) (*PoolProfileDeleteResp, error)
+	// Drain or reintegrate ranks from all pools with targets on them.
+	SystemDrain(context.Context, *SystemDrainReq) (*SystemDrainResp, error)
+	// Start, finish or query the maintenance workflow for ranks.
+	SystemMaintenance(context.Context, *SystemMaintenanceReq) (*SystemMaintenanceResp, error)
+	// Set or remove operator-defined tags on a pool.
+	PoolTag(context.Context, *PoolTagReq) (*PoolTagResp, error)
 	mustEmbedUnimplementedMgmtSvcServer()
 }
 
@@ -487,6 +731,9 @@ func (UnimplementedMgmtSvcServer) LeaderQuery(context.Context, *LeaderQueryReq)
 func (UnimplementedMgmtSvcServer) PoolCreate(context.Context, *PoolCreateReq) (*PoolCreateResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolCreate not implemented")
 }
+func (UnimplementedMgmtSvcServer) PoolCreatePlan(context.Context, *PoolCreateReq) (*PoolCreatePlanResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolCreatePlan not implemented")
+}
 func (UnimplementedMgmtSvcServer) PoolDestroy(context.Context, *PoolDestroyReq) (*PoolDestroyResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PoolDestroy not implemented")
 }
@@ -574,6 +821,54 @@ func (UnimplementedMgmtSvcServer) SystemSetProp(context.Context, *SystemSetPropR
 func (UnimplementedMgmtSvcServer) SystemGetProp(context.Context, *SystemGetPropReq) (*SystemGetPropResp, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SystemGetProp not implemented")
 }
+func (UnimplementedMgmtSvcServer) SystemEventsList(context.Context, *SystemEventsListReq) (*SystemEventsListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemEventsList not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemEventsWatch(*SystemEventsWatchReq, MgmtSvc_SystemEventsWatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method SystemEventsWatch not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemAddReplica(context.Context, *SystemReplicaReq) (*SystemReplicasResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemAddReplica not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemRemoveReplica(context.Context, *SystemReplicaReq) (*SystemReplicasResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemRemoveReplica not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemListReplicas(context.Context, *SystemListReplicasReq) (*SystemReplicasResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemListReplicas not implemented")
+}
+func (UnimplementedMgmtSvcServer) SetReplicas(context.Context, *SetReplicasReq) (*DaosResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReplicas not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemBackup(context.Context, *SystemBackupReq) (*SystemBackupResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemBackup not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemListBackups(context.Context, *SystemListBackupsReq) (*SystemListBackupsResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemListBackups not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemLeaderTransfer(context.Context, *SystemLeaderTransferReq) (*SystemLeaderTransferResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemLeaderTransfer not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemAuditList(context.Context, *SystemAuditListReq) (*SystemAuditListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemAuditList not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolProfileCreate(context.Context, *PoolProfileCreateReq) (*PoolProfileCreateResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolProfileCreate not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolProfileList(context.Context, *PoolProfileListReq) (*PoolProfileListResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolProfileList not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolProfileDelete(context.Context, *PoolProfileDeleteReq) (*PoolProfileDeleteResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolProfileDelete not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemDrain(context.Context, *SystemDrainReq) (*SystemDrainResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemDrain not implemented")
+}
+func (UnimplementedMgmtSvcServer) SystemMaintenance(context.Context, *SystemMaintenanceReq) (*SystemMaintenanceResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SystemMaintenance not implemented")
+}
+func (UnimplementedMgmtSvcServer) PoolTag(context.Context, *PoolTagReq) (*PoolTagResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolTag not implemented")
+}
 func (UnimplementedMgmtSvcServer) mustEmbedUnimplementedMgmtSvcServer() {}
 
 // UnsafeMgmtSvcServer may be embedded to opt out of forward compatibility for this service.
@@ -659,6 +954,24 @@ func _MgmtSvc_PoolCreate_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_PoolCreatePlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolCreateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolCreatePlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolCreatePlan",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolCreatePlan(ctx, req.(*PoolCreateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MgmtSvc_PoolDestroy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PoolDestroyReq)
 	if err := dec(in); err != nil {
@@ -1181,6 +1494,297 @@ func _MgmtSvc_SystemGetProp_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MgmtSvc_SystemEventsList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemEventsListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemEventsList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemEventsList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemEventsList(ctx, req.(*SystemEventsListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemEventsWatch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SystemEventsWatchReq)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MgmtSvcServer).SystemEventsWatch(m, &mgmtSvcSystemEventsWatchServer{stream})
+}
+
+type MgmtSvc_SystemEventsWatchServer interface {
+	Send(*SystemEventsWatchResp) error
+	grpc.ServerStream
+}
+
+type mgmtSvcSystemEventsWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *mgmtSvcSystemEventsWatchServer) Send(m *SystemEventsWatchResp) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MgmtSvc_SystemAddReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemAddReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemAddReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemAddReplica(ctx, req.(*SystemReplicaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemRemoveReplica_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemReplicaReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemRemoveReplica(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemRemoveReplica",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemRemoveReplica(ctx, req.(*SystemReplicaReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemListReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemListReplicasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemListReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemListReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemListReplicas(ctx, req.(*SystemListReplicasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SetReplicas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetReplicasReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SetReplicas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SetReplicas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SetReplicas(ctx, req.(*SetReplicasReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemBackupReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemBackup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemBackup(ctx, req.(*SystemBackupReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemListBackups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemListBackupsReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemListBackups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemListBackups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemListBackups(ctx, req.(*SystemListBackupsReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemLeaderTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemLeaderTransferReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemLeaderTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemLeaderTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemLeaderTransfer(ctx, req.(*SystemLeaderTransferReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemAuditList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemAuditListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemAuditList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemAuditList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemAuditList(ctx, req.(*SystemAuditListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolProfileCreate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolProfileCreateReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolProfileCreate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolProfileCreate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolProfileCreate(ctx, req.(*PoolProfileCreateReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolProfileList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolProfileListReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolProfileList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolProfileList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolProfileList(ctx, req.(*PoolProfileListReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolProfileDelete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolProfileDeleteReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolProfileDelete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolProfileDelete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolProfileDelete(ctx, req.(*PoolProfileDeleteReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemDrain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemDrainReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemDrain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemDrain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemDrain(ctx, req.(*SystemDrainReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_SystemMaintenance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SystemMaintenanceReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).SystemMaintenance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/SystemMaintenance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).SystemMaintenance(ctx, req.(*SystemMaintenanceReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MgmtSvc_PoolTag_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PoolTagReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MgmtSvcServer).PoolTag(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/mgmt.MgmtSvc/PoolTag",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MgmtSvcServer).PoolTag(ctx, req.(*PoolTagReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MgmtSvc_ServiceDesc is the grpc.ServiceDesc for MgmtSvc service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1204,6 +1808,10 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "PoolCreate",
 			Handler:    _MgmtSvc_PoolCreate_Handler,
 		},
+		{
+			MethodName: "PoolCreatePlan",
+			Handler:    _MgmtSvc_PoolCreatePlan_Handler,
+		},
 		{
 			MethodName: "PoolDestroy",
 			Handler:    _MgmtSvc_PoolDestroy_Handler,
@@ -1320,7 +1928,73 @@ var MgmtSvc_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SystemGetProp",
 			Handler:    _MgmtSvc_SystemGetProp_Handler,
 		},
+		{
+			MethodName: "SystemEventsList",
+			Handler:    _MgmtSvc_SystemEventsList_Handler,
+		},
+		{
+			MethodName: "SystemAddReplica",
+			Handler:    _MgmtSvc_SystemAddReplica_Handler,
+		},
+		{
+			MethodName: "SystemRemoveReplica",
+			Handler:    _MgmtSvc_SystemRemoveReplica_Handler,
+		},
+		{
+			MethodName: "SystemListReplicas",
+			Handler:    _MgmtSvc_SystemListReplicas_Handler,
+		},
+		{
+			MethodName: "SetReplicas",
+			Handler:    _MgmtSvc_SetReplicas_Handler,
+		},
+		{
+			MethodName: "SystemBackup",
+			Handler:    _MgmtSvc_SystemBackup_Handler,
+		},
+		{
+			MethodName: "SystemListBackups",
+			Handler:    _MgmtSvc_SystemListBackups_Handler,
+		},
+		{
+			MethodName: "SystemLeaderTransfer",
+			Handler:    _MgmtSvc_SystemLeaderTransfer_Handler,
+		},
+		{
+			MethodName: "SystemAuditList",
+			Handler:    _MgmtSvc_SystemAuditList_Handler,
+		},
+		{
+			MethodName: "PoolProfileCreate",
+			Handler:    _MgmtSvc_PoolProfileCreate_Handler,
+		},
+		{
+			MethodName: "PoolProfileList",
+			Handler:    _MgmtSvc_PoolProfileList_Handler,
+		},
+		{
+			MethodName: "PoolProfileDelete",
+			Handler:    _MgmtSvc_PoolProfileDelete_Handler,
+		},
+		{
+			MethodName: "SystemDrain",
+			Handler:    _MgmtSvc_SystemDrain_Handler,
+		},
+		{
+			MethodName: "SystemMaintenance",
+			Handler:    _MgmtSvc_SystemMaintenance_Handler,
+		},
+		{
+			MethodName: "PoolTag",
+			Handler:    _MgmtSvc_PoolTag_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SystemEventsWatch",
+			Handler:       _MgmtSvc_SystemEventsWatch_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "mgmt/mgmt.proto",
 }
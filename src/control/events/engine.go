@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -87,3 +87,20 @@ func NewEngineFormatRequiredEvent(hostname string, instanceIdx uint32, formatTyp
 		},
 	})
 }
+
+// NewEngineRestartAbandonedEvent creates an EngineRestartAbandoned event from given
+// inputs, raised when automatic restarts of an engine are abandoned.
+func NewEngineRestartAbandonedEvent(hostname string, instanceIdx uint32, rank uint32, reason string, exitErr common.ExitStatus) *RASEvent {
+	return fill(&RASEvent{
+		Msg:      fmt.Sprintf("DAOS engine %d will not be restarted automatically: %s", instanceIdx, reason),
+		ID:       RASEngineRestartAbandoned,
+		Hostname: hostname,
+		Rank:     rank,
+		Type:     RASTypeInfoOnly,
+		Severity: RASSeverityError,
+		ExtendedInfo: &EngineStateInfo{
+			InstanceIdx: instanceIdx,
+			ExitErr:     exitErr,
+		},
+	})
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2021 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -20,6 +20,7 @@ const (
 	tRank        = 1
 	tPid         = 1234
 	tFmtType     = "Metadata"
+	tReason      = "flapping"
 )
 
 var (
@@ -36,6 +37,11 @@ func mockEvtFmtReq(t *testing.T) *RASEvent {
 	return NewEngineFormatRequiredEvent(tHost, tInstanceIdx, tFmtType)
 }
 
+func mockEvtRestartAbandoned(t *testing.T) *RASEvent {
+	t.Helper()
+	return NewEngineRestartAbandonedEvent(tHost, tInstanceIdx, tRank, tReason, tExitErr)
+}
+
 func TestEvents_ConvertEngineDied(t *testing.T) {
 	event := mockEvtDied(t)
 
@@ -79,3 +85,25 @@ func TestEvents_ConvertEngineFormatRequired(t *testing.T) {
 		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
 	}
 }
+
+func TestEvents_ConvertEngineRestartAbandoned(t *testing.T) {
+	event := mockEvtRestartAbandoned(t)
+
+	pbEvent, err := event.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("proto event: %+v (%T)", pbEvent, pbEvent)
+
+	returnedEvent := new(RASEvent)
+	if err := returnedEvent.FromProto(pbEvent); err != nil {
+		t.Fatal(err)
+	}
+
+	t.Logf("native event: %+v, %+v", returnedEvent, returnedEvent.ExtendedInfo)
+
+	if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+		t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+	}
+}
@@ -0,0 +1,153 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+)
+
+// Filter specifies criteria used to select RAS events. Zero-value fields
+// match all events.
+type Filter struct {
+	IDs         []RASID       `json:"ids,omitempty"`
+	MinSeverity RASSeverityID `json:"min_severity,omitempty"`
+	Type        RASTypeID     `json:"type,omitempty"`
+	Ranks       []uint32      `json:"ranks,omitempty"`
+	Hosts       []string      `json:"hosts,omitempty"`
+	Since       time.Time     `json:"since,omitempty"`
+	Until       time.Time     `json:"until,omitempty"`
+}
+
+// IsEmpty returns true if the filter will match all events.
+func (f *Filter) IsEmpty() bool {
+	if f == nil {
+		return true
+	}
+
+	return len(f.IDs) == 0 && f.MinSeverity == RASSeverityUnknown &&
+		f.Type == RASTypeAny && len(f.Ranks) == 0 && len(f.Hosts) == 0 &&
+		f.Since.IsZero() && f.Until.IsZero()
+}
+
+// matchesSeverity returns true if the supplied severity is at least as severe
+// as the filter minimum. Lower severity values indicate more severe events.
+func (f *Filter) matchesSeverity(sev RASSeverityID) bool {
+	if f.MinSeverity == RASSeverityUnknown {
+		return true
+	}
+
+	return sev != RASSeverityUnknown && sev <= f.MinSeverity
+}
+
+// Matches returns true if the supplied event satisfies all filter criteria.
+func (f *Filter) Matches(evt *RASEvent) bool {
+	if f == nil {
+		return true
+	}
+	if evt == nil {
+		return false
+	}
+
+	if len(f.IDs) > 0 && !containsID(f.IDs, evt.ID) {
+		return false
+	}
+	if !f.matchesSeverity(evt.Severity) {
+		return false
+	}
+	if f.Type != RASTypeAny && evt.Type != f.Type {
+		return false
+	}
+	if len(f.Ranks) > 0 && !containsRank(f.Ranks, evt.Rank) {
+		return false
+	}
+	if len(f.Hosts) > 0 && !common.Includes(f.Hosts, evt.Hostname) {
+		return false
+	}
+
+	if f.Since.IsZero() && f.Until.IsZero() {
+		return true
+	}
+	ts, err := evt.GetTimestamp()
+	if err != nil {
+		return false
+	}
+	if !f.Since.IsZero() && ts.Before(f.Since) {
+		return false
+	}
+	if !f.Until.IsZero() && ts.After(f.Until) {
+		return false
+	}
+
+	return true
+}
+
+func containsID(ids []RASID, id RASID) bool {
+	for _, i := range ids {
+		if i == id {
+			return true
+		}
+	}
+
+	return false
+}
+
+func containsRank(ranks []uint32, rank uint32) bool {
+	for _, r := range ranks {
+		if r == rank {
+			return true
+		}
+	}
+
+	return false
+}
+
+// unknownRASIDStr is returned by the event name lookup for undefined IDs.
+const unknownRASIDStr = "unknown_unknown"
+
+// RASIDFromString returns the RASID matching the supplied event name.
+func RASIDFromString(name string) (RASID, error) {
+	for id := RASUnknownEvent; ; id++ {
+		idStr := id.String()
+		if idStr == unknownRASIDStr {
+			break
+		}
+		if idStr == name {
+			return id, nil
+		}
+	}
+
+	return RASUnknownEvent, errors.Errorf("unknown RAS event %q", name)
+}
+
+// RASSeverityFromString returns the RASSeverityID matching the supplied
+// severity name, case-insensitively.
+func RASSeverityFromString(name string) (RASSeverityID, error) {
+	for _, sev := range []RASSeverityID{RASSeverityError, RASSeverityWarning, RASSeverityNotice} {
+		if strings.ToUpper(name) == sev.String() {
+			return sev, nil
+		}
+	}
+
+	return RASSeverityUnknown, errors.Errorf("unknown RAS event severity %q", name)
+}
+
+// RASTypeFromString returns the RASTypeID matching the supplied type name,
+// case-insensitively.
+func RASTypeFromString(name string) (RASTypeID, error) {
+	for _, typ := range []RASTypeID{RASTypeStateChange, RASTypeInfoOnly} {
+		if strings.ToUpper(name) == typ.String() {
+			return typ, nil
+		}
+	}
+
+	return RASTypeAny, errors.Errorf("unknown RAS event type %q", name)
+}
@@ -0,0 +1,281 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"context"
+	"encoding/binary"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.etcd.io/bbolt"
+	"google.golang.org/protobuf/proto"
+
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// DefaultJournalMaxAge is the default age after which journaled events
+	// are discarded.
+	DefaultJournalMaxAge = 30 * 24 * time.Hour
+	// DefaultJournalMaxSize is the default limit on the total size of
+	// journaled events, in bytes.
+	DefaultJournalMaxSize = 64 << 20
+
+	journalBucket  = "ras_events"
+	journalKeyLen  = 16
+	journalTimeout = 1 * time.Second
+)
+
+// ErrJournalClosed indicates that an operation was attempted on a closed journal.
+var ErrJournalClosed = errors.New("event journal is not open")
+
+// JournalConfig defines the location and retention limits of a Journal.
+type JournalConfig struct {
+	Path    string
+	MaxAge  time.Duration
+	MaxSize uint64
+}
+
+// Journal persists received RAS events to a bounded on-disk store so that
+// they can be queried after the fact.
+type Journal struct {
+	sync.RWMutex
+	log     logging.Logger
+	cfg     JournalConfig
+	db      *bbolt.DB
+	curSize uint64
+	now     func() time.Time
+}
+
+// NewJournal returns an initialized (but not yet opened) Journal.
+func NewJournal(log logging.Logger, cfg JournalConfig) *Journal {
+	if cfg.MaxAge == 0 {
+		cfg.MaxAge = DefaultJournalMaxAge
+	}
+	if cfg.MaxSize == 0 {
+		cfg.MaxSize = DefaultJournalMaxSize
+	}
+
+	return &Journal{
+		log: log,
+		cfg: cfg,
+		now: time.Now,
+	}
+}
+
+// Open opens (creating if necessary) the on-disk journal store.
+func (j *Journal) Open() error {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.db != nil {
+		return nil
+	}
+	if j.cfg.Path == "" {
+		return errors.New("event journal path not set")
+	}
+
+	db, err := bbolt.Open(j.cfg.Path, 0600, &bbolt.Options{Timeout: journalTimeout})
+	if err != nil {
+		return errors.Wrapf(err, "failed to open event journal at %s", j.cfg.Path)
+	}
+
+	var curSize uint64
+	if err := db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists([]byte(journalBucket))
+		if err != nil {
+			return err
+		}
+		return b.ForEach(func(k, v []byte) error {
+			curSize += uint64(len(k) + len(v))
+			return nil
+		})
+	}); err != nil {
+		db.Close()
+		return errors.Wrap(err, "failed to initialize event journal")
+	}
+
+	j.db = db
+	j.curSize = curSize
+	j.log.Debugf("opened event journal at %s (%d bytes)", j.cfg.Path, curSize)
+
+	return nil
+}
+
+// Close closes the on-disk journal store.
+func (j *Journal) Close() error {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.db == nil {
+		return nil
+	}
+
+	err := j.db.Close()
+	j.db = nil
+	j.curSize = 0
+
+	return err
+}
+
+// IsOpen returns true if the journal is ready to store events.
+func (j *Journal) IsOpen() bool {
+	j.RLock()
+	defer j.RUnlock()
+
+	return j.db != nil
+}
+
+// journalKey returns a key which sorts journal entries by time of receipt,
+// using the bucket sequence to disambiguate entries with equal timestamps.
+func journalKey(ts time.Time, seq uint64) []byte {
+	key := make([]byte, journalKeyLen)
+	binary.BigEndian.PutUint64(key[:8], uint64(ts.UnixNano()))
+	binary.BigEndian.PutUint64(key[8:], seq)
+
+	return key
+}
+
+func journalKeyTime(key []byte) time.Time {
+	if len(key) != journalKeyLen {
+		return time.Time{}
+	}
+
+	return time.Unix(0, int64(binary.BigEndian.Uint64(key[:8])))
+}
+
+// prune removes the oldest entries until the retention limits are satisfied.
+// Must be called with the write lock held.
+func (j *Journal) prune(b *bbolt.Bucket) error {
+	cutoff := j.now().Add(-j.cfg.MaxAge)
+
+	c := b.Cursor()
+	for k, v := c.First(); k != nil; k, v = c.First() {
+		if j.curSize <= j.cfg.MaxSize && !journalKeyTime(k).Before(cutoff) {
+			break
+		}
+		entrySize := uint64(len(k) + len(v))
+		if err := c.Delete(); err != nil {
+			return err
+		}
+		if entrySize > j.curSize {
+			entrySize = j.curSize
+		}
+		j.curSize -= entrySize
+	}
+
+	return nil
+}
+
+// Append stores the supplied event in the journal, discarding old entries
+// as necessary.
+func (j *Journal) Append(evt *RASEvent) error {
+	if evt == nil {
+		return errors.New("nil event")
+	}
+
+	pbEvt, err := evt.ToProto()
+	if err != nil {
+		return err
+	}
+	data, err := proto.Marshal(pbEvt)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal event")
+	}
+
+	j.Lock()
+	defer j.Unlock()
+
+	if j.db == nil {
+		return ErrJournalClosed
+	}
+
+	return j.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket([]byte(journalBucket))
+		seq, err := b.NextSequence()
+		if err != nil {
+			return err
+		}
+
+		key := journalKey(j.now(), seq)
+		if err := b.Put(key, data); err != nil {
+			return err
+		}
+		j.curSize += uint64(len(key) + len(data))
+
+		return j.prune(b)
+	})
+}
+
+// Prune removes entries that fall outside of the journal retention limits.
+func (j *Journal) Prune() error {
+	j.Lock()
+	defer j.Unlock()
+
+	if j.db == nil {
+		return ErrJournalClosed
+	}
+
+	return j.db.Update(func(tx *bbolt.Tx) error {
+		return j.prune(tx.Bucket([]byte(journalBucket)))
+	})
+}
+
+// Query returns journaled events matching the supplied filter in the order in
+// which they were received. If limit is nonzero, only the most recent matching
+// events up to the limit are returned.
+func (j *Journal) Query(filter *Filter, limit int) ([]*RASEvent, error) {
+	j.RLock()
+	defer j.RUnlock()
+
+	if j.db == nil {
+		return nil, ErrJournalClosed
+	}
+
+	var evts []*RASEvent
+	if err := j.db.View(func(tx *bbolt.Tx) error {
+		c := tx.Bucket([]byte(journalBucket)).Cursor()
+		for k, v := c.Last(); k != nil; k, v = c.Prev() {
+			pbEvt := new(sharedpb.RASEvent)
+			if err := proto.Unmarshal(v, pbEvt); err != nil {
+				return errors.Wrap(err, "failed to unmarshal journaled event")
+			}
+			evt, err := NewFromProto(pbEvt)
+			if err != nil {
+				return err
+			}
+			if !filter.Matches(evt) {
+				continue
+			}
+
+			evts = append(evts, evt)
+			if limit > 0 && len(evts) == limit {
+				break
+			}
+		}
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	// Entries were collected newest-first; return them in order of receipt.
+	for i, k := 0, len(evts)-1; i < k; i, k = i+1, k-1 {
+		evts[i], evts[k] = evts[k], evts[i]
+	}
+
+	return evts, nil
+}
+
+// OnEvent implements the Handler interface and stores the received event.
+func (j *Journal) OnEvent(_ context.Context, evt *RASEvent) {
+	if err := j.Append(evt); err != nil {
+		j.log.Errorf("failed to journal RAS event %s: %s", evt.ID, err)
+	}
+}
@@ -0,0 +1,238 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func mockJournalEvt(id RASID, sev RASSeverityID, rank uint32, host string, ts time.Time) *RASEvent {
+	return &RASEvent{
+		ID:        id,
+		Timestamp: common.FormatTime(ts),
+		Type:      RASTypeStateChange,
+		Severity:  sev,
+		Msg:       id.String(),
+		Hostname:  host,
+		Rank:      rank,
+	}
+}
+
+func TestEvents_Journal_Query(t *testing.T) {
+	baseTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	evts := []*RASEvent{
+		mockJournalEvt(RASEngineDied, RASSeverityError, 0, "host1", baseTime),
+		mockJournalEvt(RASSwimRankDead, RASSeverityNotice, 1, "host2", baseTime.Add(time.Minute)),
+		mockJournalEvt(RASEngineFormatRequired, RASSeverityWarning, 2, "host1", baseTime.Add(2*time.Minute)),
+		mockJournalEvt(RASEngineDied, RASSeverityError, 3, "host3", baseTime.Add(3*time.Minute)),
+	}
+
+	for name, tc := range map[string]struct {
+		filter  *Filter
+		limit   int
+		closed  bool
+		expEvts []*RASEvent
+		expErr  error
+	}{
+		"closed": {
+			closed: true,
+			expErr: ErrJournalClosed,
+		},
+		"nil filter": {
+			expEvts: evts,
+		},
+		"limit": {
+			limit:   2,
+			expEvts: evts[2:],
+		},
+		"by id": {
+			filter:  &Filter{IDs: []RASID{RASEngineDied}},
+			expEvts: []*RASEvent{evts[0], evts[3]},
+		},
+		"by id with limit": {
+			filter:  &Filter{IDs: []RASID{RASEngineDied}},
+			limit:   1,
+			expEvts: []*RASEvent{evts[3]},
+		},
+		"by min severity": {
+			filter:  &Filter{MinSeverity: RASSeverityWarning},
+			expEvts: []*RASEvent{evts[0], evts[2], evts[3]},
+		},
+		"by rank": {
+			filter:  &Filter{Ranks: []uint32{1, 2}},
+			expEvts: []*RASEvent{evts[1], evts[2]},
+		},
+		"by host": {
+			filter:  &Filter{Hosts: []string{"host1"}},
+			expEvts: []*RASEvent{evts[0], evts[2]},
+		},
+		"by type": {
+			filter: &Filter{Type: RASTypeInfoOnly},
+		},
+		"by time window": {
+			filter: &Filter{
+				Since: baseTime.Add(30 * time.Second),
+				Until: baseTime.Add(2 * time.Minute),
+			},
+			expEvts: []*RASEvent{evts[1], evts[2]},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			j := NewJournal(log, JournalConfig{
+				Path: filepath.Join(tmpDir, "journal.db"),
+			})
+			if err := j.Open(); err != nil {
+				t.Fatal(err)
+			}
+			for _, evt := range evts {
+				if err := j.Append(evt); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.closed {
+				if err := j.Close(); err != nil {
+					t.Fatal(err)
+				}
+			}
+			defer j.Close()
+
+			gotEvts, gotErr := j.Query(tc.filter, tc.limit)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expEvts, gotEvts, defEvtCmpOpts...); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestEvents_Journal_Retention(t *testing.T) {
+	baseTime := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+	mockEvt := func(rank uint32) *RASEvent {
+		return mockJournalEvt(RASEngineDied, RASSeverityError, rank, "host1", baseTime)
+	}
+	evtSize := func(t *testing.T) uint64 {
+		pbEvt, err := mockEvt(1).ToProto()
+		if err != nil {
+			t.Fatal(err)
+		}
+		data, err := proto.Marshal(pbEvt)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return uint64(len(data) + journalKeyLen)
+	}
+
+	for name, tc := range map[string]struct {
+		maxAge   time.Duration
+		maxSize  func(*testing.T) uint64
+		interval time.Duration
+		count    int
+		expRanks []uint32
+	}{
+		"within limits": {
+			count:    3,
+			interval: time.Second,
+			expRanks: []uint32{1, 2, 3},
+		},
+		"age exceeded": {
+			maxAge:   time.Minute,
+			count:    4,
+			interval: 30 * time.Second,
+			expRanks: []uint32{2, 3, 4},
+		},
+		"size exceeded": {
+			maxSize: func(t *testing.T) uint64 {
+				return 2 * evtSize(t)
+			},
+			count:    4,
+			interval: time.Second,
+			expRanks: []uint32{3, 4},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			cfg := JournalConfig{
+				Path:   filepath.Join(tmpDir, "journal.db"),
+				MaxAge: tc.maxAge,
+			}
+			if tc.maxSize != nil {
+				cfg.MaxSize = tc.maxSize(t)
+			}
+			j := NewJournal(log, cfg)
+			curTime := baseTime
+			j.now = func() time.Time { return curTime }
+
+			if err := j.Open(); err != nil {
+				t.Fatal(err)
+			}
+			defer j.Close()
+
+			for i := 0; i < tc.count; i++ {
+				if err := j.Append(mockEvt(uint32(i + 1))); err != nil {
+					t.Fatal(err)
+				}
+				curTime = curTime.Add(tc.interval)
+			}
+
+			// Reopen the journal to verify that entries are persisted.
+			if err := j.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if err := j.Open(); err != nil {
+				t.Fatal(err)
+			}
+
+			gotEvts, err := j.Query(nil, 0)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotRanks []uint32
+			for _, evt := range gotEvts {
+				gotRanks = append(gotRanks, evt.Rank)
+			}
+			if diff := cmp.Diff(tc.expRanks, gotRanks); diff != "" {
+				t.Fatalf("unexpected retained events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestEvents_Journal_NotOpen(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	j := NewJournal(log, JournalConfig{})
+	test.CmpErr(t, errors.New("path not set"), j.Open())
+	test.CmpErr(t, ErrJournalClosed, j.Append(mockEvtDied(t)))
+	test.CmpErr(t, ErrJournalClosed, j.Prune())
+	test.AssertFalse(t, j.IsOpen(), "journal should not be open")
+}
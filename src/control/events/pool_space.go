@@ -0,0 +1,40 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"fmt"
+	"math"
+)
+
+// NewPoolSpaceEvent creates a pool space event with the supplied ID, raised
+// when the used space of a pool crosses one of its configured thresholds.
+// The details string describes the usage of the affected storage tiers.
+func NewPoolSpaceEvent(id RASID, poolUUID, details string) *RASEvent {
+	var msg string
+	sev := RASSeverityNotice
+	switch id {
+	case RASPoolSpaceWarning:
+		msg = "DAOS pool %s space usage has reached the warning threshold"
+		sev = RASSeverityWarning
+	case RASPoolSpaceCritical:
+		msg = "DAOS pool %s space usage has reached the critical threshold"
+		sev = RASSeverityError
+	default:
+		msg = "DAOS pool %s space usage has returned below the warning threshold"
+	}
+
+	return fill(&RASEvent{
+		Msg:          fmt.Sprintf(msg, poolUUID) + ": " + details,
+		ID:           id,
+		PoolUUID:     poolUUID,
+		Rank:         math.MaxUint32,
+		Type:         RASTypeInfoOnly,
+		Severity:     sev,
+		ExtendedInfo: NewStrInfo(details),
+	})
+}
@@ -0,0 +1,62 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEvents_NewPoolSpaceEvent(t *testing.T) {
+	for name, tc := range map[string]struct {
+		id     RASID
+		expSev RASSeverityID
+		expMsg string
+	}{
+		"warning": {
+			id:     RASPoolSpaceWarning,
+			expSev: RASSeverityWarning,
+			expMsg: "DAOS pool " + tUuid + " space usage has reached the warning threshold: SCM 82% used",
+		},
+		"critical": {
+			id:     RASPoolSpaceCritical,
+			expSev: RASSeverityError,
+			expMsg: "DAOS pool " + tUuid + " space usage has reached the critical threshold: SCM 82% used",
+		},
+		"cleared": {
+			id:     RASPoolSpaceCleared,
+			expSev: RASSeverityNotice,
+			expMsg: "DAOS pool " + tUuid + " space usage has returned below the warning threshold: SCM 82% used",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			event := NewPoolSpaceEvent(tc.id, tUuid, "SCM 82% used")
+
+			if event.Severity != tc.expSev {
+				t.Fatalf("expected severity %s, got %s", tc.expSev, event.Severity)
+			}
+			if diff := cmp.Diff(tc.expMsg, event.Msg); diff != "" {
+				t.Fatalf("unexpected message (-want, +got):\n%s\n", diff)
+			}
+
+			pbEvent, err := event.ToProto()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			returnedEvent := new(RASEvent)
+			if err := returnedEvent.FromProto(pbEvent); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(event, returnedEvent, defEvtCmpOpts...); diff != "" {
+				t.Fatalf("unexpected event (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
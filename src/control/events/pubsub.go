@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,6 +8,7 @@ package events
 
 import (
 	"context"
+	"reflect"
 	"time"
 
 	"github.com/daos-stack/daos/src/control/common"
@@ -44,8 +45,9 @@ type Publisher interface {
 }
 
 type subscriber struct {
-	topic   RASTypeID
-	handler Handler
+	topic       RASTypeID
+	handler     Handler
+	unsubscribe bool
 }
 
 // filterUpdate enables or disables publishing of given event ids.
@@ -170,6 +172,37 @@ func (ps *PubSub) Subscribe(topic RASTypeID, handler Handler) {
 	}
 }
 
+// Unsubscribe removes a handler from the list of handlers subscribed to a
+// given topic (event type). The handler must be of a comparable type (e.g. a
+// pointer) in order to be identified for removal.
+func (ps *PubSub) Unsubscribe(topic RASTypeID, handler Handler) {
+	if common.InterfaceIsNil(handler) || !reflect.TypeOf(handler).Comparable() {
+		ps.log.Errorf("cannot unsubscribe handler of type %T", handler)
+		return
+	}
+
+	select {
+	case <-time.After(submitTimeout):
+		ps.log.Errorf("failed to submit unsubscription within %s", submitTimeout)
+	case ps.subscribers <- &subscriber{
+		topic:       topic,
+		handler:     handler,
+		unsubscribe: true,
+	}:
+	}
+}
+
+func (ps *PubSub) removeHandler(topic RASTypeID, handler Handler) {
+	hdlrs := ps.handlers[topic]
+	for i, hdlr := range hdlrs {
+		if reflect.TypeOf(hdlr) != reflect.TypeOf(handler) || hdlr != handler {
+			continue
+		}
+		ps.handlers[topic] = append(hdlrs[:i:i], hdlrs[i+1:]...)
+		return
+	}
+}
+
 // Debounce accepts an event ID and a key function to be used to determine
 // if an event matches a previously-seen event with that ID. This mechanism
 // provides control over publication of duplicate events. The cooldown parameter
@@ -264,6 +297,10 @@ func (ps *PubSub) eventLoop(ctx context.Context) {
 			ps.dbncCtrl = make(dbncCtrl)
 			ps.dbncEvts = make(dbncEvts)
 		case newSub := <-ps.subscribers:
+			if newSub.unsubscribe {
+				ps.removeHandler(newSub.topic, newSub.handler)
+				break
+			}
 			ps.handlers[newSub.topic] = append(ps.handlers[newSub.topic],
 				newSub.handler)
 		case event := <-ps.events:
@@ -115,6 +115,34 @@ func TestEvents_PubSub_Reset(t *testing.T) {
 	test.AssertEqual(t, 0, len(tly1.getRx()), "unexpected number of received events")
 }
 
+func TestEvents_PubSub_Unsubscribe(t *testing.T) {
+	evt1 := mockEvtDied(t)
+
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	ps := NewPubSub(test.Context(t), log)
+	defer ps.Close()
+
+	tly1 := newTally(1)
+	tly2 := newTally(2)
+
+	ps.Subscribe(RASTypeStateChange, tly1)
+	ps.Subscribe(RASTypeStateChange, tly2)
+	// Non-comparable handlers are rejected rather than causing a panic.
+	ps.Unsubscribe(RASTypeStateChange, HandlerFunc(func(context.Context, *RASEvent) {}))
+
+	ps.Publish(evt1)
+	<-tly1.finished
+
+	ps.Unsubscribe(RASTypeStateChange, tly1)
+	ps.Publish(evt1)
+	<-tly2.finished
+
+	test.AssertEqual(t, 1, len(tly1.getRx()), "unexpected number of received events")
+	test.AssertEqual(t, 2, len(tly2.getRx()), "unexpected number of received events")
+}
+
 func TestEvents_PubSub_DisableEvent(t *testing.T) {
 	evt1 := mockEvtDied(t)
 	evt2 := mockEvtSvcReps(t)
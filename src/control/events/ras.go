@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -45,14 +45,18 @@ type RASID uint32
 // RASID constant definitions matching those used when creating events either in
 // the control or data (engine) planes.
 const (
-	RASUnknownEvent         RASID = C.RAS_UNKNOWN_EVENT
-	RASEngineFormatRequired RASID = C.RAS_ENGINE_FORMAT_REQUIRED // notice
-	RASEngineDied           RASID = C.RAS_ENGINE_DIED            // error
-	RASPoolRepsUpdate       RASID = C.RAS_POOL_REPS_UPDATE       // info
-	RASSwimRankAlive        RASID = C.RAS_SWIM_RANK_ALIVE        // info
-	RASSwimRankDead         RASID = C.RAS_SWIM_RANK_DEAD         // info
-	RASSystemStartFailed    RASID = C.RAS_SYSTEM_START_FAILED    // error
-	RASSystemStopFailed     RASID = C.RAS_SYSTEM_STOP_FAILED     // error
+	RASUnknownEvent           RASID = C.RAS_UNKNOWN_EVENT
+	RASEngineFormatRequired   RASID = C.RAS_ENGINE_FORMAT_REQUIRED   // notice
+	RASEngineDied             RASID = C.RAS_ENGINE_DIED              // error
+	RASPoolRepsUpdate         RASID = C.RAS_POOL_REPS_UPDATE         // info
+	RASSwimRankAlive          RASID = C.RAS_SWIM_RANK_ALIVE          // info
+	RASSwimRankDead           RASID = C.RAS_SWIM_RANK_DEAD           // info
+	RASSystemStartFailed      RASID = C.RAS_SYSTEM_START_FAILED      // error
+	RASSystemStopFailed       RASID = C.RAS_SYSTEM_STOP_FAILED       // error
+	RASEngineRestartAbandoned RASID = C.RAS_ENGINE_RESTART_ABANDONED // error
+	RASPoolSpaceWarning       RASID = C.RAS_POOL_SPACE_WARNING       // warning
+	RASPoolSpaceCritical      RASID = C.RAS_POOL_SPACE_CRITICAL      // error
+	RASPoolSpaceCleared       RASID = C.RAS_POOL_SPACE_CLEARED       // notice
 )
 
 func (id RASID) String() string {
@@ -0,0 +1,326 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// DefaultWebhookBatchSize is the default maximum number of events
+	// delivered in a single request.
+	DefaultWebhookBatchSize = 16
+	// DefaultWebhookBatchInterval is the default interval at which queued
+	// events are delivered if a full batch has not accumulated.
+	DefaultWebhookBatchInterval = 5 * time.Second
+	// DefaultWebhookTimeout is the default timeout for a single request.
+	DefaultWebhookTimeout = 10 * time.Second
+	// DefaultWebhookMaxRetries is the default number of times delivery of
+	// a batch is retried before the batch is left in the spool.
+	DefaultWebhookMaxRetries = 3
+	// DefaultWebhookRetryInterval is the default base interval between
+	// delivery retries.
+	DefaultWebhookRetryInterval = 1 * time.Second
+	// DefaultWebhookSpoolSize is the default maximum number of undelivered
+	// events retained per URL.
+	DefaultWebhookSpoolSize = 1024
+
+	webhookMaxBackoffFactor = 6
+)
+
+// WebhookConfig defines the destinations and delivery parameters of a
+// WebhookSink.
+type WebhookConfig struct {
+	URLs          []string
+	Headers       map[string]string
+	Filter        *Filter
+	BatchSize     int
+	BatchInterval time.Duration
+	Timeout       time.Duration
+	MaxRetries    int
+	RetryInterval time.Duration
+	SpoolSize     int
+}
+
+func (cfg *WebhookConfig) setDefaults() {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultWebhookBatchSize
+	}
+	if cfg.BatchInterval <= 0 {
+		cfg.BatchInterval = DefaultWebhookBatchInterval
+	}
+	if cfg.Timeout <= 0 {
+		cfg.Timeout = DefaultWebhookTimeout
+	}
+	if cfg.MaxRetries <= 0 {
+		cfg.MaxRetries = DefaultWebhookMaxRetries
+	}
+	if cfg.RetryInterval <= 0 {
+		cfg.RetryInterval = DefaultWebhookRetryInterval
+	}
+	if cfg.SpoolSize <= 0 {
+		cfg.SpoolSize = DefaultWebhookSpoolSize
+	}
+	if cfg.SpoolSize < cfg.BatchSize {
+		cfg.SpoolSize = cfg.BatchSize
+	}
+}
+
+// WebhookSink implements the Handler interface and delivers events to a set
+// of HTTP endpoints. Events are POSTed in batches as a JSON array of events.
+// Received events are added to a bounded per-URL spool, discarding the oldest
+// events when the spool is full, and delivered from the spool in the
+// background so that a slow or failing endpoint never blocks event handling.
+type WebhookSink struct {
+	log     logging.Logger
+	cfg     WebhookConfig
+	client  *http.Client
+	targets []*webhookTarget
+}
+
+// webhookTarget delivers events to a single URL.
+type webhookTarget struct {
+	sink  *WebhookSink
+	url   string
+	ready chan struct{}
+
+	pendingLock sync.Mutex
+	pending     []*RASEvent
+}
+
+// NewWebhookSink returns an initialized WebhookSink. Delivery to the configured
+// URLs does not begin until Start is called.
+func NewWebhookSink(log logging.Logger, cfg WebhookConfig) (*WebhookSink, error) {
+	if len(cfg.URLs) == 0 {
+		return nil, errors.New("no webhook URLs specified")
+	}
+	cfg.setDefaults()
+
+	sink := &WebhookSink{
+		log:    log,
+		cfg:    cfg,
+		client: &http.Client{Timeout: cfg.Timeout},
+	}
+	for _, u := range cfg.URLs {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid webhook URL %q", u)
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return nil, errors.Errorf("invalid webhook URL %q: scheme must be http or https", u)
+		}
+
+		sink.targets = append(sink.targets, &webhookTarget{
+			sink:  sink,
+			url:   u,
+			ready: make(chan struct{}, 1),
+		})
+	}
+
+	return sink, nil
+}
+
+// Start begins delivery of received events. Delivery stops when the supplied
+// context is canceled.
+func (ws *WebhookSink) Start(ctx context.Context) {
+	for _, tgt := range ws.targets {
+		go tgt.run(ctx)
+	}
+}
+
+// OnEvent implements the Handler interface and queues matching events for
+// delivery. Forwarded events are ignored as they will have been delivered by
+// the host that raised them.
+func (ws *WebhookSink) OnEvent(_ context.Context, evt *RASEvent) {
+	switch {
+	case evt == nil:
+		ws.log.Debug("skip webhook delivery, nil event")
+		return
+	case evt.IsForwarded():
+		return
+	case !ws.cfg.Filter.Matches(evt):
+		return
+	}
+
+	for _, tgt := range ws.targets {
+		tgt.spool(evt)
+	}
+}
+
+func (wt *webhookTarget) run(ctx context.Context) {
+	ticker := time.NewTicker(wt.sink.cfg.BatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			wt.pendingLock.Lock()
+			if len(wt.pending) > 0 {
+				wt.sink.log.Debugf("webhook %s: discarding %d undelivered events on shutdown",
+					wt.url, len(wt.pending))
+			}
+			wt.pendingLock.Unlock()
+			return
+		case <-wt.ready:
+		case <-ticker.C:
+		}
+
+		wt.flush(ctx)
+	}
+}
+
+// trimSpool discards the oldest pending events if the spool limit has been
+// exceeded. The caller must hold the pending lock.
+func (wt *webhookTarget) trimSpool() {
+	if over := len(wt.pending) - wt.sink.cfg.SpoolSize; over > 0 {
+		wt.sink.log.Errorf("webhook %s: spool full, discarding %d oldest events", wt.url, over)
+		wt.pending = wt.pending[over:]
+	}
+}
+
+// spool adds an event to the pending queue, discarding the oldest events if
+// the spool limit has been reached. Delivery is triggered once a full batch
+// has accumulated.
+func (wt *webhookTarget) spool(evt *RASEvent) {
+	wt.pendingLock.Lock()
+	defer wt.pendingLock.Unlock()
+
+	wt.pending = append(wt.pending, evt)
+	wt.trimSpool()
+
+	if len(wt.pending) >= wt.sink.cfg.BatchSize {
+		select {
+		case wt.ready <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// nextBatch removes and returns the oldest pending events, up to the batch
+// size.
+func (wt *webhookTarget) nextBatch() []*RASEvent {
+	wt.pendingLock.Lock()
+	defer wt.pendingLock.Unlock()
+
+	n := len(wt.pending)
+	if n > wt.sink.cfg.BatchSize {
+		n = wt.sink.cfg.BatchSize
+	}
+	batch := make([]*RASEvent, n)
+	copy(batch, wt.pending)
+	wt.pending = wt.pending[n:]
+
+	return batch
+}
+
+// requeue returns a batch that could not be delivered to the front of the
+// pending queue. Events received in the meantime take precedence if the
+// spool limit is exceeded.
+func (wt *webhookTarget) requeue(batch []*RASEvent) int {
+	wt.pendingLock.Lock()
+	defer wt.pendingLock.Unlock()
+
+	wt.pending = append(batch, wt.pending...)
+	wt.trimSpool()
+
+	return len(wt.pending)
+}
+
+// flush attempts to deliver all pending events in batches, leaving any that
+// could not be delivered in the spool. The spool is not locked during
+// delivery, so events continue to be received while it is in progress.
+func (wt *webhookTarget) flush(ctx context.Context) {
+	for {
+		batch := wt.nextBatch()
+		if len(batch) == 0 {
+			return
+		}
+
+		retryable, err := wt.deliver(ctx, batch)
+		if err == nil {
+			continue
+		}
+		if retryable {
+			nrSpooled := wt.requeue(batch)
+			wt.sink.log.Errorf("webhook %s: delivery failed, %d events spooled: %s",
+				wt.url, nrSpooled, err)
+			return
+		}
+		wt.sink.log.Errorf("webhook %s: delivery rejected, discarding %d events: %s",
+			wt.url, len(batch), err)
+	}
+}
+
+// deliver sends a batch of events, retrying with backoff on transient errors.
+func (wt *webhookTarget) deliver(ctx context.Context, batch []*RASEvent) (retryable bool, err error) {
+	cfg := wt.sink.cfg
+
+	for try := 0; try <= cfg.MaxRetries; try++ {
+		if try > 0 {
+			backoff := common.ExpBackoffWithJitter(cfg.RetryInterval, cfg.RetryInterval/10,
+				uint64(try), webhookMaxBackoffFactor)
+			wt.sink.log.Debugf("webhook %s: retrying delivery in %s: %s", wt.url, backoff, err)
+			select {
+			case <-ctx.Done():
+				return true, ctx.Err()
+			case <-time.After(backoff):
+			}
+		}
+
+		if retryable, err = wt.post(ctx, batch); err == nil || !retryable {
+			return
+		}
+	}
+
+	return
+}
+
+// post sends a single request containing the batch of events. Connection
+// failures, server errors and throttling responses are considered retryable.
+func (wt *webhookTarget) post(ctx context.Context, batch []*RASEvent) (bool, error) {
+	body, err := json.Marshal(batch)
+	if err != nil {
+		return false, errors.Wrap(err, "failed to marshal events")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, wt.url, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for key, val := range wt.sink.cfg.Headers {
+		req.Header.Set(key, val)
+	}
+
+	resp, err := wt.sink.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests:
+		return true, errors.Errorf("received %s", resp.Status)
+	default:
+		return false, errors.Errorf("received %s", resp.Status)
+	}
+}
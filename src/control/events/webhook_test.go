@@ -0,0 +1,341 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package events
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// receivedEvent contains the subset of delivered event fields checked by the
+// webhook tests.
+type receivedEvent struct {
+	ID       uint32 `json:"id"`
+	Severity uint32 `json:"severity"`
+	Rank     uint32 `json:"rank"`
+}
+
+// mockReceiver records event batches POSTed to it, responding with the
+// configured sequence of status codes (defaulting to 200 OK).
+type mockReceiver struct {
+	sync.Mutex
+	t        *testing.T
+	statuses []int
+	requests int
+	headers  []http.Header
+	blocked  chan struct{}
+	received chan []receivedEvent
+}
+
+func newMockReceiver(t *testing.T, statuses ...int) (*mockReceiver, *httptest.Server) {
+	mr := &mockReceiver{
+		t:        t,
+		statuses: statuses,
+		received: make(chan []receivedEvent, 16),
+	}
+	srv := httptest.NewServer(mr)
+	t.Cleanup(srv.Close)
+
+	return mr, srv
+}
+
+func (mr *mockReceiver) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	mr.Lock()
+	status := http.StatusOK
+	if mr.requests < len(mr.statuses) {
+		status = mr.statuses[mr.requests]
+	}
+	mr.requests++
+	mr.headers = append(mr.headers, r.Header.Clone())
+	blocked := mr.blocked
+	mr.Unlock()
+
+	if blocked != nil {
+		<-blocked
+	}
+
+	if status != http.StatusOK {
+		w.WriteHeader(status)
+		return
+	}
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		mr.t.Errorf("failed to read request body: %s", err)
+		return
+	}
+	var batch []receivedEvent
+	if err := json.Unmarshal(body, &batch); err != nil {
+		mr.t.Errorf("failed to unmarshal events: %s", err)
+		return
+	}
+	mr.received <- batch
+}
+
+// block causes requests to the receiver to stall until the returned function
+// is called.
+func (mr *mockReceiver) block() func() {
+	mr.Lock()
+	defer mr.Unlock()
+
+	mr.blocked = make(chan struct{})
+	return func() {
+		close(mr.blocked)
+	}
+}
+
+func (mr *mockReceiver) getRequests() int {
+	mr.Lock()
+	defer mr.Unlock()
+
+	return mr.requests
+}
+
+func (mr *mockReceiver) waitBatch(t *testing.T) []receivedEvent {
+	t.Helper()
+
+	select {
+	case batch := <-mr.received:
+		return batch
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event batch")
+	}
+	return nil
+}
+
+func mockWebhookEvent(rank uint32) *RASEvent {
+	return NewEngineDiedEvent("foo", 0, rank, common.NormalExit, 1234)
+}
+
+func batchRanks(batch []receivedEvent) []uint32 {
+	ranks := make([]uint32, 0, len(batch))
+	for _, evt := range batch {
+		ranks = append(ranks, evt.Rank)
+	}
+	return ranks
+}
+
+func TestEvents_NewWebhookSink(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    WebhookConfig
+		expErr error
+	}{
+		"no urls": {
+			expErr: errors.New("no webhook URLs"),
+		},
+		"bad scheme": {
+			cfg:    WebhookConfig{URLs: []string{"ftp://foo/bar"}},
+			expErr: errors.New("scheme must be http or https"),
+		},
+		"bad url": {
+			cfg:    WebhookConfig{URLs: []string{"http://foo bar:baz"}},
+			expErr: errors.New("invalid webhook URL"),
+		},
+		"defaults applied": {
+			cfg: WebhookConfig{URLs: []string{"http://foo/bar", "https://baz/qux"}},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			sink, err := NewWebhookSink(log, tc.cfg)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, len(tc.cfg.URLs), len(sink.targets), "unexpected number of targets")
+			test.AssertEqual(t, DefaultWebhookBatchSize, sink.cfg.BatchSize, "unexpected batch size")
+			test.AssertEqual(t, DefaultWebhookSpoolSize, sink.cfg.SpoolSize, "unexpected spool size")
+		})
+	}
+}
+
+func TestEvents_WebhookSink_Batching(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mr, srv := newMockReceiver(t)
+	sink, err := NewWebhookSink(log, WebhookConfig{
+		URLs:          []string{srv.URL},
+		Headers:       map[string]string{"Authorization": "Bearer token"},
+		Filter:        &Filter{MinSeverity: RASSeverityError},
+		BatchSize:     2,
+		BatchInterval: time.Hour,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink.Start(test.Context(t))
+
+	ctx := test.Context(t)
+	sink.OnEvent(ctx, mockWebhookEvent(1))
+	// Filtered out by severity.
+	sink.OnEvent(ctx, NewEngineFormatRequiredEvent("foo", 0, "Metadata"))
+	// Ignored as it will have been delivered by the originating host.
+	sink.OnEvent(ctx, mockWebhookEvent(2).WithForwarded(true))
+	sink.OnEvent(ctx, mockWebhookEvent(3))
+
+	batch := mr.waitBatch(t)
+	test.AssertEqual(t, []uint32{1, 3}, batchRanks(batch), "unexpected batch")
+	test.AssertEqual(t, RASEngineDied.Uint32(), batch[0].ID, "unexpected event ID")
+	test.AssertEqual(t, RASSeverityError.Uint32(), batch[0].Severity, "unexpected event severity")
+
+	mr.Lock()
+	defer mr.Unlock()
+	test.AssertEqual(t, "Bearer token", mr.headers[0].Get("Authorization"), "unexpected auth header")
+	test.AssertEqual(t, "application/json", mr.headers[0].Get("Content-Type"), "unexpected content type")
+}
+
+func TestEvents_WebhookSink_Retry(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mr, srv := newMockReceiver(t, http.StatusServiceUnavailable, http.StatusTooManyRequests)
+	sink, err := NewWebhookSink(log, WebhookConfig{
+		URLs:          []string{srv.URL},
+		BatchSize:     1,
+		RetryInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink.Start(test.Context(t))
+
+	sink.OnEvent(test.Context(t), mockWebhookEvent(1))
+
+	test.AssertEqual(t, []uint32{1}, batchRanks(mr.waitBatch(t)), "unexpected batch")
+	test.AssertEqual(t, 3, mr.getRequests(), "unexpected number of requests")
+}
+
+func TestEvents_WebhookSink_Rejected(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	mr, srv := newMockReceiver(t, http.StatusBadRequest)
+	sink, err := NewWebhookSink(log, WebhookConfig{
+		URLs:          []string{srv.URL},
+		BatchSize:     1,
+		RetryInterval: time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink.Start(test.Context(t))
+
+	sink.OnEvent(test.Context(t), mockWebhookEvent(1))
+	sink.OnEvent(test.Context(t), mockWebhookEvent(2))
+
+	// The rejected batch is discarded rather than retried.
+	test.AssertEqual(t, []uint32{2}, batchRanks(mr.waitBatch(t)), "unexpected batch")
+	test.AssertEqual(t, 2, mr.getRequests(), "unexpected number of requests")
+}
+
+func TestEvents_WebhookSink_Spool(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	const maxRetries = 1
+	statuses := make([]int, maxRetries+1)
+	for i := range statuses {
+		statuses[i] = http.StatusBadGateway
+	}
+
+	mr, srv := newMockReceiver(t, statuses...)
+	sink, err := NewWebhookSink(log, WebhookConfig{
+		URLs:          []string{srv.URL},
+		BatchSize:     1,
+		BatchInterval: 10 * time.Millisecond,
+		MaxRetries:    maxRetries,
+		RetryInterval: time.Millisecond,
+		SpoolSize:     2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Only the most recent events that fit in the spool are retained.
+	for i := uint32(1); i <= 4; i++ {
+		sink.OnEvent(test.Context(t), mockWebhookEvent(i))
+	}
+	sink.Start(test.Context(t))
+
+	// The first batch fails until the receiver recovers, and is retained
+	// in the spool until it has been delivered.
+	var got []uint32
+	for len(got) < 2 {
+		got = append(got, batchRanks(mr.waitBatch(t))...)
+	}
+	test.AssertEqual(t, []uint32{3, 4}, got, "unexpected delivered events")
+	test.AssertEqual(t, len(statuses)+2, mr.getRequests(), "unexpected number of requests")
+}
+
+func TestEvents_WebhookSink_SlowEndpoint(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	const numEvts = 200
+
+	mr, srv := newMockReceiver(t)
+	unblock := mr.block()
+	sink, err := NewWebhookSink(log, WebhookConfig{
+		URLs:          []string{srv.URL},
+		BatchSize:     1,
+		BatchInterval: time.Hour,
+		Timeout:       time.Minute,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sink.Start(test.Context(t))
+
+	// Wait until the first delivery is stalled by the receiver.
+	sink.OnEvent(test.Context(t), mockWebhookEvent(0))
+	for mr.getRequests() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	// Events continue to be accepted without blocking or being dropped
+	// while delivery is in progress.
+	done := make(chan struct{})
+	go func() {
+		for i := uint32(1); i < numEvts; i++ {
+			sink.OnEvent(test.Context(t), mockWebhookEvent(i))
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("event handling blocked by slow webhook endpoint")
+	}
+	test.AssertEqual(t, 1, mr.getRequests(), "unexpected number of requests")
+
+	unblock()
+
+	var got []uint32
+	for len(got) < numEvts {
+		got = append(got, batchRanks(mr.waitBatch(t))...)
+	}
+	for i, rank := range got {
+		if rank != uint32(i) {
+			t.Fatalf("unexpected event %d delivered at position %d", rank, i)
+		}
+	}
+}
@@ -151,6 +151,7 @@ const (
 	ServerIncompatibleComponents
 	ServerNoCompatibilityInsecure
 	ServerPoolHasContainers
+	ServerRanksInMaintenance
 )
 
 // server config fault codes
@@ -4,6 +4,7 @@ go 1.17
 
 require (
 	github.com/Jille/raft-grpc-transport v1.2.0
+	github.com/armon/go-metrics v0.4.0
 	github.com/dustin/go-humanize v1.0.0
 	github.com/google/go-cmp v0.5.8
 	github.com/google/uuid v1.3.0
@@ -26,7 +27,6 @@ require (
 )
 
 require (
-	github.com/armon/go-metrics v0.4.0 // indirect
 	github.com/beorn7/perks v1.0.1 // indirect
 	github.com/cespare/xxhash/v2 v2.1.2 // indirect
 	github.com/fatih/color v1.13.0 // indirect
@@ -0,0 +1,329 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// ACLResourceType identifies the type of resource an ACL is applied to.
+type ACLResourceType int
+
+const (
+	// ACLResourcePool indicates a pool ACL.
+	ACLResourcePool ACLResourceType = iota
+	// ACLResourceContainer indicates a container ACL.
+	ACLResourceContainer
+)
+
+const (
+	aclPermChars = "rwcdtTaAo"
+	// aclPoolPermChars are the permissions that apply to pools.
+	aclPoolPermChars = "rwcdt"
+
+	// aclContOwnerPermChars are the permissions that the owner of a
+	// container always has, regardless of the ACL (CONT_OWNER_MIN_PERMS).
+	// The owner of a pool has no such permissions.
+	aclContOwnerPermChars = "aA"
+
+	aclOwnerPrincipal      = "OWNER@"
+	aclOwnerGroupPrincipal = "GROUP@"
+	aclEveryonePrincipal   = "EVERYONE@"
+)
+
+// ACL match types, in order of precedence.
+const (
+	ACLMatchOwner    = "owner"
+	ACLMatchUser     = "user"
+	ACLMatchGroup    = "group"
+	ACLMatchEveryone = "everyone"
+	ACLMatchNone     = "none"
+)
+
+// aclPerms is a set of ACE permissions.
+type aclPerms uint16
+
+func parseACLPerms(in string) (aclPerms, error) {
+	var perms aclPerms
+	for _, c := range in {
+		idx := strings.IndexRune(aclPermChars, c)
+		if idx < 0 {
+			return 0, errors.Errorf("invalid permission %q", c)
+		}
+		perms |= 1 << idx
+	}
+
+	return perms, nil
+}
+
+func (p aclPerms) has(c byte) bool {
+	return p&(1<<strings.IndexByte(aclPermChars, c)) != 0
+}
+
+func (p aclPerms) String() string {
+	var b strings.Builder
+	for i := 0; i < len(aclPermChars); i++ {
+		if p&(1<<i) != 0 {
+			b.WriteByte(aclPermChars[i])
+		}
+	}
+	return b.String()
+}
+
+// forResource validates the permissions against the resource type and
+// expands aliases. For pools, read is an alias for get-prop and write is an
+// alias for create and delete.
+func (p aclPerms) forResource(resType ACLResourceType) (aclPerms, error) {
+	if resType != ACLResourcePool {
+		return p, nil
+	}
+
+	allowed, _ := parseACLPerms(aclPoolPermChars)
+	if invalid := p &^ allowed; invalid != 0 {
+		return 0, errors.Errorf("permission(s) %q do not apply to pools", invalid.String())
+	}
+
+	expanded := p
+	if p.has('r') {
+		expanded &^= 1 << strings.IndexByte(aclPermChars, 'r')
+		expanded |= 1 << strings.IndexByte(aclPermChars, 't')
+	}
+	if p.has('w') {
+		expanded &^= 1 << strings.IndexByte(aclPermChars, 'w')
+		expanded |= 1<<strings.IndexByte(aclPermChars, 'c') | 1<<strings.IndexByte(aclPermChars, 'd')
+	}
+
+	return expanded, nil
+}
+
+// ownerMinPerms returns the permissions that the owner of a resource of the
+// given type is granted in addition to those of the matching ACL entries.
+func ownerMinPerms(resType ACLResourceType) aclPerms {
+	if resType != ACLResourceContainer {
+		return 0
+	}
+
+	perms, _ := parseACLPerms(aclContOwnerPermChars)
+	return perms
+}
+
+// aclEntry is a parsed ACE.
+type aclEntry struct {
+	ace       string
+	group     bool
+	principal string
+	perms     aclPerms
+}
+
+func parseACLEntry(ace string, resType ACLResourceType) (*aclEntry, error) {
+	fields := strings.Split(ace, ":")
+	if len(fields) != 4 {
+		return nil, errors.Errorf("invalid ACE %q", ace)
+	}
+
+	if fields[0] != "A" {
+		return nil, errors.Errorf("invalid ACE %q: unsupported type %q", ace, fields[0])
+	}
+
+	entry := &aclEntry{ace: ace, principal: fields[2]}
+	switch fields[1] {
+	case "":
+	case "G":
+		entry.group = true
+	default:
+		return nil, errors.Errorf("invalid ACE %q: unsupported flags %q", ace, fields[1])
+	}
+
+	switch {
+	case entry.principal == "":
+		return nil, errors.Errorf("invalid ACE %q: missing principal", ace)
+	case entry.principal == aclOwnerGroupPrincipal && !entry.group:
+		return nil, errors.Errorf("invalid ACE %q: %s requires the group flag", ace, aclOwnerGroupPrincipal)
+	}
+
+	perms, err := parseACLPerms(fields[3])
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid ACE %q", ace)
+	}
+	if entry.perms, err = perms.forResource(resType); err != nil {
+		return nil, errors.Wrapf(err, "invalid ACE %q", ace)
+	}
+
+	return entry, nil
+}
+
+// aclPrincipal converts a bare user or group name into a principal.
+func aclPrincipal(name string) string {
+	if name != "" && !strings.ContainsRune(name, '@') {
+		return name + "@"
+	}
+	return name
+}
+
+// ACLAccess describes the result of evaluating an ACL for a user.
+type ACLAccess struct {
+	User       string   `json:"user"`
+	Groups     []string `json:"groups"`
+	Match      string   `json:"match"`                 // type of the entries that determined the permissions
+	Entries    []string `json:"entries"`               // entries that determined the permissions
+	Perms      string   `json:"perms"`                 // effective permissions
+	OwnerPerms string   `json:"owner_perms,omitempty"` // permissions granted implicitly to the owner
+	Requested  string   `json:"requested,omitempty"`
+	Missing    string   `json:"missing,omitempty"` // requested permissions that are not granted
+	Allowed    bool     `json:"allowed"`
+}
+
+// CheckACLAccess evaluates the ACL for a user who is a member of the supplied
+// groups, using the same precedence rules as the engine: the owner entry, then
+// the named user entries, then the union of the owner-group and named group
+// entries and finally the everyone entry. As in the engine, the owner of a
+// container is always granted the get-acl and set-acl permissions. If
+// requested permissions are supplied, the result indicates whether all of
+// them are granted.
+func CheckACLAccess(acl *AccessControlList, resType ACLResourceType, user string, groups []string, requested string) (*ACLAccess, error) {
+	if acl == nil {
+		return nil, errors.New("nil ACL")
+	}
+	if user == "" {
+		return nil, errors.New("no user specified")
+	}
+
+	var reqPerms aclPerms
+	if requested != "" {
+		perms, err := parseACLPerms(requested)
+		if err != nil {
+			return nil, errors.Wrap(err, "requested permissions")
+		}
+		if reqPerms, err = perms.forResource(resType); err != nil {
+			return nil, errors.Wrap(err, "requested permissions")
+		}
+	}
+
+	access := &ACLAccess{
+		User:  aclPrincipal(user),
+		Match: ACLMatchNone,
+	}
+	inGroup := make(map[string]bool)
+	for _, g := range groups {
+		g = aclPrincipal(g)
+		access.Groups = append(access.Groups, g)
+		inGroup[g] = true
+	}
+	isOwner := acl.Owner != "" && aclPrincipal(acl.Owner) == access.User
+	inOwnerGroup := acl.OwnerGroup != "" && inGroup[aclPrincipal(acl.OwnerGroup)]
+
+	var owner, named, everyone *aclEntry
+	var groupEntries []*aclEntry
+	for _, ace := range acl.Entries {
+		entry, err := parseACLEntry(ace, resType)
+		if err != nil {
+			return nil, err
+		}
+
+		switch {
+		case entry.principal == aclOwnerPrincipal:
+			if isOwner {
+				owner = entry
+			}
+		case entry.principal == aclEveryonePrincipal:
+			everyone = entry
+		case entry.principal == aclOwnerGroupPrincipal:
+			if inOwnerGroup {
+				groupEntries = append(groupEntries, entry)
+			}
+		case entry.group:
+			if inGroup[entry.principal] {
+				groupEntries = append(groupEntries, entry)
+			}
+		case entry.principal == access.User:
+			named = entry
+		}
+	}
+
+	var matched []*aclEntry
+	switch {
+	case owner != nil:
+		access.Match = ACLMatchOwner
+		matched = []*aclEntry{owner}
+	case named != nil:
+		access.Match = ACLMatchUser
+		matched = []*aclEntry{named}
+	case len(groupEntries) > 0:
+		access.Match = ACLMatchGroup
+		matched = groupEntries
+	case everyone != nil:
+		access.Match = ACLMatchEveryone
+		matched = []*aclEntry{everyone}
+	}
+
+	var perms aclPerms
+	for _, entry := range matched {
+		access.Entries = append(access.Entries, entry.ace)
+		perms |= entry.perms
+	}
+	if isOwner {
+		if implicit := ownerMinPerms(resType) &^ perms; implicit != 0 {
+			access.OwnerPerms = implicit.String()
+			perms |= implicit
+		}
+	}
+	access.Perms = perms.String()
+
+	if requested != "" {
+		access.Requested = reqPerms.String()
+		access.Missing = (reqPerms &^ perms).String()
+	}
+	access.Allowed = access.Missing == "" && (requested != "" || perms != 0)
+
+	return access, nil
+}
+
+var aclMatchDescriptions = map[string]string{
+	ACLMatchOwner:    "owner entry",
+	ACLMatchUser:     "named user entry",
+	ACLMatchGroup:    "owner-group and named group entries",
+	ACLMatchEveryone: "everyone entry",
+	ACLMatchNone:     "no entries (access denied)",
+}
+
+// FormatACLAccess converts the result of an ACL evaluation to a human-readable
+// string.
+func FormatACLAccess(access *ACLAccess) string {
+	if access == nil {
+		return "nil"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "User:        %s\n", access.User)
+	if len(access.Groups) > 0 {
+		fmt.Fprintf(&b, "Groups:      %s\n", strings.Join(access.Groups, ", "))
+	}
+	fmt.Fprintf(&b, "Matched:     %s\n", aclMatchDescriptions[access.Match])
+	for _, ace := range access.Entries {
+		fmt.Fprintf(&b, "  %s\n", ace)
+	}
+	if access.OwnerPerms != "" {
+		fmt.Fprintf(&b, "Owner perms: %s\n", getVerbosePermissions(access.OwnerPerms))
+	}
+	fmt.Fprintf(&b, "Permissions: %s\n", getVerbosePermissions(access.Perms))
+
+	if access.Requested == "" {
+		return b.String()
+	}
+
+	fmt.Fprintf(&b, "Requested:   %s\n", getVerbosePermissions(access.Requested))
+	result := "allowed"
+	if !access.Allowed {
+		result = fmt.Sprintf("denied (missing %s)", getVerbosePermissions(access.Missing))
+	}
+	fmt.Fprintf(&b, "Result:      %s\n", result)
+
+	return b.String()
+}
@@ -0,0 +1,324 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestControl_CheckACLAccess(t *testing.T) {
+	testACL := &AccessControlList{
+		Owner:      "owner@",
+		OwnerGroup: "ogroup@",
+		Entries: []string{
+			"A::OWNER@:rwdtTaAo",
+			"A::bob@:r",
+			"A:G:GROUP@:rw",
+			"A:G:proj@:tT",
+			"A:G:readers@:r",
+			"A::EVERYONE@:t",
+			"A::mallory@:",
+		},
+	}
+
+	for name, tc := range map[string]struct {
+		acl       *AccessControlList
+		resType   ACLResourceType
+		user      string
+		groups    []string
+		requested string
+		expAccess *ACLAccess
+		expErr    error
+	}{
+		"nil ACL": {
+			user:   "bob",
+			expErr: errors.New("nil ACL"),
+		},
+		"no user": {
+			acl:    testACL,
+			expErr: errors.New("no user"),
+		},
+		"owner": {
+			acl:     testACL,
+			resType: ACLResourceContainer,
+			user:    "owner",
+			groups:  []string{"proj"},
+			expAccess: &ACLAccess{
+				User:    "owner@",
+				Groups:  []string{"proj@"},
+				Match:   ACLMatchOwner,
+				Entries: []string{"A::OWNER@:rwdtTaAo"},
+				Perms:   "rwdtTaAo",
+				Allowed: true,
+			},
+		},
+		"container owner granted ACL permissions": {
+			acl: &AccessControlList{
+				Owner:   "owner@",
+				Entries: []string{"A::OWNER@:r"},
+			},
+			resType:   ACLResourceContainer,
+			user:      "owner",
+			requested: "rA",
+			expAccess: &ACLAccess{
+				User:       "owner@",
+				Match:      ACLMatchOwner,
+				Entries:    []string{"A::OWNER@:r"},
+				Perms:      "raA",
+				OwnerPerms: "aA",
+				Requested:  "rA",
+				Allowed:    true,
+			},
+		},
+		"container owner without matching entry": {
+			acl: &AccessControlList{
+				Owner:   "owner@",
+				Entries: []string{"A::bob@:rw"},
+			},
+			resType:   ACLResourceContainer,
+			user:      "owner",
+			requested: "r",
+			expAccess: &ACLAccess{
+				User:       "owner@",
+				Match:      ACLMatchNone,
+				Perms:      "aA",
+				OwnerPerms: "aA",
+				Requested:  "r",
+				Missing:    "r",
+			},
+		},
+		"pool owner has no implicit permissions": {
+			acl: &AccessControlList{
+				Owner:   "owner@",
+				Entries: []string{"A::OWNER@:r"},
+			},
+			resType: ACLResourcePool,
+			user:    "owner",
+			expAccess: &ACLAccess{
+				User:    "owner@",
+				Match:   ACLMatchOwner,
+				Entries: []string{"A::OWNER@:r"},
+				Perms:   "t",
+				Allowed: true,
+			},
+		},
+		"named user takes precedence over groups": {
+			acl:       testACL,
+			resType:   ACLResourceContainer,
+			user:      "bob@",
+			groups:    []string{"ogroup", "proj"},
+			requested: "rw",
+			expAccess: &ACLAccess{
+				User:      "bob@",
+				Groups:    []string{"ogroup@", "proj@"},
+				Match:     ACLMatchUser,
+				Entries:   []string{"A::bob@:r"},
+				Perms:     "r",
+				Requested: "rw",
+				Missing:   "w",
+			},
+		},
+		"union of groups": {
+			acl:       testACL,
+			resType:   ACLResourceContainer,
+			user:      "alice",
+			groups:    []string{"ogroup", "proj", "other"},
+			requested: "wT",
+			expAccess: &ACLAccess{
+				User:      "alice@",
+				Groups:    []string{"ogroup@", "proj@", "other@"},
+				Match:     ACLMatchGroup,
+				Entries:   []string{"A:G:GROUP@:rw", "A:G:proj@:tT"},
+				Perms:     "rwtT",
+				Requested: "wT",
+				Allowed:   true,
+			},
+		},
+		"everyone": {
+			acl:     testACL,
+			resType: ACLResourceContainer,
+			user:    "carol",
+			expAccess: &ACLAccess{
+				User:    "carol@",
+				Match:   ACLMatchEveryone,
+				Entries: []string{"A::EVERYONE@:t"},
+				Perms:   "t",
+				Allowed: true,
+			},
+		},
+		"user denied by empty entry": {
+			acl:       testACL,
+			resType:   ACLResourceContainer,
+			user:      "mallory",
+			groups:    []string{"readers"},
+			requested: "r",
+			expAccess: &ACLAccess{
+				User:      "mallory@",
+				Groups:    []string{"readers@"},
+				Match:     ACLMatchUser,
+				Entries:   []string{"A::mallory@:"},
+				Requested: "r",
+				Missing:   "r",
+			},
+		},
+		"no match": {
+			acl: &AccessControlList{
+				Entries: []string{"A::bob@:rw"},
+			},
+			resType: ACLResourceContainer,
+			user:    "carol",
+			expAccess: &ACLAccess{
+				User:  "carol@",
+				Match: ACLMatchNone,
+			},
+		},
+		"pool aliases expanded": {
+			acl: &AccessControlList{
+				Entries: []string{"A:G:proj@:rw"},
+			},
+			resType:   ACLResourcePool,
+			user:      "alice",
+			groups:    []string{"proj"},
+			requested: "rc",
+			expAccess: &ACLAccess{
+				User:      "alice@",
+				Groups:    []string{"proj@"},
+				Match:     ACLMatchGroup,
+				Entries:   []string{"A:G:proj@:rw"},
+				Perms:     "cdt",
+				Requested: "ct",
+				Allowed:   true,
+			},
+		},
+		"container permission requested on pool": {
+			acl:       &AccessControlList{},
+			resType:   ACLResourcePool,
+			user:      "alice",
+			requested: "rA",
+			expErr:    errors.New("\"A\" do not apply to pools"),
+		},
+		"invalid requested permission": {
+			acl:       &AccessControlList{},
+			resType:   ACLResourceContainer,
+			user:      "alice",
+			requested: "rx",
+			expErr:    errors.New("invalid permission 'x'"),
+		},
+		"invalid entry": {
+			acl: &AccessControlList{
+				Entries: []string{"A::bob@"},
+			},
+			user:   "bob",
+			expErr: errors.New("invalid ACE \"A::bob@\""),
+		},
+		"owner group entry without group flag": {
+			acl: &AccessControlList{
+				Entries: []string{"A::GROUP@:r"},
+			},
+			user:   "bob",
+			expErr: errors.New("requires the group flag"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotAccess, gotErr := CheckACLAccess(tc.acl, tc.resType, tc.user, tc.groups, tc.requested)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expAccess, gotAccess); diff != "" {
+				t.Fatalf("unexpected access (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_FormatACLAccess(t *testing.T) {
+	for name, tc := range map[string]struct {
+		access *ACLAccess
+		expStr string
+	}{
+		"nil": {
+			expStr: "nil",
+		},
+		"no requested permissions": {
+			access: &ACLAccess{
+				User:    "carol@",
+				Match:   ACLMatchEveryone,
+				Entries: []string{"A::EVERYONE@:t"},
+				Perms:   "t",
+				Allowed: true,
+			},
+			expStr: `User:        carol@
+Matched:     everyone entry
+  A::EVERYONE@:t
+Permissions: Get-Prop
+`,
+		},
+		"denied": {
+			access: &ACLAccess{
+				User:      "alice@",
+				Groups:    []string{"ogroup@", "proj@"},
+				Match:     ACLMatchGroup,
+				Entries:   []string{"A:G:GROUP@:r", "A:G:proj@:t"},
+				Perms:     "rt",
+				Requested: "rw",
+				Missing:   "w",
+			},
+			expStr: `User:        alice@
+Groups:      ogroup@, proj@
+Matched:     owner-group and named group entries
+  A:G:GROUP@:r
+  A:G:proj@:t
+Permissions: Read/Get-Prop
+Requested:   Read/Write
+Result:      denied (missing Write)
+`,
+		},
+		"owner permissions": {
+			access: &ACLAccess{
+				User:       "owner@",
+				Match:      ACLMatchOwner,
+				Entries:    []string{"A::OWNER@:r"},
+				Perms:      "raA",
+				OwnerPerms: "aA",
+				Allowed:    true,
+			},
+			expStr: `User:        owner@
+Matched:     owner entry
+  A::OWNER@:r
+Owner perms: Get-ACL/Set-ACL
+Permissions: Read/Get-ACL/Set-ACL
+`,
+		},
+		"no match": {
+			access: &ACLAccess{
+				User:      "carol@",
+				Match:     ACLMatchNone,
+				Requested: "r",
+				Missing:   "r",
+			},
+			expStr: `User:        carol@
+Matched:     no entries (access denied)
+Permissions: None
+Requested:   Read
+Result:      denied (missing Read)
+`,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.expStr, FormatACLAccess(tc.access)); diff != "" {
+				t.Fatalf("unexpected output (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
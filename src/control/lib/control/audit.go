@@ -0,0 +1,98 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/daos-stack/daos/src/control/common"
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// SystemAuditListReq contains the inputs for the system audit list
+	// request. Unset fields match all recorded requests.
+	SystemAuditListReq struct {
+		unaryRequest
+		msRequest
+		Method     string
+		Caller     string
+		Peer       string
+		Since      time.Time
+		Until      time.Time
+		FailedOnly bool
+		Limit      uint32
+	}
+
+	// SystemAuditListResp contains the results of a system audit list request.
+	SystemAuditListResp struct {
+		Records []*system.AuditRecord `json:"records"`
+	}
+)
+
+// SystemAuditList retrieves summaries of mutating control-plane requests
+// recorded in the system database by the MS leader.
+func SystemAuditList(ctx context.Context, rpcClient UnaryInvoker, req *SystemAuditListReq) (*SystemAuditListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if !req.Since.IsZero() && !req.Until.IsZero() && req.Until.Before(req.Since) {
+		return nil, errors.New("until time must not be before since time")
+	}
+
+	pbReq := &mgmtpb.SystemAuditListReq{
+		Sys:        req.getSystem(rpcClient),
+		Method:     req.Method,
+		Caller:     req.Caller,
+		Peer:       req.Peer,
+		FailedOnly: req.FailedOnly,
+		Limit:      req.Limit,
+	}
+	if !req.Since.IsZero() {
+		pbReq.Since = common.FormatTime(req.Since)
+	}
+	if !req.Until.IsZero() {
+		pbReq.Until = common.FormatTime(req.Until)
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemAuditList(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system audit list request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+	pbResp, ok := msResp.(*mgmtpb.SystemAuditListResp)
+	if !ok {
+		return nil, errors.New("unable to extract SystemAuditListResp from MS response")
+	}
+
+	resp := &SystemAuditListResp{
+		Records: make([]*system.AuditRecord, 0, len(pbResp.GetRecords())),
+	}
+	if len(pbResp.GetRecords()) > 0 {
+		if err := convert.Types(pbResp.GetRecords(), &resp.Records); err != nil {
+			return nil, errors.Wrap(err, "convert audit records from proto")
+		}
+	}
+
+	return resp, nil
+}
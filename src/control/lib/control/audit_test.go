@@ -0,0 +1,126 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_SystemAuditList(t *testing.T) {
+	now := time.Now()
+	ts := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	for name, tc := range map[string]struct {
+		req     *SystemAuditListReq
+		mic     *MockInvokerConfig
+		expResp *SystemAuditListResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"bad time window": {
+			req: &SystemAuditListReq{
+				Since: now,
+				Until: now.Add(-time.Hour),
+			},
+			expErr: errors.New("before since"),
+		},
+		"req fails": {
+			req: &SystemAuditListReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"no records": {
+			req: &SystemAuditListReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemAuditListResp{}),
+				},
+			},
+			expResp: &SystemAuditListResp{
+				Records: []*system.AuditRecord{},
+			},
+		},
+		"success": {
+			req: &SystemAuditListReq{
+				Method:     "PoolDestroy",
+				Caller:     "admin",
+				Peer:       "10.0.0.1",
+				Since:      now.Add(-time.Hour),
+				Until:      now,
+				FailedOnly: true,
+				Limit:      10,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemAuditListResp{
+						Records: []*mgmtpb.AuditRecord{
+							{
+								Sequence:  42,
+								Timestamp: ts.Format(time.RFC3339Nano),
+								Host:      "host1",
+								Method:    "/mgmt.MgmtSvc/PoolDestroy",
+								Caller:    "admin",
+								Peer:      "10.0.0.1:40000",
+								Request:   `{"id":"pool1"}`,
+								Status:    "Unknown",
+								Error:     "pool busy",
+								Duration:  uint64(time.Second),
+							},
+						},
+					}),
+				},
+			},
+			expResp: &SystemAuditListResp{
+				Records: []*system.AuditRecord{
+					{
+						Sequence:  42,
+						Timestamp: ts,
+						Host:      "host1",
+						Method:    "/mgmt.MgmtSvc/PoolDestroy",
+						Caller:    "admin",
+						Peer:      "10.0.0.1:40000",
+						Request:   `{"id":"pool1"}`,
+						Status:    "Unknown",
+						Error:     "pool busy",
+						Duration:  time.Second,
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemAuditList(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -8,17 +8,27 @@ package control
 
 import (
 	"context"
+	"io"
 	"log"
 	"log/syslog"
+	"time"
 
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/proto"
 
+	"github.com/daos-stack/daos/src/control/common"
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
 	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/atm"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
 )
 
 // EventNotifyReq contains the inputs for an event notify request.
@@ -174,3 +184,227 @@ func newEventLogger(logBasic logging.Logger, newSyslogger newSysloggerFn) *Event
 func NewEventLogger(log logging.Logger) *EventLogger {
 	return newEventLogger(log, syslog.NewLogger)
 }
+
+type (
+	// SystemEventsListReq contains the inputs for the system events list
+	// request. Unset fields match all journaled events.
+	SystemEventsListReq struct {
+		unaryRequest
+		msRequest
+		IDs         []events.RASID
+		MinSeverity events.RASSeverityID
+		Type        events.RASTypeID
+		Ranks       *ranklist.RankSet
+		Hosts       *hostlist.HostSet
+		Since       time.Time
+		Until       time.Time
+		Limit       uint32
+	}
+
+	// SystemEventsListResp contains the results of a system events list request.
+	SystemEventsListResp struct {
+		Events []*events.RASEvent `json:"events"`
+	}
+)
+
+// SystemEventsList retrieves RAS events recorded in the MS event journal.
+func SystemEventsList(ctx context.Context, rpcClient UnaryInvoker, req *SystemEventsListReq) (*SystemEventsListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if !req.Since.IsZero() && !req.Until.IsZero() && req.Until.Before(req.Since) {
+		return nil, errors.New("until time must not be before since time")
+	}
+
+	pbReq := &mgmtpb.SystemEventsListReq{
+		Sys:         req.getSystem(rpcClient),
+		MinSeverity: req.MinSeverity.Uint32(),
+		Type:        req.Type.Uint32(),
+		Limit:       req.Limit,
+	}
+	for _, id := range req.IDs {
+		pbReq.Ids = append(pbReq.Ids, id.Uint32())
+	}
+	if req.Ranks != nil {
+		pbReq.Ranks = req.Ranks.String()
+	}
+	if req.Hosts != nil {
+		pbReq.Hosts = req.Hosts.String()
+	}
+	if !req.Since.IsZero() {
+		pbReq.Since = common.FormatTime(req.Since)
+	}
+	if !req.Until.IsZero() {
+		pbReq.Until = common.FormatTime(req.Until)
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemEventsList(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system events list request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, err
+	}
+	pbResp, ok := msResp.(*mgmtpb.SystemEventsListResp)
+	if !ok {
+		return nil, errors.New("unable to extract SystemEventsListResp from MS response")
+	}
+
+	resp := &SystemEventsListResp{
+		Events: make([]*events.RASEvent, 0, len(pbResp.GetEvents())),
+	}
+	for _, pbEvt := range pbResp.GetEvents() {
+		evt, err := events.NewFromProto(pbEvt)
+		if err != nil {
+			return nil, errors.Wrap(err, "convert event from proto")
+		}
+		resp.Events = append(resp.Events, evt)
+	}
+
+	return resp, nil
+}
+
+const (
+	// eventsWatchSessionTimeout bounds the lifetime of a single event stream
+	// when the caller has not set a deadline. The subscription is
+	// re-established transparently when a session expires.
+	eventsWatchSessionTimeout = 1 * time.Hour
+	// eventsWatchRetryInterval is the base interval to wait before
+	// re-establishing an interrupted event stream.
+	eventsWatchRetryInterval = 500 * time.Millisecond
+	// eventsWatchMaxBackoffFactor limits the exponential backoff applied
+	// between attempts to re-establish an event stream.
+	eventsWatchMaxBackoffFactor = 5
+)
+
+// errEventStreamEnded indicates that the MS closed an event stream.
+var errEventStreamEnded = errors.New("event stream closed by server")
+
+type (
+	// SystemEventsWatchReq contains the inputs for the system events watch
+	// request. Unset fields match all events.
+	SystemEventsWatchReq struct {
+		unaryRequest
+		msRequest
+		IDs         []events.RASID
+		MinSeverity events.RASSeverityID
+		Ranks       *ranklist.RankSet
+	}
+
+	// EventWatchFn is called for each event received on an event stream.
+	EventWatchFn func(*events.RASEvent)
+)
+
+// recvEvents reads events from the stream and passes them to the supplied
+// callback until the stream is closed or an error is encountered.
+func recvEvents(stream mgmtpb.MgmtSvc_SystemEventsWatchClient, target string, onEvent EventWatchFn) error {
+	for {
+		pbResp, err := stream.Recv()
+		if err == io.EOF {
+			return errEventStreamEnded
+		}
+		if err != nil {
+			return unwrapRPCError(err, target)
+		}
+
+		if pbResp.GetEvent() == nil {
+			return errors.New("event stream response missing event")
+		}
+		evt, err := events.NewFromProto(pbResp.GetEvent())
+		if err != nil {
+			return errors.Wrap(err, "convert event from proto")
+		}
+		onEvent(evt)
+	}
+}
+
+// isEventStreamInterrupted returns true if the error indicates that the
+// event stream was interrupted and should be re-established.
+func isEventStreamInterrupted(err error) bool {
+	cause := errors.Cause(err)
+	return cause == errEventStreamEnded ||
+		IsConnErr(err) || isTimeout(err) ||
+		system.IsNotLeader(err) || system.IsNotReplica(err) ||
+		system.IsUnavailable(err) ||
+		status.Code(cause) == codes.Unavailable
+}
+
+// SystemEventsWatch subscribes to RAS events received by the MS leader and
+// invokes the supplied callback for each matching event until the context is
+// canceled. If the stream is interrupted (e.g. by a change of MS leadership),
+// the subscription is re-established with the current leader. Events raised
+// while the subscription is being re-established are not delivered.
+func SystemEventsWatch(ctx context.Context, rpcClient UnaryInvoker, req *SystemEventsWatchReq, onEvent EventWatchFn) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if onEvent == nil {
+		return errors.New("nil event callback")
+	}
+
+	pbReq := &mgmtpb.SystemEventsWatchReq{
+		Sys:         req.getSystem(rpcClient),
+		MinSeverity: req.MinSeverity.Uint32(),
+	}
+	for _, id := range req.IDs {
+		pbReq.Ids = append(pbReq.Ids, id.Uint32())
+	}
+	if req.Ranks != nil {
+		pbReq.Ranks = req.Ranks.String()
+	}
+
+	var received atm.Bool
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		stream, err := mgmtpb.NewMgmtSvcClient(conn).SystemEventsWatch(ctx, pbReq)
+		if err != nil {
+			return nil, err
+		}
+		rpcClient.Debugf("subscribed to events on MS @ %s", conn.Target())
+
+		return &mgmtpb.SystemEventsWatchResp{}, recvEvents(stream, conn.Target(),
+			func(evt *events.RASEvent) {
+				received.SetTrue()
+				onEvent(evt)
+			})
+	})
+
+	// Start each attempt from the original hostlist in order to rediscover
+	// the MS leader after an interruption.
+	startHostList := make([]string, len(req.getHostList()))
+	copy(startHostList, req.getHostList())
+
+	rpcClient.Debugf("DAOS system events watch request: %s", pbUtil.Debug(pbReq))
+	for try := uint64(1); ; try++ {
+		req.SetHostList(startHostList)
+		req.SetTimeout(eventsWatchSessionTimeout)
+		received.SetFalse()
+
+		ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+		if err == nil {
+			_, err = ur.getMSResponse()
+		}
+		if ctx.Err() != nil {
+			return nil
+		}
+		if !isEventStreamInterrupted(err) {
+			return err
+		}
+
+		if received.IsTrue() {
+			try = 1
+		}
+		backoff := common.ExpBackoff(eventsWatchRetryInterval, try, eventsWatchMaxBackoffFactor)
+		rpcClient.Debugf("event stream interrupted (%v); resubscribing in %s", err, backoff)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(backoff):
+		}
+	}
+}
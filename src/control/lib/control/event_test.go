@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,20 +7,30 @@
 package control
 
 import (
+	"context"
 	"fmt"
+	"io"
 	"log"
 	"log/syslog"
 	"math"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
 	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
 	"github.com/daos-stack/daos/src/control/common/test"
 	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
 )
 
@@ -245,3 +255,228 @@ prio27 id: [engine_died] ts: [%s] host: [foo] type: [STATE_CHANGE] sev: [ERROR]
 		})
 	}
 }
+
+func TestControl_SystemEventsList(t *testing.T) {
+	evt := mockEvtEngineDied(t)
+	pbEvt, err := evt.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now()
+
+	for name, tc := range map[string]struct {
+		req     *SystemEventsListReq
+		mic     *MockInvokerConfig
+		expResp *SystemEventsListResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"bad time window": {
+			req: &SystemEventsListReq{
+				Since: now,
+				Until: now.Add(-time.Hour),
+			},
+			expErr: errors.New("before since"),
+		},
+		"req fails": {
+			req: &SystemEventsListReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("error"), nil),
+				},
+			},
+			expErr: errors.New("error"),
+		},
+		"no events": {
+			req: &SystemEventsListReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemEventsListResp{}),
+				},
+			},
+			expResp: &SystemEventsListResp{
+				Events: []*events.RASEvent{},
+			},
+		},
+		"success": {
+			req: &SystemEventsListReq{
+				IDs:         []events.RASID{events.RASEngineDied},
+				MinSeverity: events.RASSeverityWarning,
+				Ranks:       ranklist.MustCreateRankSet("0-1"),
+				Hosts:       hostlist.MustCreateSet("foo"),
+				Since:       now.Add(-time.Hour),
+				Until:       now,
+				Limit:       10,
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemEventsListResp{
+						Events: []*sharedpb.RASEvent{pbEvt},
+					}),
+				},
+			},
+			expResp: &SystemEventsListResp{
+				Events: []*events.RASEvent{evt},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemEventsList(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{cmpopts.IgnoreUnexported(events.RASEvent{})}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+type mockEventsWatchClient struct {
+	grpc.ClientStream
+	resps []*mgmtpb.SystemEventsWatchResp
+	err   error
+}
+
+func (c *mockEventsWatchClient) Recv() (*mgmtpb.SystemEventsWatchResp, error) {
+	if len(c.resps) == 0 {
+		return nil, c.err
+	}
+	resp := c.resps[0]
+	c.resps = c.resps[1:]
+	return resp, nil
+}
+
+func TestControl_recvEvents(t *testing.T) {
+	evt := mockEvtEngineDied(t)
+	pbEvt, err := evt.ToProto()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for name, tc := range map[string]struct {
+		stream  *mockEventsWatchClient
+		expEvts []*events.RASEvent
+		expErr  error
+	}{
+		"stream closed": {
+			stream: &mockEventsWatchClient{
+				resps: []*mgmtpb.SystemEventsWatchResp{{Event: pbEvt}, {Event: pbEvt}},
+				err:   io.EOF,
+			},
+			expEvts: []*events.RASEvent{evt, evt},
+			expErr:  errEventStreamEnded,
+		},
+		"connection closed": {
+			stream: &mockEventsWatchClient{
+				resps: []*mgmtpb.SystemEventsWatchResp{{Event: pbEvt}},
+				err:   status.Error(codes.Unavailable, "transport is closing"),
+			},
+			expEvts: []*events.RASEvent{evt},
+			expErr:  FaultConnectionClosed("host1"),
+		},
+		"missing event": {
+			stream: &mockEventsWatchClient{
+				resps: []*mgmtpb.SystemEventsWatchResp{{}},
+			},
+			expErr: errors.New("missing event"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotEvts []*events.RASEvent
+			gotErr := recvEvents(tc.stream, "host1", func(evt *events.RASEvent) {
+				gotEvts = append(gotEvts, evt)
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			cmpOpts := []cmp.Option{cmpopts.IgnoreUnexported(events.RASEvent{})}
+			if diff := cmp.Diff(tc.expEvts, gotEvts, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected events (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemEventsWatch(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req            *SystemEventsWatchReq
+		noCallback     bool
+		cancelCtx      bool
+		mic            *MockInvokerConfig
+		expInvokeCount int
+		expErr         error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"nil callback": {
+			req:        &SystemEventsWatchReq{},
+			noCallback: true,
+			expErr:     errors.New("nil event callback"),
+		},
+		"fatal error": {
+			req: &SystemEventsWatchReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("fatal"), nil),
+				},
+			},
+			expInvokeCount: 1,
+			expErr:         errors.New("fatal"),
+		},
+		"resubscribes after interruption": {
+			req: &SystemEventsWatchReq{
+				IDs:         []events.RASID{events.RASEngineDied},
+				MinSeverity: events.RASSeverityError,
+				Ranks:       ranklist.MustCreateRankSet("0-1"),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", FaultConnectionClosed("host1"), nil),
+					MockMSResponse("", errEventStreamEnded, nil),
+					MockMSResponse("", errors.New("fatal"), nil),
+				},
+			},
+			expInvokeCount: 3,
+			expErr:         errors.New("fatal"),
+		},
+		"context canceled": {
+			req:       &SystemEventsWatchReq{},
+			cancelCtx: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			defer cancel()
+			if tc.cancelCtx {
+				cancel()
+			}
+
+			var onEvent EventWatchFn
+			if !tc.noCallback {
+				onEvent = func(*events.RASEvent) {}
+			}
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := SystemEventsWatch(ctx, client, tc.req, onEvent)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if tc.expInvokeCount > 0 {
+				test.AssertEqual(t, tc.expInvokeCount, client.GetInvokeCount(),
+					"unexpected number of invocations")
+			}
+		})
+	}
+}
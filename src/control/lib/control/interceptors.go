@@ -44,17 +44,23 @@ func connErrToFault(st *status.Status, target string) error {
 	}
 }
 
+// unwrapRPCError converts an error returned by an RPC into either the
+// original error sent by the server or a connection fault.
+func unwrapRPCError(err error, target string) error {
+	st := status.Convert(err)
+	err = proto.UnwrapError(st)
+	if err.Error() != st.Err().Error() {
+		return err
+	}
+	return connErrToFault(st, target)
+}
+
 // streamErrorInterceptor calls the specified streaming RPC and returns any unwrapped errors.
 func streamErrorInterceptor() grpc.DialOption {
 	return grpc.WithStreamInterceptor(func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
 		cs, err := streamer(ctx, desc, cc, method, opts...)
 		if err != nil {
-			st := status.Convert(err)
-			err = proto.UnwrapError(st)
-			if err.Error() != st.Err().Error() {
-				return cs, err
-			}
-			return cs, connErrToFault(st, cc.Target())
+			return cs, unwrapRPCError(err, cc.Target())
 		}
 		return cs, nil
 	})
@@ -65,12 +71,7 @@ func unaryErrorInterceptor() grpc.UnaryClientInterceptor {
 	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
 		err := invoker(ctx, method, req, reply, cc, opts...)
 		if err != nil {
-			st := status.Convert(err)
-			err = proto.UnwrapError(st)
-			if err.Error() != st.Err().Error() {
-				return err
-			}
-			return connErrToFault(st, cc.Target())
+			return unwrapRPCError(err, cc.Target())
 		}
 		return nil
 	}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2018-2022 Intel Corporation.
+// (C) Copyright 2018-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -229,6 +229,7 @@ type (
 		ServiceRanks  []*PrimaryServiceRank `json:"rank_uris"`
 		MSRanks       []uint32              `json:"ms_ranks"`
 		ClientNetHint ClientNetworkHint     `json:"client_net_hint"`
+		MSReplicas    []string              `json:"ms_replicas"`
 	}
 )
 
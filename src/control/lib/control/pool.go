@@ -142,10 +142,12 @@ func (pcr *PoolCreateReq) MarshalJSON() ([]byte, error) {
 	return json.Marshal(struct {
 		Properties []*mgmtpb.PoolProperty `json:"properties"`
 		ACL        []string               `json:"acl"`
+		Tags       []string               `json:"tags"`
 		*toJSON
 	}{
 		Properties: props,
 		ACL:        acl,
+		Tags:       pcr.Tags.Strings(),
 		toJSON:     (*toJSON)(pcr),
 	})
 }
@@ -217,6 +219,10 @@ type (
 		Ranks     []ranklist.Rank
 		TierBytes []uint64
 		MetaBytes uint64 `json:"meta_blob_size"`
+		// operator-defined tags recorded by the MS
+		Tags system.PoolTags `json:"-"`
+		// name of the pool profile providing defaults, checked by the MS
+		Profile string `json:"profile,omitempty"`
 	}
 
 	// PoolCreateResp contains the response from a pool create request.
@@ -348,6 +354,153 @@ func PoolCreate(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq)
 	return pcr, nil
 }
 
+type (
+	// PoolCreateRankPlan describes the expected impact of a pool create
+	// request on the storage of a single rank.
+	PoolCreateRankPlan struct {
+		Rank          ranklist.Rank `json:"rank"`
+		TierFree      []uint64      `json:"tier_free"`
+		TierFreeAfter []uint64      `json:"tier_free_after"`
+		Fits          bool          `json:"fits"`
+	}
+
+	// PoolCreatePlanResp contains the resolved placement of a pool create
+	// request that has not been executed.
+	PoolCreatePlanResp struct {
+		TgtRanks     []uint32              `json:"tgt_ranks"`
+		TgtsPerRank  uint32                `json:"tgts_per_rank"`
+		TierBytes    []uint64              `json:"tier_bytes"`
+		TgtTierBytes []uint64              `json:"tgt_tier_bytes"`
+		TierRatio    []float64             `json:"tier_ratio"`
+		MetaBytes    uint64                `json:"meta_blob_size"`
+		FaultDomains []string              `json:"fault_domains"`
+		Ranks        []*PoolCreateRankPlan `json:"ranks"`
+	}
+)
+
+// getRankFreeSpace returns the usable SCM and NVMe bytes currently available
+// on each of the given ranks.
+func getRankFreeSpace(ctx context.Context, rpcClient UnaryInvoker, ranks ranklist.RankList) (map[ranklist.Rank][]uint64, error) {
+	resp, err := StorageScan(ctx, rpcClient, &StorageScanReq{Usage: true})
+	if err != nil {
+		return nil, err
+	}
+
+	// The free space of ranks on hosts that failed to respond is unknown,
+	// so no plan can be made for them.
+	if len(resp.HostErrors) > 0 {
+		var hostErrs []string
+		for _, errStr := range resp.HostErrors.Keys() {
+			hostErrs = append(hostErrs, fmt.Sprintf("%s: %s",
+				resp.HostErrors[errStr].HostSet, errStr))
+		}
+		return nil, errors.Errorf("storage scan failed on some hosts: %s",
+			strings.Join(hostErrs, "; "))
+	}
+
+	filterRank := newFilterRankFunc(ranks)
+	rankSCMFreeSpace := make(rankFreeSpaceMap)
+	rankNVMeFreeSpace := make(rankFreeSpaceMap)
+	for _, key := range resp.HostStorage.Keys() {
+		hostStorage := resp.HostStorage[key].HostStorage
+
+		for _, scmNamespace := range hostStorage.ScmNamespaces {
+			if scmNamespace.Mount == nil || !filterRank(scmNamespace.Mount.Rank) {
+				continue
+			}
+			rankSCMFreeSpace[scmNamespace.Mount.Rank] = scmNamespace.Mount.UsableBytes
+			rankNVMeFreeSpace[scmNamespace.Mount.Rank] = 0
+		}
+
+		if err := processNVMeSpaceStats(rpcClient, filterRank, hostStorage.NvmeDevices, rankNVMeFreeSpace); err != nil {
+			return nil, err
+		}
+	}
+
+	free := make(map[ranklist.Rank][]uint64)
+	for rank, scmBytes := range rankSCMFreeSpace {
+		free[rank] = []uint64{scmBytes, rankNVMeFreeSpace[rank]}
+	}
+
+	return free, nil
+}
+
+// PoolCreatePlan resolves how a pool create request would be placed across
+// the system, including the expected free space on each target rank after the
+// pool has been created, without creating the pool.
+func PoolCreatePlan(ctx context.Context, rpcClient UnaryInvoker, req *PoolCreateReq) (*PoolCreatePlanResp, error) {
+	pbReq, err := poolCreateGenPBReq(ctx, rpcClient, req)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate PoolCreate request")
+	}
+	pbReq.Sys = req.getSystem(rpcClient)
+
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolCreatePlan(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Plan DAOS pool create request: %+v\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolCreatePlanResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "pool create plan failed")
+	}
+
+	var totalBytes uint64
+	for _, tierBytes := range resp.TierBytes {
+		totalBytes += tierBytes
+	}
+	resp.TierRatio = make([]float64, len(resp.TierBytes))
+	resp.TgtTierBytes = make([]uint64, len(resp.TierBytes))
+	for tierIdx, tierBytes := range resp.TierBytes {
+		if totalBytes != 0 {
+			resp.TierRatio[tierIdx] = float64(tierBytes) / float64(totalBytes)
+		}
+		if resp.TgtsPerRank != 0 {
+			resp.TgtTierBytes[tierIdx] = tierBytes / uint64(resp.TgtsPerRank)
+		}
+	}
+
+	tgtRanks := ranklist.RanksFromUint32(resp.TgtRanks)
+	free, err := getRankFreeSpace(ctx, rpcClient, tgtRanks)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to determine free space on target ranks")
+	}
+
+	for _, rank := range tgtRanks {
+		rp := &PoolCreateRankPlan{
+			Rank:     rank,
+			TierFree: free[rank],
+		}
+		if rp.TierFree == nil {
+			rpcClient.Debugf("no storage usage found for rank %d", rank)
+			resp.Ranks = append(resp.Ranks, rp)
+			continue
+		}
+
+		rp.Fits = true
+		rp.TierFreeAfter = make([]uint64, len(rp.TierFree))
+		for tierIdx, tierFree := range rp.TierFree {
+			if tierIdx >= len(resp.TierBytes) {
+				rp.TierFreeAfter[tierIdx] = tierFree
+				continue
+			}
+			if resp.TierBytes[tierIdx] > tierFree {
+				rp.Fits = false
+				continue
+			}
+			rp.TierFreeAfter[tierIdx] = tierFree - resp.TierBytes[tierIdx]
+		}
+		resp.Ranks = append(resp.Ranks, rp)
+	}
+
+	return resp, nil
+}
+
 // PoolDestroyReq contains the parameters for a pool destroy request.
 type PoolDestroyReq struct {
 	poolRequest
@@ -463,10 +616,11 @@ type (
 
 	// PoolRebuildStatus contains detailed information about the pool rebuild process.
 	PoolRebuildStatus struct {
-		Status  int32            `json:"status"`
-		State   PoolRebuildState `json:"state"`
-		Objects uint64           `json:"objects"`
-		Records uint64           `json:"records"`
+		Status       int32            `json:"status"`
+		State        PoolRebuildState `json:"state"`
+		Objects      uint64           `json:"objects"`
+		Records      uint64           `json:"records"`
+		TotalObjects uint64           `json:"total_objects"`
 	}
 
 	// PoolInfo contains information about the pool.
@@ -1142,6 +1296,9 @@ type (
 
 		// PoolRebuildStatus contains detailed information about the pool rebuild process.
 		RebuildState string `json:"rebuild_state"`
+
+		// Tags are the operator-defined key/value pairs set on the pool.
+		Tags system.PoolTags `json:"tags,omitempty"`
 	}
 )
 
@@ -1186,6 +1343,8 @@ type ListPoolsReq struct {
 	unaryRequest
 	msRequest
 	NoQuery bool
+	// Tags restricts the list to pools with all of the given tags set.
+	Tags system.PoolTags
 }
 
 // ListPoolsResp contains the status of the request and, if successful, the list
@@ -1259,6 +1418,16 @@ func ListPools(ctx context.Context, rpcClient UnaryInvoker, req *ListPoolsReq) (
 		return nil, err
 	}
 
+	if len(req.Tags) > 0 {
+		filtered := make([]*Pool, 0, len(resp.Pools))
+		for _, p := range resp.Pools {
+			if p.Tags.Matches(req.Tags) {
+				filtered = append(filtered, p)
+			}
+		}
+		resp.Pools = filtered
+	}
+
 	if req.NoQuery {
 		return resp, nil
 	}
@@ -0,0 +1,162 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// PoolProfileCreateReq contains the parameters for a pool profile
+	// create request.
+	PoolProfileCreateReq struct {
+		unaryRequest
+		msRequest
+		Profile *system.PoolProfile
+		Replace bool
+	}
+
+	// PoolProfileListReq contains the parameters for a pool profile list
+	// request. If no names are supplied, all profiles are returned.
+	PoolProfileListReq struct {
+		unaryRequest
+		msRequest
+		Names []string
+	}
+
+	// PoolProfileListResp contains the results of a pool profile list request.
+	PoolProfileListResp struct {
+		Profiles []*system.PoolProfile `json:"profiles"`
+	}
+
+	// PoolProfileDeleteReq contains the parameters for a pool profile
+	// delete request.
+	PoolProfileDeleteReq struct {
+		unaryRequest
+		msRequest
+		Name string
+	}
+)
+
+// PoolProfileCreate stores a named pool profile in the system database.
+func PoolProfileCreate(ctx context.Context, rpcClient UnaryInvoker, req *PoolProfileCreateReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if err := req.Profile.Validate(); err != nil {
+		return err
+	}
+
+	pbReq := &mgmtpb.PoolProfileCreateReq{
+		Sys:     req.getSystem(rpcClient),
+		Profile: new(mgmtpb.PoolProfile),
+		Replace: req.Replace,
+	}
+	if err := convert.Types(req.Profile, pbReq.Profile); err != nil {
+		return errors.Wrap(err, "convert pool profile to proto")
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolProfileCreate(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Create DAOS pool profile request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(ur.getMSError(), "pool profile create failed")
+}
+
+// PoolProfileList retrieves named pool profiles from the system database.
+func PoolProfileList(ctx context.Context, rpcClient UnaryInvoker, req *PoolProfileListReq) (*PoolProfileListResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.PoolProfileListReq{
+		Sys:   req.getSystem(rpcClient),
+		Names: req.Names,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolProfileList(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("List DAOS pool profiles request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	msResp, err := ur.getMSResponse()
+	if err != nil {
+		return nil, errors.Wrap(err, "pool profile list failed")
+	}
+	pbResp, ok := msResp.(*mgmtpb.PoolProfileListResp)
+	if !ok {
+		return nil, errors.New("unable to extract PoolProfileListResp from MS response")
+	}
+
+	resp := &PoolProfileListResp{
+		Profiles: make([]*system.PoolProfile, 0, len(pbResp.GetProfiles())),
+	}
+	if len(pbResp.GetProfiles()) > 0 {
+		if err := convert.Types(pbResp.GetProfiles(), &resp.Profiles); err != nil {
+			return nil, errors.Wrap(err, "convert pool profiles from proto")
+		}
+	}
+
+	return resp, nil
+}
+
+// GetPoolProfile retrieves a single named pool profile from the system database.
+func GetPoolProfile(ctx context.Context, rpcClient UnaryInvoker, name string) (*system.PoolProfile, error) {
+	resp, err := PoolProfileList(ctx, rpcClient, &PoolProfileListReq{Names: []string{name}})
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Profiles) != 1 {
+		return nil, errors.Errorf("unexpected number of pool profiles returned (%d)", len(resp.Profiles))
+	}
+
+	return resp.Profiles[0], nil
+}
+
+// PoolProfileDelete removes a named pool profile from the system database.
+func PoolProfileDelete(ctx context.Context, rpcClient UnaryInvoker, req *PoolProfileDeleteReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if req.Name == "" {
+		return errors.New("no pool profile name specified")
+	}
+
+	pbReq := &mgmtpb.PoolProfileDeleteReq{
+		Sys:  req.getSystem(rpcClient),
+		Name: req.Name,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolProfileDelete(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Delete DAOS pool profile request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	return errors.Wrap(ur.getMSError(), "pool profile delete failed")
+}
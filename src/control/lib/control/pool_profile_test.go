@@ -0,0 +1,187 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_PoolProfileCreate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *PoolProfileCreateReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"nil profile": {
+			req:    &PoolProfileCreateReq{},
+			expErr: errors.New("nil pool profile"),
+		},
+		"invalid profile": {
+			req: &PoolProfileCreateReq{
+				Profile: &system.PoolProfile{
+					Name:      "scratch",
+					SizeRatio: 120,
+				},
+			},
+			expErr: errors.New("invalid pool profile size ratio"),
+		},
+		"req fails": {
+			req: &PoolProfileCreateReq{
+				Profile: &system.PoolProfile{Name: "scratch"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", errors.New("already exists"), nil),
+			},
+			expErr: errors.New("already exists"),
+		},
+		"success": {
+			req: &PoolProfileCreateReq{
+				Profile: &system.PoolProfile{
+					Name:       "scratch",
+					Properties: map[string]string{"reclaim": "lazy"},
+					TotalBytes: 1 << 40,
+					TierRatio:  []float64{0.1, 0.9},
+				},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.PoolProfileCreateResp{}),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := PoolProfileCreate(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_PoolProfileList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *PoolProfileListReq
+		mic     *MockInvokerConfig
+		expResp *PoolProfileListResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &PoolProfileListReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"no profiles": {
+			req: &PoolProfileListReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.PoolProfileListResp{}),
+			},
+			expResp: &PoolProfileListResp{
+				Profiles: []*system.PoolProfile{},
+			},
+		},
+		"success": {
+			req: &PoolProfileListReq{Names: []string{"scratch"}},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.PoolProfileListResp{
+					Profiles: []*mgmtpb.PoolProfile{
+						{
+							Name:       "scratch",
+							Properties: map[string]string{"reclaim": "lazy"},
+							Acl:        []string{"A::OWNER@:rw"},
+							SizeRatio:  50,
+							NumSvcReps: 3,
+							Ranks:      "0-3",
+						},
+					},
+				}),
+			},
+			expResp: &PoolProfileListResp{
+				Profiles: []*system.PoolProfile{
+					{
+						Name:       "scratch",
+						Properties: map[string]string{"reclaim": "lazy"},
+						ACL:        []string{"A::OWNER@:rw"},
+						SizeRatio:  50,
+						NumSvcReps: 3,
+						Ranks:      "0-3",
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := PoolProfileList(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolProfileDelete(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *PoolProfileDeleteReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"missing name": {
+			req:    &PoolProfileDeleteReq{},
+			expErr: errors.New("no pool profile name"),
+		},
+		"req fails": {
+			req: &PoolProfileDeleteReq{Name: "scratch"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", errors.New("not found"), nil),
+			},
+			expErr: errors.New("not found"),
+		},
+		"success": {
+			req: &PoolProfileDeleteReq{Name: "scratch"},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.PoolProfileDeleteResp{}),
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := PoolProfileDelete(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
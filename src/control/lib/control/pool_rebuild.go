@@ -0,0 +1,180 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+type (
+	// PoolRebuildWatchReq contains the parameters for a pool rebuild
+	// watch request.
+	PoolRebuildWatchReq struct {
+		ID       string
+		Interval time.Duration
+		// StartDeadline, if set, is the time by which a rebuild is
+		// expected to have started. Until the rebuild has been observed
+		// to be busy or the pool map version has changed, a completed
+		// rebuild status is assumed to be left over from an earlier
+		// rebuild and watching continues, unless the deadline has
+		// passed.
+		StartDeadline time.Time
+	}
+
+	// PoolRebuildProgress describes a single sample of pool rebuild
+	// progress. Rates and the estimated time remaining are derived from
+	// the samples taken since the rebuild was first observed to be busy.
+	PoolRebuildProgress struct {
+		Timestamp    time.Time        `json:"timestamp"`
+		State        PoolRebuildState `json:"state"`
+		Status       int32            `json:"status"`
+		Objects      uint64           `json:"objects"`
+		Records      uint64           `json:"records"`
+		TotalObjects uint64           `json:"total_objects"`
+		Percent      float64          `json:"percent"`
+		ObjectRate   float64          `json:"objects_per_sec"`
+		RecordRate   float64          `json:"records_per_sec"`
+		Elapsed      time.Duration    `json:"elapsed"`
+		Remaining    time.Duration    `json:"remaining"`
+	}
+
+	// PoolRebuildWatchFn is called with each pool rebuild progress sample.
+	PoolRebuildWatchFn func(*PoolRebuildProgress)
+
+	// rebuildTracker derives rebuild rates from successive samples.
+	rebuildTracker struct {
+		started  bool
+		start    time.Time
+		startObj uint64
+		startRec uint64
+	}
+)
+
+// Failed returns true if the rebuild has completed with an error.
+func (p *PoolRebuildProgress) Failed() bool {
+	return p.Status != 0
+}
+
+// Finished returns true if no further progress is expected.
+func (p *PoolRebuildProgress) Finished() bool {
+	return p.Failed() || p.State == PoolRebuildStateDone
+}
+
+func (rt *rebuildTracker) update(ts time.Time, rs *PoolRebuildStatus) *PoolRebuildProgress {
+	p := &PoolRebuildProgress{
+		Timestamp:    ts,
+		State:        rs.State,
+		Status:       rs.Status,
+		Objects:      rs.Objects,
+		Records:      rs.Records,
+		TotalObjects: rs.TotalObjects,
+	}
+
+	// A drop in the object count indicates that a new rebuild has started,
+	// so the baseline needs to be reset.
+	if rs.State == PoolRebuildStateBusy && (!rt.started || rs.Objects < rt.startObj) {
+		rt.started = true
+		rt.start = ts
+		rt.startObj = rs.Objects
+		rt.startRec = rs.Records
+	}
+
+	if rs.TotalObjects > 0 {
+		p.Percent = float64(rs.Objects) * 100 / float64(rs.TotalObjects)
+		if p.Percent > 100 {
+			p.Percent = 100
+		}
+	}
+	if rs.State == PoolRebuildStateDone && !p.Failed() {
+		p.Percent = 100
+	}
+
+	if !rt.started {
+		return p
+	}
+
+	p.Elapsed = ts.Sub(rt.start)
+	secs := p.Elapsed.Seconds()
+	if secs <= 0 {
+		return p
+	}
+	if rs.Objects >= rt.startObj {
+		p.ObjectRate = float64(rs.Objects-rt.startObj) / secs
+	}
+	if rs.Records >= rt.startRec {
+		p.RecordRate = float64(rs.Records-rt.startRec) / secs
+	}
+	if rs.State == PoolRebuildStateBusy && p.ObjectRate > 0 && rs.TotalObjects > rs.Objects {
+		remaining := float64(rs.TotalObjects-rs.Objects) / p.ObjectRate
+		p.Remaining = time.Duration(remaining * float64(time.Second)).Round(time.Second)
+	}
+
+	return p
+}
+
+// PoolRebuildWatch repeatedly queries the specified pool at the requested
+// interval and invokes the supplied callback with the rebuild progress of
+// each sample. It returns when the rebuild has completed, the rebuild has
+// failed, or the context is canceled. Without a start deadline in the request
+// it also returns after the first sample if the pool has never been rebuilt.
+// If a start deadline is set, the status of a rebuild that has not yet started
+// is not mistaken for that of an earlier rebuild.
+func PoolRebuildWatch(ctx context.Context, rpcClient UnaryInvoker, req *PoolRebuildWatchReq, onProgress PoolRebuildWatchFn) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if onProgress == nil {
+		return errors.New("nil progress callback")
+	}
+	if req.Interval <= 0 {
+		return errors.Errorf("invalid pool rebuild watch interval %s", req.Interval)
+	}
+
+	var tracker rebuildTracker
+	var firstMapVer uint32
+	for nrSamples := 0; ; nrSamples++ {
+		resp, err := PoolQuery(ctx, rpcClient, &PoolQueryReq{ID: req.ID})
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		if resp.Rebuild == nil {
+			return errors.New("pool query response missing rebuild status")
+		}
+		if nrSamples == 0 {
+			firstMapVer = resp.Version
+		}
+
+		ts := time.Now()
+		progress := tracker.update(ts, resp.Rebuild)
+		onProgress(progress)
+
+		stale := !req.StartDeadline.IsZero() && !tracker.started && resp.Version == firstMapVer &&
+			ts.Before(req.StartDeadline)
+		switch {
+		case req.StartDeadline.IsZero() && nrSamples == 0 && progress.State == PoolRebuildStateIdle:
+			// no rebuild in progress and none expected
+			return nil
+		case stale:
+		case progress.Failed():
+			return errors.Errorf("pool rebuild failed, status=%d", progress.Status)
+		case progress.Finished():
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(req.Interval):
+		}
+	}
+}
@@ -0,0 +1,367 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_rebuildTracker_update(t *testing.T) {
+	t0 := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	type sample struct {
+		offset time.Duration
+		status *PoolRebuildStatus
+	}
+
+	for name, tc := range map[string]struct {
+		samples []sample
+		exp     *PoolRebuildProgress
+	}{
+		"idle": {
+			samples: []sample{
+				{0, &PoolRebuildStatus{State: PoolRebuildStateIdle}},
+			},
+			exp: &PoolRebuildProgress{
+				Timestamp: t0,
+				State:     PoolRebuildStateIdle,
+			},
+		},
+		"first busy sample": {
+			samples: []sample{
+				{0, &PoolRebuildStatus{State: PoolRebuildStateBusy, Objects: 10, Records: 100, TotalObjects: 40}},
+			},
+			exp: &PoolRebuildProgress{
+				Timestamp:    t0,
+				State:        PoolRebuildStateBusy,
+				Objects:      10,
+				Records:      100,
+				TotalObjects: 40,
+				Percent:      25,
+			},
+		},
+		"busy with rate": {
+			samples: []sample{
+				{0, &PoolRebuildStatus{State: PoolRebuildStateBusy, Objects: 10, Records: 100, TotalObjects: 110}},
+				{10 * time.Second, &PoolRebuildStatus{State: PoolRebuildStateBusy, Objects: 60, Records: 600, TotalObjects: 110}},
+			},
+			exp: &PoolRebuildProgress{
+				Timestamp:    t0.Add(10 * time.Second),
+				State:        PoolRebuildStateBusy,
+				Objects:      60,
+				Records:      600,
+				TotalObjects: 110,
+				Percent:      float64(60) * 100 / 110,
+				ObjectRate:   5,
+				RecordRate:   50,
+				Elapsed:      10 * time.Second,
+				Remaining:    10 * time.Second,
+			},
+		},
+		"objects exceed total": {
+			samples: []sample{
+				{0, &PoolRebuildStatus{State: PoolRebuildStateBusy, Objects: 0, TotalObjects: 10}},
+				{time.Second, &PoolRebuildStatus{State: PoolRebuildStateBusy, Objects: 12, TotalObjects: 10}},
+			},
+			exp: &PoolRebuildProgress{
+				Timestamp:    t0.Add(time.Second),
+				State:        PoolRebuildStateBusy,
+				Objects:      12,
+				TotalObjects: 10,
+				Percent:      100,
+				ObjectRate:   12,
+				Elapsed:      time.Second,
+			},
+		},
+		"new rebuild resets baseline": {
+			samples: []sample{
+				{0, &PoolRebuildStatus{State: PoolRebuildStateBusy, Objects: 50, TotalObjects: 100}},
+				{10 * time.Second, &PoolRebuildStatus{State: PoolRebuildStateBusy, Objects: 5, TotalObjects: 100}},
+				{15 * time.Second, &PoolRebuildStatus{State: PoolRebuildStateBusy, Objects: 10, TotalObjects: 100}},
+			},
+			exp: &PoolRebuildProgress{
+				Timestamp:    t0.Add(15 * time.Second),
+				State:        PoolRebuildStateBusy,
+				Objects:      10,
+				TotalObjects: 100,
+				Percent:      10,
+				ObjectRate:   1,
+				Elapsed:      5 * time.Second,
+				Remaining:    90 * time.Second,
+			},
+		},
+		"done": {
+			samples: []sample{
+				{0, &PoolRebuildStatus{State: PoolRebuildStateBusy, Objects: 0, TotalObjects: 20}},
+				{4 * time.Second, &PoolRebuildStatus{State: PoolRebuildStateDone, Objects: 20, TotalObjects: 20}},
+			},
+			exp: &PoolRebuildProgress{
+				Timestamp:    t0.Add(4 * time.Second),
+				State:        PoolRebuildStateDone,
+				Objects:      20,
+				TotalObjects: 20,
+				Percent:      100,
+				ObjectRate:   5,
+				Elapsed:      4 * time.Second,
+			},
+		},
+		"failed": {
+			samples: []sample{
+				{0, &PoolRebuildStatus{State: PoolRebuildStateDone, Status: -1012, Objects: 3, TotalObjects: 6}},
+			},
+			exp: &PoolRebuildProgress{
+				Timestamp:    t0,
+				State:        PoolRebuildStateDone,
+				Status:       -1012,
+				Objects:      3,
+				TotalObjects: 6,
+				Percent:      50,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var rt rebuildTracker
+			var got *PoolRebuildProgress
+			for _, s := range tc.samples {
+				got = rt.update(t0.Add(s.offset), s.status)
+			}
+
+			if diff := cmp.Diff(tc.exp, got); diff != "" {
+				t.Fatalf("unexpected progress (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_PoolRebuildWatch(t *testing.T) {
+	mockVerResp := func(mapVer uint32, state mgmtpb.PoolRebuildStatus_State, status int32, objs uint64) *UnaryResponse {
+		return MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+			Uuid:         test.MockUUID(),
+			TotalTargets: 8,
+			Version:      mapVer,
+			Rebuild: &mgmtpb.PoolRebuildStatus{
+				Status:       status,
+				State:        state,
+				Objects:      objs,
+				TotalObjects: 10,
+			},
+		})
+	}
+	mockResp := func(state mgmtpb.PoolRebuildStatus_State, status int32, objs uint64) *UnaryResponse {
+		return mockVerResp(1, state, status, objs)
+	}
+
+	for name, tc := range map[string]struct {
+		req        *PoolRebuildWatchReq
+		noCallback bool
+		mic        *MockInvokerConfig
+		expStates  []PoolRebuildState
+		expObjects []uint64
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil *control.PoolRebuildWatchReq request"),
+		},
+		"nil callback": {
+			req:        &PoolRebuildWatchReq{ID: test.MockUUID(), Interval: time.Millisecond},
+			noCallback: true,
+			expErr:     errors.New("nil progress callback"),
+		},
+		"invalid interval": {
+			req:    &PoolRebuildWatchReq{ID: test.MockUUID()},
+			expErr: errors.New("invalid pool rebuild watch interval"),
+		},
+		"query fails": {
+			req: &PoolRebuildWatchReq{ID: test.MockUUID(), Interval: time.Millisecond},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"missing rebuild status": {
+			req: &PoolRebuildWatchReq{ID: test.MockUUID(), Interval: time.Millisecond},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", nil, &mgmtpb.PoolQueryResp{
+					Uuid: test.MockUUID(),
+				}),
+			},
+			expErr: errors.New("missing rebuild status"),
+		},
+		"no rebuild in progress": {
+			req: &PoolRebuildWatchReq{ID: test.MockUUID(), Interval: time.Millisecond},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					mockResp(mgmtpb.PoolRebuildStatus_IDLE, 0, 0),
+					mockResp(mgmtpb.PoolRebuildStatus_BUSY, 0, 2),
+				},
+			},
+			expStates:  []PoolRebuildState{PoolRebuildStateIdle},
+			expObjects: []uint64{0},
+		},
+		"rebuild completes": {
+			req: &PoolRebuildWatchReq{ID: test.MockUUID(), Interval: time.Millisecond},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					mockResp(mgmtpb.PoolRebuildStatus_BUSY, 0, 2),
+					mockResp(mgmtpb.PoolRebuildStatus_BUSY, 0, 6),
+					mockResp(mgmtpb.PoolRebuildStatus_DONE, 0, 10),
+				},
+			},
+			expStates: []PoolRebuildState{
+				PoolRebuildStateBusy, PoolRebuildStateBusy, PoolRebuildStateDone,
+			},
+			expObjects: []uint64{2, 6, 10},
+		},
+		"idle pool waited on until start deadline": {
+			req: &PoolRebuildWatchReq{
+				ID:            test.MockUUID(),
+				Interval:      time.Millisecond,
+				StartDeadline: time.Now().Add(time.Minute),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					mockResp(mgmtpb.PoolRebuildStatus_IDLE, 0, 0),
+					mockResp(mgmtpb.PoolRebuildStatus_BUSY, 0, 2),
+					mockResp(mgmtpb.PoolRebuildStatus_DONE, 0, 10),
+				},
+			},
+			expStates: []PoolRebuildState{
+				PoolRebuildStateIdle, PoolRebuildStateBusy, PoolRebuildStateDone,
+			},
+			expObjects: []uint64{0, 2, 10},
+		},
+		"rebuild fails": {
+			req: &PoolRebuildWatchReq{ID: test.MockUUID(), Interval: time.Millisecond},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					mockResp(mgmtpb.PoolRebuildStatus_BUSY, 0, 2),
+					mockResp(mgmtpb.PoolRebuildStatus_DONE, -1012, 4),
+				},
+			},
+			expStates:  []PoolRebuildState{PoolRebuildStateBusy, PoolRebuildStateDone},
+			expObjects: []uint64{2, 4},
+			expErr:     errors.New("pool rebuild failed, status=-1012"),
+		},
+		"completed rebuild accepted without start deadline": {
+			req: &PoolRebuildWatchReq{ID: test.MockUUID(), Interval: time.Millisecond},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					mockResp(mgmtpb.PoolRebuildStatus_DONE, 0, 10),
+					mockResp(mgmtpb.PoolRebuildStatus_BUSY, 0, 2),
+				},
+			},
+			expStates:  []PoolRebuildState{PoolRebuildStateDone},
+			expObjects: []uint64{10},
+		},
+		"stale completed rebuild ignored until busy": {
+			req: &PoolRebuildWatchReq{
+				ID:            test.MockUUID(),
+				Interval:      time.Millisecond,
+				StartDeadline: time.Now().Add(time.Minute),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					mockResp(mgmtpb.PoolRebuildStatus_DONE, 0, 10),
+					mockResp(mgmtpb.PoolRebuildStatus_IDLE, 0, 0),
+					mockResp(mgmtpb.PoolRebuildStatus_BUSY, 0, 4),
+					mockResp(mgmtpb.PoolRebuildStatus_DONE, 0, 10),
+				},
+			},
+			expStates: []PoolRebuildState{
+				PoolRebuildStateDone, PoolRebuildStateIdle,
+				PoolRebuildStateBusy, PoolRebuildStateDone,
+			},
+			expObjects: []uint64{10, 0, 4, 10},
+		},
+		"stale failed rebuild ignored until busy": {
+			req: &PoolRebuildWatchReq{
+				ID:            test.MockUUID(),
+				Interval:      time.Millisecond,
+				StartDeadline: time.Now().Add(time.Minute),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					mockResp(mgmtpb.PoolRebuildStatus_DONE, -1012, 4),
+					mockResp(mgmtpb.PoolRebuildStatus_BUSY, 0, 4),
+					mockResp(mgmtpb.PoolRebuildStatus_DONE, 0, 10),
+				},
+			},
+			expStates: []PoolRebuildState{
+				PoolRebuildStateDone, PoolRebuildStateBusy, PoolRebuildStateDone,
+			},
+			expObjects: []uint64{4, 4, 10},
+		},
+		"completed rebuild accepted after map version change": {
+			req: &PoolRebuildWatchReq{
+				ID:            test.MockUUID(),
+				Interval:      time.Millisecond,
+				StartDeadline: time.Now().Add(time.Minute),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					mockVerResp(5, mgmtpb.PoolRebuildStatus_DONE, 0, 10),
+					mockVerResp(6, mgmtpb.PoolRebuildStatus_DONE, 0, 10),
+				},
+			},
+			expStates:  []PoolRebuildState{PoolRebuildStateDone, PoolRebuildStateDone},
+			expObjects: []uint64{10, 10},
+		},
+		"completed rebuild accepted after start deadline": {
+			req: &PoolRebuildWatchReq{
+				ID:            test.MockUUID(),
+				Interval:      time.Millisecond,
+				StartDeadline: time.Now(),
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					mockResp(mgmtpb.PoolRebuildStatus_DONE, 0, 10),
+					mockResp(mgmtpb.PoolRebuildStatus_BUSY, 0, 2),
+				},
+			},
+			expStates:  []PoolRebuildState{PoolRebuildStateDone},
+			expObjects: []uint64{10},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(name)
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var gotStates []PoolRebuildState
+			var gotObjects []uint64
+			onProgress := func(p *PoolRebuildProgress) {
+				gotStates = append(gotStates, p.State)
+				gotObjects = append(gotObjects, p.Objects)
+			}
+			if tc.noCallback {
+				onProgress = nil
+			}
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+			client := NewMockInvoker(log, mic)
+			gotErr := PoolRebuildWatch(test.Context(t), client, tc.req, onProgress)
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			if diff := cmp.Diff(tc.expStates, gotStates); diff != "" {
+				t.Fatalf("unexpected states (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expObjects, gotObjects); diff != "" {
+				t.Fatalf("unexpected objects (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
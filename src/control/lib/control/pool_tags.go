@@ -0,0 +1,81 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// PoolTagReq contains the parameters for a request to set or remove
+	// operator-defined tags on a pool.
+	PoolTagReq struct {
+		unaryRequest
+		msRequest
+		ID    string
+		Set   system.PoolTags
+		Unset []string
+	}
+
+	// PoolTagResp contains the set of tags on the pool after the update.
+	PoolTagResp struct {
+		Tags system.PoolTags `json:"tags"`
+	}
+)
+
+// PoolTag sets or removes operator-defined tags on a pool. Tags are stored
+// in the system database and may be used to filter the pool list.
+func PoolTag(ctx context.Context, rpcClient UnaryInvoker, req *PoolTagReq) (*PoolTagResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.ID == "" {
+		return nil, errors.New("no pool ID specified")
+	}
+	if len(req.Set) == 0 && len(req.Unset) == 0 {
+		return nil, errors.New("no pool tags to set or unset")
+	}
+	if err := req.Set.Validate(); err != nil {
+		return nil, err
+	}
+	for _, key := range req.Unset {
+		if err := system.ValidatePoolTagKey(key); err != nil {
+			return nil, err
+		}
+	}
+
+	pbReq := &mgmtpb.PoolTagReq{
+		Sys:   req.getSystem(rpcClient),
+		Id:    req.ID,
+		Set:   req.Set.Strings(),
+		Unset: req.Unset,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).PoolTag(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("Update DAOS pool tags request: %s\n", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(PoolTagResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, errors.Wrap(err, "pool tag failed")
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,97 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestControl_PoolTag(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *PoolTagReq
+		mic     *MockInvokerConfig
+		expResp *PoolTagResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"no pool ID": {
+			req: &PoolTagReq{
+				Set: system.PoolTags{"project": "climate"},
+			},
+			expErr: errors.New("no pool ID"),
+		},
+		"nothing to do": {
+			req:    &PoolTagReq{ID: "pool1"},
+			expErr: errors.New("no pool tags"),
+		},
+		"invalid set": {
+			req: &PoolTagReq{
+				ID:  "pool1",
+				Set: system.PoolTags{"bad key": "climate"},
+			},
+			expErr: errors.New("invalid character"),
+		},
+		"invalid unset": {
+			req: &PoolTagReq{
+				ID:    "pool1",
+				Unset: []string{""},
+			},
+			expErr: errors.New("key must not be empty"),
+		},
+		"req fails": {
+			req: &PoolTagReq{
+				ID:    "pool1",
+				Unset: []string{"project"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"success": {
+			req: &PoolTagReq{
+				ID:    "pool1",
+				Set:   system.PoolTags{"project": "climate"},
+				Unset: []string{"owner"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("", nil, &mgmtpb.PoolTagResp{
+					Tags: []string{"project=climate", "tier=gold"},
+				}),
+			},
+			expResp: &PoolTagResp{
+				Tags: system.PoolTags{"project": "climate", "tier": "gold"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := PoolTag(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
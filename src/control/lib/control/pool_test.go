@@ -45,6 +45,139 @@ func rankSetCmpOpt() []cmp.Option {
 	}
 }
 
+func TestControl_PoolCreatePlan(t *testing.T) {
+	mockExt := auth.NewMockExtWithUser("poolTest", 0, 0)
+	mockScanHost := func(host string, rank ranklist.Rank, scmBytes, nvmeBytes uint64) *HostResponse {
+		return &HostResponse{
+			Addr: host,
+			Message: MockStorageScanResp(t,
+				[]MockScmConfig{
+					{
+						MockStorageConfig: MockStorageConfig{
+							TotalBytes:  scmBytes,
+							AvailBytes:  scmBytes,
+							UsableBytes: scmBytes,
+						},
+						Rank: rank,
+					},
+				},
+				[]MockNvmeConfig{
+					{
+						MockStorageConfig: MockStorageConfig{
+							TotalBytes:  nvmeBytes,
+							AvailBytes:  nvmeBytes,
+							UsableBytes: nvmeBytes,
+						},
+						Rank: rank,
+					},
+				}),
+		}
+	}
+	planResp := &mgmtpb.PoolCreatePlanResp{
+		TgtRanks:     []uint32{0, 1},
+		TierBytes:    []uint64{50 * humanize.GByte, 500 * humanize.GByte},
+		TgtsPerRank:  8,
+		FaultDomains: []string{"/rack0/foo", "/rack0/bar"},
+	}
+
+	for name, tc := range map[string]struct {
+		mic     *MockInvokerConfig
+		req     *PoolCreateReq
+		expResp *PoolCreatePlanResp
+		expErr  error
+	}{
+		"invalid sizes": {
+			req: &PoolCreateReq{
+				TierBytes: []uint64{0, humanize.GByte},
+			},
+			expErr: errors.New("0 SCM"),
+		},
+		"plan fails": {
+			req: &PoolCreateReq{
+				TierBytes: []uint64{50 * humanize.GByte, 500 * humanize.GByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponse: MockMSResponse("host1", errors.New("remote failed"), nil),
+			},
+			expErr: errors.New("remote failed"),
+		},
+		"scan fails": {
+			req: &PoolCreateReq{
+				TierBytes: []uint64{50 * humanize.GByte, 500 * humanize.GByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, planResp),
+					MockMSResponse("host1", errors.New("scan failed"), nil),
+				},
+			},
+			expErr: errors.New("scan failed"),
+		},
+		"success": {
+			req: &PoolCreateReq{
+				TierBytes: []uint64{50 * humanize.GByte, 500 * humanize.GByte},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, planResp),
+					{
+						Responses: []*HostResponse{
+							mockScanHost("foo", 0, 100*humanize.GByte, humanize.TByte),
+							mockScanHost("bar", 1, 10*humanize.GByte, humanize.TByte),
+						},
+					},
+				},
+			},
+			expResp: &PoolCreatePlanResp{
+				TgtRanks:    []uint32{0, 1},
+				TgtsPerRank: 8,
+				TierBytes:   []uint64{50 * humanize.GByte, 500 * humanize.GByte},
+				TgtTierBytes: []uint64{
+					50 * humanize.GByte / 8,
+					500 * humanize.GByte / 8,
+				},
+				TierRatio:    []float64{50.0 / 550.0, 500.0 / 550.0},
+				FaultDomains: []string{"/rack0/foo", "/rack0/bar"},
+				Ranks: []*PoolCreateRankPlan{
+					{
+						Rank:          0,
+						TierFree:      []uint64{100 * humanize.GByte, humanize.TByte},
+						TierFreeAfter: []uint64{50 * humanize.GByte, 500 * humanize.GByte},
+						Fits:          true,
+					},
+					{
+						Rank:          1,
+						TierFree:      []uint64{10 * humanize.GByte, humanize.TByte},
+						TierFreeAfter: []uint64{0, 500 * humanize.GByte},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mic := tc.mic
+			if mic == nil {
+				mic = DefaultMockInvokerConfig()
+			}
+
+			tc.req.userExt = mockExt
+			gotResp, gotErr := PoolCreatePlan(test.Context(t), NewMockInvoker(log, mic), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpt := cmpopts.EquateApprox(0, 1e-9)
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpt); diff != "" {
+				t.Fatalf("Unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestControl_PoolDestroy(t *testing.T) {
 	for name, tc := range map[string]struct {
 		mic    *MockInvokerConfig
@@ -2043,6 +2176,46 @@ func TestControl_ListPools(t *testing.T) {
 				},
 			},
 		},
+		"two pools; filtered by tag": {
+			req: &ListPoolsReq{
+				Tags: system.PoolTags{"project": "climate"},
+			},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("host1", nil, &mgmtpb.ListPoolsResp{
+						Pools: []*mgmtpb.ListPoolsResp_Pool{
+							{
+								Uuid:    test.MockUUID(1),
+								SvcReps: []uint32{1, 3, 5, 8},
+								State:   system.PoolServiceStateReady.String(),
+								Tags:    []string{"project=fusion"},
+							},
+							{
+								Uuid:    test.MockUUID(2),
+								SvcReps: []uint32{1, 2, 3},
+								State:   system.PoolServiceStateReady.String(),
+								Tags:    []string{"owner=bob", "project=climate"},
+							},
+						},
+					}),
+					MockMSResponse("host1", nil, queryResp(2)),
+				},
+			},
+			expResp: &ListPoolsResp{
+				Pools: []*Pool{
+					{
+						UUID:            test.MockUUID(2),
+						ServiceReplicas: []ranklist.Rank{1, 2, 3},
+						TargetsTotal:    42,
+						TargetsDisabled: 17,
+						Usage:           expUsage,
+						State:           system.PoolServiceStateDegraded.String(),
+						RebuildState:    "busy",
+						Tags:            system.PoolTags{"owner": "bob", "project": "climate"},
+					},
+				},
+			},
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			log, buf := logging.NewTestLogger(t.Name())
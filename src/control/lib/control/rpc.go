@@ -124,6 +124,7 @@ type (
 	// Client implements the Invoker interface and should be provided to
 	// API methods to invoke RPCs.
 	Client struct {
+		configMu  sync.RWMutex
 		config    *Config
 		log       debugLogger
 		component build.Component
@@ -189,13 +190,34 @@ func (c *Client) GetComponent() build.Component {
 // SetConfig sets the client configuration for an
 // existing Client.
 func (c *Client) SetConfig(cfg *Config) {
+	c.configMu.Lock()
+	defer c.configMu.Unlock()
+
 	c.config = cfg
 }
 
+// SetHostList replaces the default host list in the client configuration,
+// e.g. when the set of MS replicas has changed.
+func (c *Client) SetHostList(hostList []string) {
+	c.configMu.Lock()
+	defer c.configMu.Unlock()
+
+	cfg := *c.config
+	cfg.HostList = hostList
+	c.config = &cfg
+}
+
+func (c *Client) getConfig() *Config {
+	c.configMu.RLock()
+	defer c.configMu.RUnlock()
+
+	return c.config
+}
+
 // GetConfig retrieves the system name from the client configuration and
 // implements the sysGetter interface.
 func (c *Client) GetSystem() string {
-	return c.config.SystemName
+	return c.getConfig().SystemName
 }
 
 func (c *Client) Debug(msg string) {
@@ -218,7 +240,7 @@ func (c *Client) dialOptions() ([]grpc.DialOption, error) {
 		grpc.FailOnNonTempDialError(true),
 	}
 
-	creds, err := security.DialOptionForTransportConfig(c.config.TransportConfig)
+	creds, err := security.DialOptionForTransportConfig(c.getConfig().TransportConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -270,7 +292,7 @@ func wrapReqTimeout(req UnaryRequest, err error) error {
 // provides access to a stream of HostResponse items as they are received, and
 // is closed when no more responses are expected.
 func (c *Client) InvokeUnaryRPCAsync(parent context.Context, req UnaryRequest) (HostResponseChan, error) {
-	hosts, err := getRequestHosts(c.config, req)
+	hosts, err := getRequestHosts(c.getConfig(), req)
 	if err != nil {
 		return nil, err
 	}
@@ -528,5 +550,5 @@ func invokeUnaryRPC(parentCtx context.Context, log debugLogger, c UnaryInvoker,
 // items which represent the success or failure of the RPC invocation for each host
 // in the request.
 func (c *Client) InvokeUnaryRPC(ctx context.Context, req UnaryRequest) (*UnaryResponse, error) {
-	return invokeUnaryRPC(ctx, c.log, c, req, c.config.HostList)
+	return invokeUnaryRPC(ctx, c.log, c, req, c.getConfig().HostList)
 }
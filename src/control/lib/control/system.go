@@ -518,6 +518,147 @@ func SystemExclude(ctx context.Context, rpcClient UnaryInvoker, req *SystemExclu
 	return resp, convertMSResponse(ur, resp)
 }
 
+// SystemDrainReq contains the inputs for the system drain request.
+type SystemDrainReq struct {
+	unaryRequest
+	msRequest
+	sysRequest
+	Reint bool
+}
+
+// PoolRankResult describes the result of a drain or reintegrate operation on
+// a set of ranks of a pool.
+type PoolRankResult struct {
+	Status int32  `json:"status"`
+	Msg    string `json:"msg"`
+	PoolID string `json:"pool_id"`
+	Ranks  string `json:"ranks"`
+}
+
+// PoolRankResults is an alias for a PoolRankResult slice.
+type PoolRankResults []*PoolRankResult
+
+// SystemDrainResp contains the request response.
+type SystemDrainResp struct {
+	Reint   bool            `json:"reint"`
+	Results PoolRankResults `json:"results"`
+}
+
+// Errors returns a single error combining all error messages associated with a
+// system drain response.
+func (resp *SystemDrainResp) Errors() error {
+	var errMsgs []string
+	for _, r := range resp.Results {
+		if r.Status != int32(daos.Success) {
+			errMsgs = append(errMsgs, fmt.Sprintf("pool %s ranks %s: %s", r.PoolID, r.Ranks, r.Msg))
+		}
+	}
+
+	if len(errMsgs) > 0 {
+		return errors.New(strings.Join(errMsgs, ", "))
+	}
+
+	return nil
+}
+
+// SystemDrain will drain (or reintegrate) the specified ranks from all pools
+// that have targets on them.
+func SystemDrain(ctx context.Context, rpcClient UnaryInvoker, req *SystemDrainReq) (*SystemDrainResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Ranks.Count() == 0 && req.Hosts.Count() == 0 {
+		return nil, errors.New("no ranks or hosts specified")
+	}
+
+	pbReq := &mgmtpb.SystemDrainReq{
+		Hosts: req.Hosts.String(),
+		Ranks: req.Ranks.String(),
+		Sys:   req.getSystem(rpcClient),
+		Reint: req.Reint,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemDrain(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system drain request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemDrainResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// SystemMaintenanceAction identifies the operation requested by a system
+// maintenance request.
+type SystemMaintenanceAction int32
+
+const (
+	// SystemMaintenanceStatus requests the maintenance state of ranks.
+	SystemMaintenanceStatus = SystemMaintenanceAction(mgmtpb.SystemMaintenanceReq_STATUS)
+	// SystemMaintenanceStart places ranks into maintenance.
+	SystemMaintenanceStart = SystemMaintenanceAction(mgmtpb.SystemMaintenanceReq_START)
+	// SystemMaintenanceFinish returns ranks in maintenance to service.
+	SystemMaintenanceFinish = SystemMaintenanceAction(mgmtpb.SystemMaintenanceReq_FINISH)
+)
+
+// SystemMaintenanceReq contains the inputs for the system maintenance request.
+type SystemMaintenanceReq struct {
+	unaryRequest
+	msRequest
+	sysRequest
+	Action SystemMaintenanceAction
+}
+
+// MaintenanceMember describes the maintenance state of a system member.
+type MaintenanceMember struct {
+	Rank       ranklist.Rank `json:"rank"`
+	Addr       string        `json:"addr"`
+	State      string        `json:"state"`
+	Phase      string        `json:"phase"`
+	Pools      []string      `json:"pools"`
+	Error      string        `json:"error"`
+	LastUpdate string        `json:"last_update"`
+}
+
+// SystemMaintenanceResp contains the request response.
+type SystemMaintenanceResp struct {
+	Members []*MaintenanceMember `json:"members"`
+}
+
+// SystemMaintenance will start or finish maintenance of the specified ranks,
+// or report the maintenance state of ranks. If no ranks or hosts are specified
+// in a status request, all ranks undergoing maintenance are reported.
+func SystemMaintenance(ctx context.Context, rpcClient UnaryInvoker, req *SystemMaintenanceReq) (*SystemMaintenanceResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Action != SystemMaintenanceStatus && req.Ranks.Count() == 0 && req.Hosts.Count() == 0 {
+		return nil, errors.New("no ranks or hosts specified")
+	}
+
+	pbReq := &mgmtpb.SystemMaintenanceReq{
+		Hosts:  req.Hosts.String(),
+		Ranks:  req.Ranks.String(),
+		Sys:    req.getSystem(rpcClient),
+		Action: mgmtpb.SystemMaintenanceReq_Action(req.Action),
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemMaintenance(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system maintenance request: %s", pbUtil.Debug(pbReq))
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemMaintenanceResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
 // SystemEraseReq contains the inputs for a system erase request.
 type SystemEraseReq struct {
 	msRequest
@@ -0,0 +1,371 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+)
+
+type (
+	// SystemReplicaReq contains the inputs for a request to add or remove
+	// a management service replica.
+	SystemReplicaReq struct {
+		unaryRequest
+		msRequest
+
+		Host string
+	}
+
+	// SystemListReplicasReq contains the inputs for a request to list the
+	// management service replicas.
+	SystemListReplicasReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemReplicasResp contains the management service replica set.
+	SystemReplicasResp struct {
+		Replicas []string `json:"replicas"`
+		Leader   string   `json:"leader"`
+	}
+)
+
+type replicaRPC func(mgmtpb.MgmtSvcClient, context.Context, *mgmtpb.SystemReplicaReq, ...grpc.CallOption) (*mgmtpb.SystemReplicasResp, error)
+
+func invokeReplicaRPC(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq, rpc replicaRPC) (*SystemReplicasResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+	if req.Host == "" {
+		return nil, errors.New("replica host must be specified")
+	}
+
+	pbReq := &mgmtpb.SystemReplicaReq{
+		Sys:  req.getSystem(rpcClient),
+		Host: req.Host,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return rpc(mgmtpb.NewMgmtSvcClient(conn), ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system replica request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemReplicasResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// SystemAddReplica adds the control plane instance on the requested host to
+// the set of management service replicas.
+func SystemAddReplica(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq) (*SystemReplicasResp, error) {
+	return invokeReplicaRPC(ctx, rpcClient, req, mgmtpb.MgmtSvcClient.SystemAddReplica)
+}
+
+// SystemRemoveReplica removes the control plane instance on the requested
+// host from the set of management service replicas.
+func SystemRemoveReplica(ctx context.Context, rpcClient UnaryInvoker, req *SystemReplicaReq) (*SystemReplicasResp, error) {
+	return invokeReplicaRPC(ctx, rpcClient, req, mgmtpb.MgmtSvcClient.SystemRemoveReplica)
+}
+
+// SystemListReplicas lists the management service replicas.
+func SystemListReplicas(ctx context.Context, rpcClient UnaryInvoker, req *SystemListReplicasReq) (*SystemReplicasResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemListReplicasReq{Sys: req.getSystem(rpcClient)}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemListReplicas(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system list-replicas request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemReplicasResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// SetReplicasReq contains the inputs for a request notifying a control plane
+// instance of a change in the management service replica set.
+type SetReplicasReq struct {
+	unaryRequest
+
+	Replicas []string
+}
+
+// SetReplicas notifies the control plane instances in the request host list of
+// an updated management service replica set. It is intended to be used by the
+// MS leader when reconfiguring the replica set.
+func SetReplicas(ctx context.Context, rpcClient UnaryInvoker, req *SetReplicasReq) error {
+	if req == nil {
+		return errors.Errorf("nil %T request", req)
+	}
+	if len(req.Replicas) == 0 {
+		return errors.New("replica set must not be empty")
+	}
+
+	pbReq := &mgmtpb.SetReplicasReq{
+		Sys:      req.getSystem(rpcClient),
+		Replicas: req.Replicas,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SetReplicas(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS set-replicas request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	for _, hr := range ur.Responses {
+		if hr.Error != nil {
+			return errors.Wrapf(hr.Error, "set replicas on %s", hr.Addr)
+		}
+	}
+
+	return nil
+}
+
+type (
+	// SystemBackup describes a backup of the management service database.
+	SystemBackup struct {
+		Name     string    `json:"name"`
+		Path     string    `json:"path"`
+		Time     time.Time `json:"time"`
+		Index    uint64    `json:"index"`
+		Term     uint64    `json:"term"`
+		Size     int64     `json:"size"`
+		Checksum string    `json:"checksum"`
+		Error    string    `json:"error,omitempty"`
+	}
+
+	// SystemBackupReq contains the inputs for a request to take a backup of
+	// the management service database.
+	SystemBackupReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemBackupResp contains details of a newly-taken backup.
+	SystemBackupResp struct {
+		Backup  *SystemBackup `json:"backup"`
+		Removed []string      `json:"removed"`
+	}
+
+	// SystemListBackupsReq contains the inputs for a request to list the
+	// management service database backups.
+	SystemListBackupsReq struct {
+		unaryRequest
+		msRequest
+	}
+
+	// SystemListBackupsResp contains the backups held by the MS leader.
+	SystemListBackupsResp struct {
+		Backups []*SystemBackup `json:"backups"`
+		Leader  string          `json:"leader"`
+		Dir     string          `json:"dir"`
+	}
+)
+
+// SystemBackupNow requests that the MS leader immediately take a backup of the
+// management service database.
+func SystemBackupNow(ctx context.Context, rpcClient UnaryInvoker, req *SystemBackupReq) (*SystemBackupResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemBackupReq{Sys: req.getSystem(rpcClient)}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemBackup(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system backup request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemBackupResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+// SystemListBackups lists the management service database backups held by
+// the MS leader.
+func SystemListBackups(ctx context.Context, rpcClient UnaryInvoker, req *SystemListBackupsReq) (*SystemListBackupsResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	pbReq := &mgmtpb.SystemListBackupsReq{Sys: req.getSystem(rpcClient)}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemListBackups(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system list-backups request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemListBackupsResp)
+	return resp, convertMSResponse(ur, resp)
+}
+
+const defaultLeaderTransferTimeout = 30 * time.Second
+
+// leaderTransferPollInterval is the interval between checks for the new
+// leader after a leadership transfer.
+var leaderTransferPollInterval = 250 * time.Millisecond
+
+type (
+	// SystemLeaderTransferReq contains the inputs for a request to transfer
+	// management service leadership to another replica.
+	SystemLeaderTransferReq struct {
+		unaryRequest
+		msRequest
+
+		Target string
+	}
+
+	// SystemLeaderTransferResp contains the result of a leadership transfer.
+	SystemLeaderTransferResp struct {
+		OldLeader string        `json:"old_leader"`
+		NewLeader string        `json:"new_leader"`
+		Replicas  []string      `json:"replicas"`
+		Elapsed   time.Duration `json:"elapsed"`
+	}
+
+	// ReplicaLeaderQueryReq is used to ask a specific replica for the
+	// current leader, bypassing the retry and redirection of MS requests.
+	ReplicaLeaderQueryReq struct {
+		unaryRequest
+	}
+)
+
+// queryReplicaLeader returns the current leader as reported by the replica
+// at the supplied address.
+func queryReplicaLeader(ctx context.Context, rpcClient UnaryInvoker, sys, host string) (string, error) {
+	req := new(ReplicaLeaderQueryReq)
+	req.SetHostList([]string{host})
+	pbReq := &mgmtpb.LeaderQueryReq{Sys: sys}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).LeaderQuery(ctx, pbReq)
+	})
+
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return "", err
+	}
+	if len(ur.Responses) != 1 {
+		return "", errors.Errorf("expected 1 response from %s, got %d", host, len(ur.Responses))
+	}
+	hr := ur.Responses[0]
+	if hr.Error != nil {
+		return "", hr.Error
+	}
+	lqResp, ok := hr.Message.(*mgmtpb.LeaderQueryResp)
+	if !ok {
+		return "", errors.Errorf("unexpected response type %T from %s", hr.Message, host)
+	}
+
+	return lqResp.GetCurrentLeader(), nil
+}
+
+// waitForNewLeader polls the replicas until one other than the previous
+// leader reports itself as leader in response to a leader query.
+func waitForNewLeader(ctx context.Context, rpcClient UnaryInvoker, sys string, resp *SystemLeaderTransferResp) error {
+	var candidates []string
+	if resp.NewLeader != "" {
+		candidates = []string{resp.NewLeader}
+	} else {
+		for _, rep := range resp.Replicas {
+			if rep != resp.OldLeader {
+				candidates = append(candidates, rep)
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return errors.New("no candidate replicas for new leader")
+	}
+
+	for {
+		for _, host := range candidates {
+			leader, err := queryReplicaLeader(ctx, rpcClient, sys, host)
+			if err != nil {
+				rpcClient.Debugf("leader query to %s failed: %s", host, err)
+				continue
+			}
+			if leader == host {
+				resp.NewLeader = leader
+				return nil
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return errors.Wrap(ctx.Err(), "waiting for new leader")
+		case <-time.After(leaderTransferPollInterval):
+		}
+	}
+}
+
+// SystemLeaderTransfer requests that the MS leader transfer leadership to
+// another replica, optionally the one specified in the request, and then waits
+// until the new leader is serving requests.
+func SystemLeaderTransfer(ctx context.Context, rpcClient UnaryInvoker, req *SystemLeaderTransferReq) (*SystemLeaderTransferResp, error) {
+	if req == nil {
+		return nil, errors.Errorf("nil %T request", req)
+	}
+
+	start := time.Now()
+	sys := req.getSystem(rpcClient)
+	pbReq := &mgmtpb.SystemLeaderTransferReq{
+		Sys:    sys,
+		Target: req.Target,
+	}
+	req.setRPC(func(ctx context.Context, conn *grpc.ClientConn) (proto.Message, error) {
+		return mgmtpb.NewMgmtSvcClient(conn).SystemLeaderTransfer(ctx, pbReq)
+	})
+
+	rpcClient.Debugf("DAOS system leader-transfer request: %+v", pbReq)
+	ur, err := rpcClient.InvokeUnaryRPC(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(SystemLeaderTransferResp)
+	if err := convertMSResponse(ur, resp); err != nil {
+		return nil, err
+	}
+
+	waitCtx := ctx
+	if _, hasDeadline := ctx.Deadline(); !hasDeadline {
+		var cancel context.CancelFunc
+		waitCtx, cancel = context.WithTimeout(ctx, defaultLeaderTransferTimeout)
+		defer cancel()
+	}
+	if err := waitForNewLeader(waitCtx, rpcClient, sys, resp); err != nil {
+		return nil, err
+	}
+	resp.Elapsed = time.Since(start)
+
+	return resp, nil
+}
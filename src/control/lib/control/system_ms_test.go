@@ -0,0 +1,466 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package control
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestControl_SystemAddRemoveReplica(t *testing.T) {
+	replicasResp := &mgmtpb.SystemReplicasResp{
+		Replicas: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+		Leader:   "10.0.0.1:10001",
+	}
+
+	for name, tc := range map[string]struct {
+		req     *SystemReplicaReq
+		mic     *MockInvokerConfig
+		expResp *SystemReplicasResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"missing host": {
+			req:    &SystemReplicaReq{},
+			expErr: errors.New("must be specified"),
+		},
+		"req fails": {
+			req: &SystemReplicaReq{Host: "host2"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("quorum"), nil),
+				},
+			},
+			expErr: errors.New("quorum"),
+		},
+		"success": {
+			req: &SystemReplicaReq{Host: "host2"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, replicasResp),
+				},
+			},
+			expResp: &SystemReplicasResp{
+				Replicas: []string{"10.0.0.1:10001", "10.0.0.2:10001"},
+				Leader:   "10.0.0.1:10001",
+			},
+		},
+	} {
+		for opName, opFn := range map[string]func(*testing.T, UnaryInvoker, *SystemReplicaReq) (*SystemReplicasResp, error){
+			"add": func(t *testing.T, c UnaryInvoker, r *SystemReplicaReq) (*SystemReplicasResp, error) {
+				return SystemAddReplica(test.Context(t), c, r)
+			},
+			"remove": func(t *testing.T, c UnaryInvoker, r *SystemReplicaReq) (*SystemReplicasResp, error) {
+				return SystemRemoveReplica(test.Context(t), c, r)
+			},
+		} {
+			t.Run(opName+" "+name, func(t *testing.T) {
+				log, buf := logging.NewTestLogger(t.Name())
+				defer test.ShowBufferOnFailure(t, buf)
+
+				var req *SystemReplicaReq
+				if tc.req != nil {
+					req = &SystemReplicaReq{Host: tc.req.Host}
+				}
+
+				client := NewMockInvoker(log, tc.mic)
+				gotResp, gotErr := opFn(t, client, req)
+				test.CmpErr(t, tc.expErr, gotErr)
+				if tc.expErr != nil {
+					return
+				}
+
+				if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+					t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+				}
+			})
+		}
+	}
+}
+
+func TestControl_SystemListReplicas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemListReplicasReq
+		mic     *MockInvokerConfig
+		expResp *SystemReplicasResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemListReplicasReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("failed"), nil),
+				},
+			},
+			expErr: errors.New("failed"),
+		},
+		"success": {
+			req: &SystemListReplicasReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemReplicasResp{
+						Replicas: []string{"10.0.0.1:10001"},
+						Leader:   "10.0.0.1:10001",
+					}),
+				},
+			},
+			expResp: &SystemReplicasResp{
+				Replicas: []string{"10.0.0.1:10001"},
+				Leader:   "10.0.0.1:10001",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemListReplicas(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SetReplicas(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *SetReplicasReq
+		mic    *MockInvokerConfig
+		expErr error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"empty replicas": {
+			req:    &SetReplicasReq{},
+			expErr: errors.New("must not be empty"),
+		},
+		"host fails": {
+			req: &SetReplicasReq{Replicas: []string{"10.0.0.1:10001"}},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host2", Error: errors.New("failed")},
+					},
+				},
+			},
+			expErr: errors.New("host2"),
+		},
+		"success": {
+			req: &SetReplicasReq{Replicas: []string{"10.0.0.1:10001"}},
+			mic: &MockInvokerConfig{
+				UnaryResponse: &UnaryResponse{
+					Responses: []*HostResponse{
+						{Addr: "host2", Message: &mgmtpb.DaosResp{}},
+					},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotErr := SetReplicas(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
+
+func TestControl_SystemBackupNow(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemBackupReq
+		mic     *MockInvokerConfig
+		expResp *SystemBackupResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemBackupReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("not configured"), nil),
+				},
+			},
+			expErr: errors.New("not configured"),
+		},
+		"success": {
+			req: &SystemBackupReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemBackupResp{
+						Backup: &mgmtpb.SystemBackup{
+							Name:     "backup-20230102T030405Z-2-42",
+							Path:     "/backups/backup-20230102T030405Z-2-42",
+							Time:     "2023-01-02T03:04:05Z",
+							Index:    42,
+							Term:     2,
+							Size:     1024,
+							Checksum: "abcd",
+						},
+						Removed: []string{"/backups/backup-20230101T030405Z-2-40"},
+					}),
+				},
+			},
+			expResp: &SystemBackupResp{
+				Backup: &SystemBackup{
+					Name:     "backup-20230102T030405Z-2-42",
+					Path:     "/backups/backup-20230102T030405Z-2-42",
+					Time:     time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+					Index:    42,
+					Term:     2,
+					Size:     1024,
+					Checksum: "abcd",
+				},
+				Removed: []string{"/backups/backup-20230101T030405Z-2-40"},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemBackupNow(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestControl_SystemListBackups(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req     *SystemListBackupsReq
+		mic     *MockInvokerConfig
+		expResp *SystemListBackupsResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemListBackupsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("failed"), nil),
+				},
+			},
+			expErr: errors.New("failed"),
+		},
+		"success": {
+			req: &SystemListBackupsReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemListBackupsResp{
+						Backups: []*mgmtpb.SystemBackup{
+							{
+								Name:  "backup-20230102T030405Z-2-42",
+								Time:  "2023-01-02T03:04:05Z",
+								Index: 42,
+								Term:  2,
+								Error: "checksum mismatch",
+							},
+						},
+						Leader: "10.0.0.1:10001",
+						Dir:    "/backups",
+					}),
+				},
+			},
+			expResp: &SystemListBackupsResp{
+				Backups: []*SystemBackup{
+					{
+						Name:  "backup-20230102T030405Z-2-42",
+						Time:  time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC),
+						Index: 42,
+						Term:  2,
+						Error: "checksum mismatch",
+					},
+				},
+				Leader: "10.0.0.1:10001",
+				Dir:    "/backups",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemListBackups(test.Context(t), client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func mockLeaderQueryResp(addr, leader string, err error) *UnaryResponse {
+	hr := &HostResponse{Addr: addr, Error: err}
+	if err == nil {
+		hr.Message = &mgmtpb.LeaderQueryResp{CurrentLeader: leader}
+	}
+	return &UnaryResponse{Responses: []*HostResponse{hr}}
+}
+
+func TestControl_SystemLeaderTransfer(t *testing.T) {
+	defer func(interval time.Duration) {
+		leaderTransferPollInterval = interval
+	}(leaderTransferPollInterval)
+	leaderTransferPollInterval = time.Millisecond
+
+	replicas := []string{"10.0.0.1:10001", "10.0.0.2:10001", "10.0.0.3:10001"}
+
+	for name, tc := range map[string]struct {
+		req     *SystemLeaderTransferReq
+		timeout time.Duration
+		mic     *MockInvokerConfig
+		expResp *SystemLeaderTransferResp
+		expErr  error
+	}{
+		"nil req": {
+			expErr: errors.New("nil"),
+		},
+		"req fails": {
+			req: &SystemLeaderTransferReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", errors.New("failed"), nil),
+				},
+			},
+			expErr: errors.New("failed"),
+		},
+		"new leader known": {
+			req: &SystemLeaderTransferReq{Target: "10.0.0.2"},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemLeaderTransferResp{
+						OldLeader: replicas[0],
+						NewLeader: replicas[1],
+						Replicas:  replicas,
+					}),
+					mockLeaderQueryResp(replicas[1], replicas[1], nil),
+				},
+			},
+			expResp: &SystemLeaderTransferResp{
+				OldLeader: replicas[0],
+				NewLeader: replicas[1],
+				Replicas:  replicas,
+			},
+		},
+		"new leader discovered": {
+			req: &SystemLeaderTransferReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemLeaderTransferResp{
+						OldLeader: replicas[0],
+						Replicas:  replicas,
+					}),
+					mockLeaderQueryResp(replicas[1], "", errors.New("unavailable")),
+					mockLeaderQueryResp(replicas[2], replicas[2], nil),
+				},
+			},
+			expResp: &SystemLeaderTransferResp{
+				OldLeader: replicas[0],
+				NewLeader: replicas[2],
+				Replicas:  replicas,
+			},
+		},
+		"new leader elected after retry": {
+			req: &SystemLeaderTransferReq{},
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemLeaderTransferResp{
+						OldLeader: replicas[0],
+						Replicas:  replicas[:2],
+					}),
+					mockLeaderQueryResp(replicas[1], replicas[0], nil),
+					mockLeaderQueryResp(replicas[1], replicas[1], nil),
+				},
+			},
+			expResp: &SystemLeaderTransferResp{
+				OldLeader: replicas[0],
+				NewLeader: replicas[1],
+				Replicas:  replicas[:2],
+			},
+		},
+		"no new leader": {
+			req:     &SystemLeaderTransferReq{},
+			timeout: 50 * time.Millisecond,
+			mic: &MockInvokerConfig{
+				UnaryResponseSet: []*UnaryResponse{
+					MockMSResponse("", nil, &mgmtpb.SystemLeaderTransferResp{
+						OldLeader: replicas[0],
+						Replicas:  replicas[:2],
+					}),
+				},
+				UnaryResponse: mockLeaderQueryResp(replicas[1], replicas[0], nil),
+			},
+			expErr: errors.New("waiting for new leader"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+			if tc.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tc.timeout)
+				defer cancel()
+			}
+
+			client := NewMockInvoker(log, tc.mic)
+			gotResp, gotErr := SystemLeaderTransfer(ctx, client, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			cmpOpts := []cmp.Option{
+				cmpopts.IgnoreFields(SystemLeaderTransferResp{}, "Elapsed"),
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, cmpOpts...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			test.AssertTrue(t, gotResp.Elapsed > 0, "elapsed time not set")
+		})
+	}
+}
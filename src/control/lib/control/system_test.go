@@ -1086,6 +1086,206 @@ func TestControl_SystemExclude(t *testing.T) {
 	}
 }
 
+func TestControl_SystemDrain(t *testing.T) {
+	drainReq := func(reint bool) *SystemDrainReq {
+		req := &SystemDrainReq{Reint: reint}
+		req.Ranks.Replace(ranklist.MustCreateRankSet("0-1"))
+		return req
+	}
+
+	for name, tc := range map[string]struct {
+		req       *SystemDrainReq
+		uErr      error
+		uResp     *UnaryResponse
+		expResp   *SystemDrainResp
+		expErr    error
+		expRspErr error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.SystemDrainReq request"),
+		},
+		"no ranks or hosts": {
+			req:    new(SystemDrainReq),
+			expErr: errors.New("no ranks or hosts"),
+		},
+		"local failure": {
+			req:    drainReq(false),
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    drainReq(false),
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"drain": {
+			req: drainReq(false),
+			uResp: MockMSResponse("10.0.0.1:10001", nil,
+				&mgmtpb.SystemDrainResp{
+					Results: []*mgmtpb.PoolRankResult{
+						{PoolId: "pool1", Ranks: "0-1"},
+						{PoolId: "pool2", Ranks: "1"},
+					},
+				},
+			),
+			expResp: &SystemDrainResp{
+				Results: PoolRankResults{
+					{PoolID: "pool1", Ranks: "0-1"},
+					{PoolID: "pool2", Ranks: "1"},
+				},
+			},
+		},
+		"reintegrate with failure": {
+			req: drainReq(true),
+			uResp: MockMSResponse("10.0.0.1:10001", nil,
+				&mgmtpb.SystemDrainResp{
+					Reint: true,
+					Results: []*mgmtpb.PoolRankResult{
+						{PoolId: "pool1", Ranks: "0"},
+						{
+							Status: -1,
+							Msg:    "fail",
+							PoolId: "pool1",
+							Ranks:  "1",
+						},
+					},
+				},
+			),
+			expResp: &SystemDrainResp{
+				Reint: true,
+				Results: PoolRankResults{
+					{PoolID: "pool1", Ranks: "0"},
+					{Status: -1, Msg: "fail", PoolID: "pool1", Ranks: "1"},
+				},
+			},
+			expRspErr: errors.New("pool pool1 ranks 1: fail"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := SystemDrain(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			test.CmpErr(t, tc.expRspErr, gotResp.Errors())
+		})
+	}
+}
+
+func TestControl_SystemMaintenance(t *testing.T) {
+	maintReq := func(action SystemMaintenanceAction) *SystemMaintenanceReq {
+		req := &SystemMaintenanceReq{Action: action}
+		req.Ranks.Replace(ranklist.MustCreateRankSet("0-1"))
+		return req
+	}
+
+	for name, tc := range map[string]struct {
+		req     *SystemMaintenanceReq
+		uErr    error
+		uResp   *UnaryResponse
+		expResp *SystemMaintenanceResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    nil,
+			expErr: errors.New("nil *control.SystemMaintenanceReq request"),
+		},
+		"start without ranks or hosts": {
+			req:    &SystemMaintenanceReq{Action: SystemMaintenanceStart},
+			expErr: errors.New("no ranks or hosts"),
+		},
+		"local failure": {
+			req:    maintReq(SystemMaintenanceStart),
+			uErr:   errors.New("local failed"),
+			expErr: errors.New("local failed"),
+		},
+		"remote failure": {
+			req:    maintReq(SystemMaintenanceFinish),
+			uResp:  MockMSResponse("host1", errors.New("remote failed"), nil),
+			expErr: errors.New("remote failed"),
+		},
+		"status of all ranks": {
+			req: new(SystemMaintenanceReq),
+			uResp: MockMSResponse("10.0.0.1:10001", nil,
+				&mgmtpb.SystemMaintenanceResp{
+					Members: []*mgmtpb.MaintenanceMember{
+						{
+							Rank:       1,
+							Addr:       "10.0.0.1:10001",
+							State:      "stopped",
+							Phase:      "serviceable",
+							Pools:      []string{test.MockUUID(1)},
+							LastUpdate: "2023-01-02T03:04:05Z",
+						},
+					},
+				},
+			),
+			expResp: &SystemMaintenanceResp{
+				Members: []*MaintenanceMember{
+					{
+						Rank:       1,
+						Addr:       "10.0.0.1:10001",
+						State:      "stopped",
+						Phase:      "serviceable",
+						Pools:      []string{test.MockUUID(1)},
+						LastUpdate: "2023-01-02T03:04:05Z",
+					},
+				},
+			},
+		},
+		"start": {
+			req: maintReq(SystemMaintenanceStart),
+			uResp: MockMSResponse("10.0.0.1:10001", nil,
+				&mgmtpb.SystemMaintenanceResp{
+					Members: []*mgmtpb.MaintenanceMember{
+						{Rank: 0, State: "joined", Phase: "draining"},
+						{Rank: 1, State: "joined", Phase: "draining", Error: "busy"},
+					},
+				},
+			),
+			expResp: &SystemMaintenanceResp{
+				Members: []*MaintenanceMember{
+					{Rank: 0, State: "joined", Phase: "draining"},
+					{Rank: 1, State: "joined", Phase: "draining", Error: "busy"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			mi := NewMockInvoker(log, &MockInvokerConfig{
+				UnaryError:    tc.uErr,
+				UnaryResponse: tc.uResp,
+			})
+
+			gotResp, gotErr := SystemMaintenance(test.Context(t), mi, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestDmg_System_checkSystemErase(t *testing.T) {
 	for name, tc := range map[string]struct {
 		uErr, expErr error
@@ -19,6 +19,20 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 )
 
+// mgmtPoolPropertyMin is the first pool property number reserved for
+// properties that are handled by the management service. These are kept
+// well clear of the engine's property numbers.
+const mgmtPoolPropertyMin = 1 << 16
+
+const (
+	// PoolPropertySpaceWarn is the percentage of used pool space at which
+	// a pool space warning event is raised.
+	PoolPropertySpaceWarn = mgmtPoolPropertyMin + iota
+	// PoolPropertySpaceCrit is the percentage of used pool space at which
+	// a pool space critical event is raised.
+	PoolPropertySpaceCrit
+)
+
 func numericMarshaler(v *PoolPropertyValue) ([]byte, error) {
 	n, err := v.GetNumber()
 	if err != nil {
@@ -419,7 +433,58 @@ func PoolProperties() PoolPropertyMap {
 				"no_data_sync": PoolReintModeNoDataSync,
 			},
 		},
+		"space_warn": {
+			Property: PoolProperty{
+				Number:         PoolPropertySpaceWarn,
+				Description:    "Used space percentage at which a pool space warning is raised",
+				valueHandler:   spaceThresholdHandler("space_warn"),
+				valueStringer:  spaceThresholdStringer,
+				valueMarshaler: numericMarshaler,
+			},
+		},
+		"space_crit": {
+			Property: PoolProperty{
+				Number:         PoolPropertySpaceCrit,
+				Description:    "Used space percentage at which a critical pool space alert is raised",
+				valueHandler:   spaceThresholdHandler("space_crit"),
+				valueStringer:  spaceThresholdStringer,
+				valueMarshaler: numericMarshaler,
+			},
+		},
+	}
+}
+
+func spaceThresholdHandler(name string) func(string) (*PoolPropertyValue, error) {
+	return func(s string) (*PoolPropertyValue, error) {
+		if strings.ToLower(s) == "disabled" {
+			return &PoolPropertyValue{uint64(0)}, nil
+		}
+
+		thErr := errors.Errorf("invalid %s value %s (valid values: 0-100 or disabled)", name, s)
+		pct, err := strconv.ParseUint(strings.ReplaceAll(s, "%", ""), 10, 64)
+		if err != nil || pct > 100 {
+			return nil, thErr
+		}
+		return &PoolPropertyValue{pct}, nil
+	}
+}
+
+func spaceThresholdStringer(v *PoolPropertyValue) string {
+	n, err := v.GetNumber()
+	if err != nil {
+		return "not set"
 	}
+	if n == 0 {
+		return "disabled"
+	}
+	return fmt.Sprintf("%d%%", n)
+}
+
+// IsMgmtPoolProperty returns true if the supplied pool property number
+// identifies a property that is stored by the management service rather
+// than by the engine.
+func IsMgmtPoolProperty(number uint32) bool {
+	return number >= mgmtPoolPropertyMin
 }
 
 func PoolDeprecatedProperties() map[string]string {
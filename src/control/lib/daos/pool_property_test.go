@@ -235,6 +235,23 @@ func TestControl_PoolProperties(t *testing.T) {
 			expStr:  "reintegration:data_sync",
 			expJson: []byte(`{"name":"reintegration","description":"Reintegration mode","value":"data_sync"}`),
 		},
+		"space_warn-valid": {
+			name:    "space_warn",
+			value:   "80%",
+			expStr:  "space_warn:80%",
+			expJson: []byte(`{"name":"space_warn","description":"Used space percentage at which a pool space warning is raised","value":80}`),
+		},
+		"space_warn-disabled": {
+			name:    "space_warn",
+			value:   "disabled",
+			expStr:  "space_warn:disabled",
+			expJson: []byte(`{"name":"space_warn","description":"Used space percentage at which a pool space warning is raised","value":0}`),
+		},
+		"space_crit-invalid": {
+			name:   "space_crit",
+			value:  "101",
+			expErr: errors.New("invalid space_crit value 101 (valid values: 0-100 or disabled)"),
+		},
 		"reintegration-invalid": {
 			name:   "reintegration",
 			value:  "bad mode",
@@ -303,6 +303,8 @@ func (sp SystemPropertyKey) String() string {
 		SystemPropertyDaosSystem:      "daos_system",
 		SystemPropertyPoolScrubMode:   "pool_scrub_mode",
 		SystemPropertyPoolScrubThresh: "pool_scrub_thresh",
+		SystemPropertyPoolSpaceWarn:   "pool_space_warn",
+		SystemPropertyPoolSpaceCrit:   "pool_space_crit",
 	}[sp]; found {
 		return str
 	}
@@ -342,6 +344,10 @@ const (
 	SystemPropertyPoolScrubMode
 	// SystemPropertyPoolScrubThresh sets or retrieves the scrubbing error threshold for each pool in the system.
 	SystemPropertyPoolScrubThresh
+	// SystemPropertyPoolSpaceWarn sets or retrieves the used space warning threshold for each pool in the system.
+	SystemPropertyPoolSpaceWarn
+	// SystemPropertyPoolSpaceCrit sets or retrieves the used space critical threshold for each pool in the system.
+	SystemPropertyPoolSpaceCrit
 	// NB: This must be the last entry.
 	systemPropertyMax
 )
@@ -504,5 +510,7 @@ func SystemProperties() SystemPropertyMap {
 		},
 		SystemPropertyPoolScrubThresh: pph2sp(SystemPropertyPoolScrubThresh, poolProps["scrub-thresh"], "0"),
 		SystemPropertyPoolScrubMode:   pph2sp(SystemPropertyPoolScrubMode, poolProps["scrub"], "off"),
+		SystemPropertyPoolSpaceWarn:   pph2sp(SystemPropertyPoolSpaceWarn, poolProps["space_warn"], "80"),
+		SystemPropertyPoolSpaceCrit:   pph2sp(SystemPropertyPoolSpaceCrit, poolProps["space_crit"], "95"),
 	}
 }
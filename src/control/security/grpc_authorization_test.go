@@ -76,7 +76,14 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 		"/mgmt.MgmtSvc/SystemErase":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemStart":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemExclude":          {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemDrain":            {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemMaintenance":      {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolCreate":             {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolCreatePlan":         {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolProfileCreate":      {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolProfileList":        {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolProfileDelete":      {ComponentAdmin},
+		"/mgmt.MgmtSvc/PoolTag":                {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolDestroy":            {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolQuery":              {ComponentAdmin},
 		"/mgmt.MgmtSvc/PoolQueryTarget":        {ComponentAdmin},
@@ -101,6 +108,16 @@ func TestSecurity_ComponentHasAccess(t *testing.T) {
 		"/mgmt.MgmtSvc/SystemGetAttr":          {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemSetProp":          {ComponentAdmin},
 		"/mgmt.MgmtSvc/SystemGetProp":          {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemEventsList":       {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemEventsWatch":      {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemAddReplica":       {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemRemoveReplica":    {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemListReplicas":     {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemLeaderTransfer":   {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemBackup":           {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemListBackups":      {ComponentAdmin},
+		"/mgmt.MgmtSvc/SystemAuditList":        {ComponentAdmin},
+		"/mgmt.MgmtSvc/SetReplicas":            {ComponentServer},
 		"/RaftTransport/AppendEntries":         {ComponentServer},
 		"/RaftTransport/AppendEntriesPipeline": {ComponentServer},
 		"/RaftTransport/RequestVote":           {ComponentServer},
@@ -0,0 +1,470 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pbUtil "github.com/daos-stack/daos/src/control/common/proto"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	auditLogFile = "daos_server_audit.log"
+
+	// defaultAuditLogMaxSize is the size in bytes at which the audit log
+	// is rotated if no size is configured.
+	defaultAuditLogMaxSize = 16 << 20
+	// defaultAuditLogMaxFiles is the number of rotated audit log files
+	// retained if no limit is configured.
+	defaultAuditLogMaxFiles = 5
+
+	// auditReqMaxLen is the maximum length of the sanitized request
+	// stored in an audit record.
+	auditReqMaxLen = 1024
+	auditRedacted  = "<redacted>"
+
+	auditCallerUnknown = "unauthenticated"
+
+	// auditBatchInterval is the longest time for which summaries of audited
+	// requests are held before being stored in the system database.
+	auditBatchInterval = time.Second
+	// auditBatchMaxRecords is the number of held summaries at which they
+	// are stored without waiting for the batch interval.
+	auditBatchMaxRecords = 64
+	// auditMaxPending is the maximum number of summaries held while they
+	// can't be stored, beyond which the oldest are discarded.
+	auditMaxPending = 1024
+)
+
+// auditExemptMethods are the MgmtSvc and CtlSvc methods which do not modify
+// system state, or which are used internally at high frequency, and are
+// therefore not audited. Any method not listed here is audited.
+var auditExemptMethods = map[string]struct{}{
+	"/ctl.CtlSvc/StorageScan":          {},
+	"/ctl.CtlSvc/NetworkScan":          {},
+	"/ctl.CtlSvc/FirmwareQuery":        {},
+	"/ctl.CtlSvc/SmdQuery":             {},
+	"/ctl.CtlSvc/CollectLog":           {},
+	"/mgmt.MgmtSvc/ClusterEvent":       {},
+	"/mgmt.MgmtSvc/LeaderQuery":        {},
+	"/mgmt.MgmtSvc/PoolCreatePlan":     {},
+	"/mgmt.MgmtSvc/PoolProfileList":    {},
+	"/mgmt.MgmtSvc/PoolQuery":          {},
+	"/mgmt.MgmtSvc/PoolQueryTarget":    {},
+	"/mgmt.MgmtSvc/PoolGetProp":        {},
+	"/mgmt.MgmtSvc/PoolGetACL":         {},
+	"/mgmt.MgmtSvc/GetAttachInfo":      {},
+	"/mgmt.MgmtSvc/ListPools":          {},
+	"/mgmt.MgmtSvc/ListContainers":     {},
+	"/mgmt.MgmtSvc/SystemQuery":        {},
+	"/mgmt.MgmtSvc/SystemGetAttr":      {},
+	"/mgmt.MgmtSvc/SystemGetProp":      {},
+	"/mgmt.MgmtSvc/SystemEventsList":   {},
+	"/mgmt.MgmtSvc/SystemEventsWatch":  {},
+	"/mgmt.MgmtSvc/SystemListReplicas": {},
+	"/mgmt.MgmtSvc/SystemListBackups":  {},
+	"/mgmt.MgmtSvc/SystemAuditList":    {},
+}
+
+// auditRedactFields matches the names of request fields whose values must
+// never be written to the audit log.
+var auditRedactFields = regexp.MustCompile(`(?i)(passw|secret|token|cred|auth)`)
+
+// auditRedactMapValues lists the request fields containing user-defined
+// key/value data, the values of which are not written to the audit log.
+var auditRedactMapValues = map[protoreflect.Name]struct{}{
+	"attributes": {},
+}
+
+func isMgmtMethod(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/mgmt.MgmtSvc/")
+}
+
+// isAuditedMethod returns true if calls to the supplied gRPC method should
+// be recorded in the audit log.
+func isAuditedMethod(fullMethod string) bool {
+	if !isMgmtMethod(fullMethod) && !strings.HasPrefix(fullMethod, "/ctl.CtlSvc/") {
+		return false
+	}
+	_, exempt := auditExemptMethods[fullMethod]
+	return !exempt
+}
+
+// redactMessage clears or replaces sensitive values in the supplied message.
+func redactMessage(msg protoreflect.Message) {
+	var clear, redact []protoreflect.FieldDescriptor
+
+	msg.Range(func(fd protoreflect.FieldDescriptor, val protoreflect.Value) bool {
+		isMsg := fd.Kind() == protoreflect.MessageKind || fd.Kind() == protoreflect.GroupKind
+		sensitive := auditRedactFields.MatchString(string(fd.Name()))
+
+		switch {
+		case fd.IsMap():
+			_, userData := auditRedactMapValues[fd.Name()]
+			m := val.Map()
+			m.Range(func(k protoreflect.MapKey, v protoreflect.Value) bool {
+				switch fd.MapValue().Kind() {
+				case protoreflect.StringKind:
+					if sensitive || userData {
+						m.Set(k, protoreflect.ValueOfString(auditRedacted))
+					}
+				case protoreflect.BytesKind:
+					m.Set(k, protoreflect.ValueOfBytes(nil))
+				case protoreflect.MessageKind:
+					redactMessage(v.Message())
+				}
+				return true
+			})
+		case fd.IsList():
+			list := val.List()
+			for i := 0; i < list.Len(); i++ {
+				switch {
+				case isMsg:
+					redactMessage(list.Get(i).Message())
+				case fd.Kind() == protoreflect.StringKind && sensitive:
+					list.Set(i, protoreflect.ValueOfString(auditRedacted))
+				case fd.Kind() == protoreflect.BytesKind:
+					list.Set(i, protoreflect.ValueOfBytes(nil))
+				}
+			}
+		case fd.Kind() == protoreflect.BytesKind, isMsg && sensitive:
+			clear = append(clear, fd)
+		case isMsg:
+			redactMessage(val.Message())
+		case fd.Kind() == protoreflect.StringKind && sensitive:
+			redact = append(redact, fd)
+		}
+		return true
+	})
+
+	for _, fd := range clear {
+		msg.Clear(fd)
+	}
+	for _, fd := range redact {
+		msg.Set(fd, protoreflect.ValueOfString(auditRedacted))
+	}
+}
+
+// sanitizeAuditReq returns a compact representation of the supplied request
+// with sensitive values removed, suitable for inclusion in an audit record.
+func sanitizeAuditReq(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok || msg == nil {
+		return fmt.Sprintf("%T", req)
+	}
+
+	cpy := proto.Clone(msg)
+	redactMessage(cpy.ProtoReflect())
+
+	buf, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(cpy)
+	if err != nil {
+		return fmt.Sprintf("%T", req)
+	}
+	// The protojson output is deliberately unstable in whitespace, so
+	// normalize it to make the records easier to search.
+	var cmp bytes.Buffer
+	if err := json.Compact(&cmp, buf); err == nil {
+		buf = cmp.Bytes()
+	}
+	str := string(buf)
+	if len(str) > auditReqMaxLen {
+		str = str[:auditReqMaxLen] + "..."
+	}
+
+	return str
+}
+
+// callerFromContext returns the identity of the caller from its certificate
+// and the caller's network address.
+func callerFromContext(ctx context.Context) (caller, addr string) {
+	caller = auditCallerUnknown
+	if comp, err := componentFromContext(ctx); err == nil {
+		caller = comp.String()
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		addr = p.Addr.String()
+	}
+
+	return
+}
+
+// newAuditRecord creates an audit record for a completed request.
+func newAuditRecord(ctx context.Context, host, fullMethod string, req interface{}, start time.Time, elapsed time.Duration, err error) *system.AuditRecord {
+	rec := &system.AuditRecord{
+		Timestamp: start,
+		Host:      host,
+		Method:    fullMethod,
+		Request:   sanitizeAuditReq(req),
+		Status:    system.AuditStatusOK,
+		Duration:  elapsed,
+	}
+	rec.Caller, rec.Peer = callerFromContext(ctx)
+
+	if err != nil {
+		rec.Status = codes.Unknown.String()
+		if st, ok := status.FromError(err); ok {
+			rec.Status = st.Code().String()
+			err = pbUtil.UnwrapError(st)
+		}
+		rec.Error = err.Error()
+	}
+
+	return rec
+}
+
+// auditLog is an append-only log of audit records stored as lines of JSON,
+// which is rotated when it reaches its maximum size.
+type auditLog struct {
+	sync.Mutex
+	path     string
+	maxSize  uint64
+	maxFiles int
+	file     *os.File
+	size     uint64
+}
+
+func newAuditLog(path string, maxSize uint64, maxFiles int) *auditLog {
+	if maxSize == 0 {
+		maxSize = defaultAuditLogMaxSize
+	}
+	if maxFiles == 0 {
+		maxFiles = defaultAuditLogMaxFiles
+	}
+
+	return &auditLog{
+		path:     path,
+		maxSize:  maxSize,
+		maxFiles: maxFiles,
+	}
+}
+
+func (al *auditLog) open() error {
+	f, err := os.OpenFile(al.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		return errors.Wrap(err, "failed to open audit log")
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return errors.Wrap(err, "failed to stat audit log")
+	}
+
+	al.file = f
+	al.size = uint64(fi.Size())
+	return nil
+}
+
+func (al *auditLog) rotatedPath(idx int) string {
+	return fmt.Sprintf("%s.%d", al.path, idx)
+}
+
+// rotate closes the current log file and renames it, shifting the existing
+// rotated files and discarding the oldest.
+func (al *auditLog) rotate() error {
+	if al.file != nil {
+		if err := al.file.Close(); err != nil {
+			return errors.Wrap(err, "failed to close audit log")
+		}
+		al.file = nil
+	}
+
+	for idx := al.maxFiles - 1; idx > 0; idx-- {
+		if err := os.Rename(al.rotatedPath(idx), al.rotatedPath(idx+1)); err != nil && !os.IsNotExist(err) {
+			return errors.Wrap(err, "failed to rotate audit log")
+		}
+	}
+	if err := os.Rename(al.path, al.rotatedPath(1)); err != nil && !os.IsNotExist(err) {
+		return errors.Wrap(err, "failed to rotate audit log")
+	}
+
+	return al.open()
+}
+
+// Write appends the supplied record to the log.
+func (al *auditLog) Write(rec *system.AuditRecord) error {
+	buf, err := json.Marshal(rec)
+	if err != nil {
+		return err
+	}
+	buf = append(buf, '\n')
+
+	al.Lock()
+	defer al.Unlock()
+
+	if al.file == nil {
+		if err := al.open(); err != nil {
+			return err
+		}
+	}
+	if al.size > 0 && al.size+uint64(len(buf)) > al.maxSize {
+		if err := al.rotate(); err != nil {
+			return err
+		}
+	}
+
+	n, err := al.file.Write(buf)
+	al.size += uint64(n)
+	return err
+}
+
+// Close closes the log file.
+func (al *auditLog) Close() error {
+	al.Lock()
+	defer al.Unlock()
+
+	if al.file == nil {
+		return nil
+	}
+	err := al.file.Close()
+	al.file = nil
+	return err
+}
+
+// auditRecorder is implemented by the system database in order to store
+// replicated summaries of audited requests.
+type auditRecorder interface {
+	IsLeader() bool
+	AddAuditRecords([]*system.AuditRecord) error
+}
+
+// auditor records mutating control plane requests handled by this server.
+//
+// Each record is written to the local audit log before the request returns.
+// Summaries of requests handled by the MS leader are also stored in the system
+// database, but storing each one as it is recorded would add a raft update to
+// every mutating request. Summaries are instead held and stored in batches,
+// so that the cost is at most one update per batch interval; summaries that
+// can't be stored are still available from the local audit log.
+type auditor struct {
+	log      logging.Logger
+	hostname string
+	auditLog *auditLog
+	recorder auditRecorder
+
+	pendingLock sync.Mutex
+	pending     []*system.AuditRecord
+	dropped     int
+	flushReady  chan struct{}
+}
+
+func newAuditor(log logging.Logger, hostname string, al *auditLog, recorder auditRecorder) *auditor {
+	return &auditor{
+		log:        log,
+		hostname:   hostname,
+		auditLog:   al,
+		recorder:   recorder,
+		flushReady: make(chan struct{}, 1),
+	}
+}
+
+// record writes the supplied record to the local audit log and, if the
+// request was handled by the MS leader, holds it to be stored in the system
+// database with the next batch.
+func (a *auditor) record(rec *system.AuditRecord) {
+	if a.auditLog != nil {
+		if err := a.auditLog.Write(rec); err != nil {
+			a.log.Errorf("failed to write audit record for %s: %s", rec.Method, err)
+		}
+	}
+
+	if a.recorder == nil || !isMgmtMethod(rec.Method) || !a.recorder.IsLeader() {
+		return
+	}
+
+	a.pendingLock.Lock()
+	defer a.pendingLock.Unlock()
+
+	if len(a.pending) >= auditMaxPending {
+		a.pending = a.pending[1:]
+		a.dropped++
+	}
+	a.pending = append(a.pending, rec)
+	if len(a.pending) >= auditBatchMaxRecords {
+		select {
+		case a.flushReady <- struct{}{}:
+		default:
+		}
+	}
+}
+
+// flush stores the held summaries in the system database.
+func (a *auditor) flush() {
+	a.pendingLock.Lock()
+	recs, dropped := a.pending, a.dropped
+	a.pending, a.dropped = nil, 0
+	a.pendingLock.Unlock()
+
+	if dropped > 0 {
+		a.log.Errorf("discarded %d audit record summaries before they could be stored", dropped)
+	}
+	if len(recs) == 0 {
+		return
+	}
+
+	if err := a.recorder.AddAuditRecords(recs); err != nil && !system.IsNotLeader(err) {
+		a.log.Errorf("failed to store %d audit record summaries: %s", len(recs), err)
+	}
+}
+
+// start stores the held summaries in the system database at each batch
+// interval, or sooner if a batch fills up, until the context is canceled.
+func (a *auditor) start(ctx context.Context) {
+	if a.recorder == nil {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(auditBatchInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			case <-a.flushReady:
+			}
+			a.flush()
+		}
+	}()
+}
+
+// unaryAuditInterceptor generates a grpc.UnaryServerInterceptor that records
+// mutating requests, including those which are rejected by other interceptors.
+func unaryAuditInterceptor(a *auditor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !isAuditedMethod(info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		start := time.Now()
+		res, err := handler(ctx, req)
+		elapsed := time.Since(start)
+		a.record(newAuditRecord(ctx, a.hostname, info.FullMethod, req, start, elapsed, err))
+
+		return res, err
+	}
+}
@@ -0,0 +1,385 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/security/auth"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_isAuditedMethod(t *testing.T) {
+	for method, expAudited := range map[string]bool{
+		"/mgmt.MgmtSvc/PoolDestroy":          true,
+		"/mgmt.MgmtSvc/SystemExclude":        true,
+		"/ctl.CtlSvc/StorageFormat":          true,
+		"/mgmt.MgmtSvc/PoolQuery":            false,
+		"/mgmt.MgmtSvc/SystemAuditList":      false,
+		"/ctl.CtlSvc/StorageScan":            false,
+		"/RaftTransport/AppendEntries":       false,
+		"/grpc.health.v1.Health/Check":       false,
+		"/mgmt.MgmtSvc/SomeFutureMutatingOp": true,
+	} {
+		t.Run(method, func(t *testing.T) {
+			test.AssertEqual(t, expAudited, isAuditedMethod(method), "unexpected result")
+		})
+	}
+}
+
+func TestServer_sanitizeAuditReq(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req        interface{}
+		expContain []string
+		expOmit    []string
+	}{
+		"non-proto request": {
+			req:        "foo",
+			expContain: []string{"string"},
+		},
+		"pool destroy": {
+			req: &mgmtpb.PoolDestroyReq{
+				Sys:   "daos_server",
+				Id:    "pool1",
+				Force: true,
+			},
+			expContain: []string{`"id":"pool1"`, `"force":true`},
+		},
+		"attribute values redacted": {
+			req: &mgmtpb.SystemSetAttrReq{
+				Attributes: map[string]string{"api-key": "s3kr1t"},
+			},
+			expContain: []string{`"api-key":"` + auditRedacted + `"`},
+			expOmit:    []string{"s3kr1t"},
+		},
+		"credential redacted": {
+			req: &auth.Credential{
+				Token:    &auth.Token{Flavor: auth.Flavor_AUTH_SYS, Data: []byte("tokendata")},
+				Verifier: &auth.Token{Flavor: auth.Flavor_AUTH_SYS, Data: []byte("verifierdata")},
+				Origin:   "agent",
+			},
+			expContain: []string{`"origin":"agent"`, `"verifier":{"flavor":"AUTH_SYS"}`},
+			expOmit:    []string{`"token"`, "dG9rZW5kYXRh", "dmVyaWZpZXJkYXRh"},
+		},
+		"truncated": {
+			req: &mgmtpb.PoolDestroyReq{
+				Id: strings.Repeat("x", auditReqMaxLen*2),
+			},
+			expContain: []string{"..."},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			got := sanitizeAuditReq(tc.req)
+			for _, exp := range tc.expContain {
+				if !strings.Contains(got, exp) {
+					t.Fatalf("expected %q to contain %q", got, exp)
+				}
+			}
+			for _, exp := range tc.expOmit {
+				if strings.Contains(got, exp) {
+					t.Fatalf("expected %q not to contain %q", got, exp)
+				}
+			}
+			if len(got) > auditReqMaxLen+len("...") {
+				t.Fatalf("sanitized request not truncated (%d bytes)", len(got))
+			}
+		})
+	}
+}
+
+func readAuditLog(t *testing.T, path string) []*system.AuditRecord {
+	t.Helper()
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var recs []*system.AuditRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		rec := new(system.AuditRecord)
+		if err := json.Unmarshal(scanner.Bytes(), rec); err != nil {
+			t.Fatal(err)
+		}
+		recs = append(recs, rec)
+	}
+
+	return recs
+}
+
+func TestServer_auditLog_Rotate(t *testing.T) {
+	tmpDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	path := filepath.Join(tmpDir, auditLogFile)
+	rec := &system.AuditRecord{
+		Sequence: 1,
+		Method:   "/mgmt.MgmtSvc/PoolDestroy",
+		Status:   system.AuditStatusOK,
+	}
+	// Measure with a single digit sequence number, as used by all of the
+	// records written below, so that the records are all the same size.
+	recLen, err := json.Marshal(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Each file holds two records, and two rotated files are kept.
+	al := newAuditLog(path, uint64(2*(len(recLen)+1)), 2)
+	defer al.Close()
+
+	for i := 0; i < 7; i++ {
+		rec.Sequence = uint64(i + 1)
+		if err := al.Write(rec); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for logPath, expSeqs := range map[string][]uint64{
+		path:              {7},
+		al.rotatedPath(1): {5, 6},
+		al.rotatedPath(2): {3, 4},
+		al.rotatedPath(3): nil,
+	} {
+		if expSeqs == nil {
+			if _, err := os.Stat(logPath); !os.IsNotExist(err) {
+				t.Fatalf("expected %s to have been discarded", logPath)
+			}
+			continue
+		}
+
+		var gotSeqs []uint64
+		for _, rec := range readAuditLog(t, logPath) {
+			gotSeqs = append(gotSeqs, rec.Sequence)
+		}
+		if diff := cmp.Diff(expSeqs, gotSeqs); diff != "" {
+			t.Fatalf("unexpected records in %s (-want, +got):\n%s\n", logPath, diff)
+		}
+	}
+
+	fi, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, os.FileMode(0600), fi.Mode().Perm(), "unexpected audit log permissions")
+}
+
+type mockAuditRecorder struct {
+	sync.Mutex
+	leader  bool
+	addErr  error
+	batches int
+	records []*system.AuditRecord
+}
+
+func (mar *mockAuditRecorder) IsLeader() bool {
+	return mar.leader
+}
+
+func (mar *mockAuditRecorder) AddAuditRecords(recs []*system.AuditRecord) error {
+	mar.Lock()
+	defer mar.Unlock()
+
+	if mar.addErr != nil {
+		return mar.addErr
+	}
+	mar.batches++
+	mar.records = append(mar.records, recs...)
+	return nil
+}
+
+func TestServer_unaryAuditInterceptor(t *testing.T) {
+	for name, tc := range map[string]struct {
+		method      string
+		notLeader   bool
+		addErr      error
+		handlerErr  error
+		expLogged   bool
+		expStored   bool
+		expStatus   string
+		expErrorMsg string
+	}{
+		"exempt method": {
+			method: "/mgmt.MgmtSvc/PoolQuery",
+		},
+		"mgmt method on leader": {
+			method:    "/mgmt.MgmtSvc/PoolDestroy",
+			expLogged: true,
+			expStored: true,
+			expStatus: system.AuditStatusOK,
+		},
+		"mgmt method on non-leader": {
+			method:    "/mgmt.MgmtSvc/PoolDestroy",
+			notLeader: true,
+			expLogged: true,
+			expStatus: system.AuditStatusOK,
+		},
+		"ctl method": {
+			method:    "/ctl.CtlSvc/StorageFormat",
+			expLogged: true,
+			expStatus: system.AuditStatusOK,
+		},
+		"failed request": {
+			method:      "/mgmt.MgmtSvc/SystemExclude",
+			handlerErr:  errors.New("exclude failed"),
+			expLogged:   true,
+			expStored:   true,
+			expStatus:   "Unknown",
+			expErrorMsg: "exclude failed",
+		},
+		"store failure does not fail request": {
+			method:    "/mgmt.MgmtSvc/PoolDestroy",
+			addErr:    errors.New("raft failed"),
+			expLogged: true,
+			expStatus: system.AuditStatusOK,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			path := filepath.Join(tmpDir, auditLogFile)
+			recorder := &mockAuditRecorder{
+				leader: !tc.notLeader,
+				addErr: tc.addErr,
+			}
+			aud := newAuditor(log, "host1", newAuditLog(path, 0, 0), recorder)
+			defer aud.auditLog.Close()
+
+			req := &mgmtpb.PoolDestroyReq{Id: "pool1"}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "resp", tc.handlerErr
+			}
+			ctx := newTestAuthCtx(test.Context(t), "admin")
+
+			gotResp, gotErr := unaryAuditInterceptor(aud)(ctx, req,
+				&grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+			test.CmpErr(t, tc.handlerErr, gotErr)
+			test.AssertEqual(t, "resp", gotResp, "unexpected response")
+
+			if !tc.expLogged {
+				if _, err := os.Stat(path); !os.IsNotExist(err) {
+					t.Fatal("unexpected audit log")
+				}
+				return
+			}
+
+			recs := readAuditLog(t, path)
+			if len(recs) != 1 {
+				t.Fatalf("expected 1 audit record, got %d", len(recs))
+			}
+			rec := recs[0]
+			test.AssertEqual(t, tc.method, rec.Method, "unexpected method")
+			test.AssertEqual(t, "host1", rec.Host, "unexpected host")
+			test.AssertEqual(t, "admin", rec.Caller, "unexpected caller")
+			test.AssertEqual(t, common.LocalhostCtrlAddr().String(), rec.Peer, "unexpected peer")
+			test.AssertEqual(t, tc.expStatus, rec.Status, "unexpected status")
+			test.AssertEqual(t, tc.expErrorMsg, rec.Error, "unexpected error")
+			if !strings.Contains(rec.Request, "pool1") {
+				t.Fatalf("unexpected request %q", rec.Request)
+			}
+			if rec.Timestamp.IsZero() || time.Since(rec.Timestamp) > time.Minute {
+				t.Fatalf("unexpected timestamp %s", rec.Timestamp)
+			}
+
+			// Summaries are only stored when the held batch is flushed.
+			test.AssertEqual(t, 0, len(recorder.records), "unexpected records stored before flush")
+			aud.flush()
+
+			var expStored []*system.AuditRecord
+			if tc.expStored {
+				expStored = recs
+			}
+			if diff := cmp.Diff(expStored, recorder.records); diff != "" {
+				t.Fatalf("unexpected stored records (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_auditor_batch(t *testing.T) {
+	for name, tc := range map[string]struct {
+		numRecs    int
+		expBatches int
+		expStored  int
+	}{
+		"full batch stored without waiting for interval": {
+			numRecs:    auditBatchMaxRecords,
+			expBatches: 1,
+			expStored:  auditBatchMaxRecords,
+		},
+		"oldest discarded when too many held": {
+			numRecs:    auditMaxPending + 10,
+			expBatches: 1,
+			expStored:  auditMaxPending,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			recorder := &mockAuditRecorder{leader: true}
+			aud := newAuditor(log, "host1", nil, recorder)
+
+			// Hold the records before starting the flush loop so that
+			// they are all stored in a single batch.
+			for i := 0; i < tc.numRecs; i++ {
+				aud.record(&system.AuditRecord{
+					Method:   "/mgmt.MgmtSvc/PoolDestroy",
+					Sequence: uint64(i + 1),
+				})
+			}
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			defer cancel()
+			aud.start(ctx)
+
+			// A full batch is flushed well before the batch interval.
+			deadline := time.Now().Add(auditBatchInterval / 2)
+			for {
+				recorder.Lock()
+				batches := recorder.batches
+				recorder.Unlock()
+				if batches > 0 {
+					break
+				}
+				if time.Now().After(deadline) {
+					t.Fatal("held records not stored")
+				}
+				time.Sleep(time.Millisecond)
+			}
+
+			recorder.Lock()
+			defer recorder.Unlock()
+			test.AssertEqual(t, tc.expBatches, recorder.batches, "unexpected number of batches")
+			test.AssertEqual(t, tc.expStored, len(recorder.records), "unexpected number of stored records")
+			test.AssertEqual(t, uint64(tc.numRecs), recorder.records[len(recorder.records)-1].Sequence,
+				"newest record not stored")
+		})
+	}
+}
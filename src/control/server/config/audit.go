@@ -0,0 +1,42 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"path/filepath"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+)
+
+// AuditLogConfig defines parameters for the local log of mutating control
+// plane requests handled by this server. If no path is set, the log is
+// stored in the control plane metadata directory or alongside the control
+// plane log file.
+type AuditLogConfig struct {
+	Disabled   bool   `yaml:"disabled,omitempty"`
+	Path       string `yaml:"path,omitempty"`
+	MaxSizeMiB uint64 `yaml:"max_size_mib,omitempty"`
+	MaxFiles   int    `yaml:"max_files,omitempty"`
+}
+
+// MaxSizeBytes returns the size at which the audit log is rotated in bytes.
+func (alc AuditLogConfig) MaxSizeBytes() uint64 {
+	return alc.MaxSizeMiB * humanize.MiByte
+}
+
+// Validate checks the audit log configuration for obvious errors.
+func (alc *AuditLogConfig) Validate() error {
+	switch {
+	case alc.Path != "" && !filepath.IsAbs(alc.Path):
+		return errors.Errorf("path %q must be absolute", alc.Path)
+	case alc.MaxFiles < 0:
+		return errors.New("max_files must not be negative")
+	}
+
+	return nil
+}
@@ -0,0 +1,73 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/dustin/go-humanize"
+	"github.com/pkg/errors"
+)
+
+// EventJournalConfig defines parameters for the persistent RAS event journal
+// maintained by the management service leader.
+type EventJournalConfig struct {
+	Disabled   bool          `yaml:"disabled,omitempty"`
+	MaxAge     time.Duration `yaml:"max_age,omitempty"`
+	MaxSizeMiB uint64        `yaml:"max_size_mib,omitempty"`
+}
+
+// MaxSizeBytes returns the journal size limit in bytes.
+func (ejc EventJournalConfig) MaxSizeBytes() uint64 {
+	return ejc.MaxSizeMiB * humanize.MiByte
+}
+
+// EventSinkConfig defines a set of HTTP endpoints to which RAS events raised
+// on this server are delivered, along with the events to be delivered.
+type EventSinkConfig struct {
+	Name          string            `yaml:"name,omitempty"`
+	URLs          []string          `yaml:"urls"`
+	Events        []string          `yaml:"events,omitempty"`
+	MinSeverity   string            `yaml:"min_severity,omitempty"`
+	Headers       map[string]string `yaml:"headers,omitempty"`
+	BatchSize     int               `yaml:"batch_size,omitempty"`
+	BatchInterval time.Duration     `yaml:"batch_interval,omitempty"`
+	Timeout       time.Duration     `yaml:"timeout,omitempty"`
+	MaxRetries    int               `yaml:"max_retries,omitempty"`
+	RetryInterval time.Duration     `yaml:"retry_interval,omitempty"`
+	SpoolSize     int               `yaml:"spool_size,omitempty"`
+}
+
+// Validate checks the event sink configuration for obvious errors.
+func (esc *EventSinkConfig) Validate() error {
+	if len(esc.URLs) == 0 {
+		return errors.New("no urls specified")
+	}
+	for _, u := range esc.URLs {
+		parsed, err := url.Parse(u)
+		if err != nil {
+			return errors.Wrapf(err, "invalid url %q", u)
+		}
+		if parsed.Scheme != "http" && parsed.Scheme != "https" {
+			return errors.Errorf("invalid url %q: scheme must be http or https", u)
+		}
+	}
+
+	switch {
+	case esc.BatchSize < 0:
+		return errors.New("batch_size must not be negative")
+	case esc.MaxRetries < 0:
+		return errors.New("max_retries must not be negative")
+	case esc.SpoolSize < 0:
+		return errors.New("spool_size must not be negative")
+	case esc.BatchInterval < 0, esc.Timeout < 0, esc.RetryInterval < 0:
+		return errors.New("durations must not be negative")
+	}
+
+	return nil
+}
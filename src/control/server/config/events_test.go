@@ -0,0 +1,58 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestConfig_EventSinkConfig_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		cfg    EventSinkConfig
+		expErr error
+	}{
+		"no urls": {
+			expErr: errors.New("no urls"),
+		},
+		"unsupported scheme": {
+			cfg:    EventSinkConfig{URLs: []string{"ftp://host/hook"}},
+			expErr: errors.New("scheme must be http or https"),
+		},
+		"negative batch size": {
+			cfg: EventSinkConfig{
+				URLs:      []string{"http://host/hook"},
+				BatchSize: -1,
+			},
+			expErr: errors.New("batch_size"),
+		},
+		"negative duration": {
+			cfg: EventSinkConfig{
+				URLs:          []string{"http://host/hook"},
+				RetryInterval: -time.Second,
+			},
+			expErr: errors.New("durations"),
+		},
+		"valid": {
+			cfg: EventSinkConfig{
+				URLs:          []string{"http://host1/hook", "https://host2:8443/hook"},
+				Events:        []string{"engine_died"},
+				MinSeverity:   "error",
+				BatchSize:     4,
+				BatchInterval: time.Second,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
+		})
+	}
+}
@@ -0,0 +1,68 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package config
+
+import (
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// DefaultMSBackupInterval is the default interval between scheduled
+	// backups of the management service database.
+	DefaultMSBackupInterval = 24 * time.Hour
+	// DefaultMSBackupRetain is the default number of backups to retain.
+	DefaultMSBackupRetain = 7
+)
+
+// MSBackupConfig defines parameters for scheduled backups of the management
+// service database taken by the MS leader. Backups are disabled unless a
+// path is set.
+type MSBackupConfig struct {
+	Path     string        `yaml:"path,omitempty"`
+	Interval time.Duration `yaml:"interval,omitempty"`
+	Retain   int           `yaml:"retain,omitempty"`
+}
+
+// Enabled returns true if backups have been configured.
+func (mbc MSBackupConfig) Enabled() bool {
+	return mbc.Path != ""
+}
+
+// GetInterval returns the configured backup interval, or the default.
+func (mbc MSBackupConfig) GetInterval() time.Duration {
+	if mbc.Interval == 0 {
+		return DefaultMSBackupInterval
+	}
+	return mbc.Interval
+}
+
+// GetRetain returns the configured number of backups to retain, or the default.
+func (mbc MSBackupConfig) GetRetain() int {
+	if mbc.Retain == 0 {
+		return DefaultMSBackupRetain
+	}
+	return mbc.Retain
+}
+
+// Validate checks the backup configuration for obvious errors.
+func (mbc *MSBackupConfig) Validate() error {
+	switch {
+	case mbc.Path != "" && !filepath.IsAbs(mbc.Path):
+		return errors.Errorf("path %q must be absolute", mbc.Path)
+	case mbc.Interval < 0:
+		return errors.New("interval must not be negative")
+	case mbc.Interval > 0 && mbc.Interval < time.Minute:
+		return errors.New("interval must be at least 1m")
+	case mbc.Retain < 0:
+		return errors.New("retain must not be negative")
+	}
+
+	return nil
+}
@@ -70,6 +70,12 @@ type Server struct {
 
 	Metadata storage.ControlMetadata `yaml:"control_metadata,omitempty"`
 
+	EventJournal EventJournalConfig `yaml:"event_journal,omitempty"`
+	EventSinks   []EventSinkConfig  `yaml:"event_sinks,omitempty"`
+
+	MSBackup MSBackupConfig `yaml:"ms_backup,omitempty"`
+	AuditLog AuditLogConfig `yaml:"audit_log,omitempty"`
+
 	// unused (?)
 	FaultCb      string `yaml:"fault_cb"`
 	Hyperthreads bool   `yaml:"hyperthreads"`
@@ -314,6 +320,30 @@ func (cfg *Server) WithTelemetryPort(port int) *Server {
 	return cfg
 }
 
+// WithEventJournal sets the RAS event journal configuration.
+func (cfg *Server) WithEventJournal(ejc EventJournalConfig) *Server {
+	cfg.EventJournal = ejc
+	return cfg
+}
+
+// WithEventSinks sets the RAS event sink configurations.
+func (cfg *Server) WithEventSinks(sinks ...EventSinkConfig) *Server {
+	cfg.EventSinks = sinks
+	return cfg
+}
+
+// WithMSBackup sets the management service database backup configuration.
+func (cfg *Server) WithMSBackup(mbc MSBackupConfig) *Server {
+	cfg.MSBackup = mbc
+	return cfg
+}
+
+// WithAuditLog sets the audit log configuration.
+func (cfg *Server) WithAuditLog(alc AuditLogConfig) *Server {
+	cfg.AuditLog = alc
+	return cfg
+}
+
 // DefaultServer creates a new instance of configuration struct
 // populated with defaults.
 func DefaultServer() *Server {
@@ -676,6 +706,20 @@ func (cfg *Server) Validate(log logging.Logger) (err error) {
 		return FaultConfigSysRsvdZero
 	}
 
+	for idx, esc := range cfg.EventSinks {
+		if err := esc.Validate(); err != nil {
+			return errors.Wrapf(err, "event sink %d failed config validation", idx)
+		}
+	}
+
+	if err := cfg.MSBackup.Validate(); err != nil {
+		return errors.Wrap(err, "ms_backup failed config validation")
+	}
+
+	if err := cfg.AuditLog.Validate(); err != nil {
+		return errors.Wrap(err, "audit_log failed config validation")
+	}
+
 	// A config without engines is valid when initially discovering hardware prior to adding
 	// per-engine sections with device allocations.
 	if len(cfg.Engines) == 0 {
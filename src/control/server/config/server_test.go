@@ -17,6 +17,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/dustin/go-humanize"
 	"github.com/google/go-cmp/cmp"
@@ -90,8 +91,11 @@ func uncommentServerConfig(t *testing.T, outFile string) {
 	defer out.Close()
 
 	// Keep track of keys we've already seen in order
-	// to avoid writing duplicate parameters.
+	// to avoid writing duplicate parameters. Nested keys
+	// are tracked per top-level section so that the same
+	// param may appear in different sections.
 	seenKeys := make(map[string]struct{})
+	var section string
 
 	scn := bufio.NewScanner(in)
 	for scn.Scan() {
@@ -103,6 +107,11 @@ func uncommentServerConfig(t *testing.T, outFile string) {
 			continue
 		}
 		key := fields[0]
+		if !strings.HasPrefix(line, " ") && strings.HasSuffix(key, ":") {
+			section = key
+		} else {
+			key = section + key
+		}
 
 		// If we're in a server or a storage tier config, reset the
 		// seen map to allow the same params in different
@@ -246,6 +255,33 @@ func TestServerConfig_Constructed(t *testing.T) {
 		WithHelperLogFile("/tmp/daos_server_helper.log").
 		WithFirmwareHelperLogFile("/tmp/daos_firmware_helper.log").
 		WithTelemetryPort(9191).
+		WithEventJournal(EventJournalConfig{
+			MaxAge:     168 * time.Hour,
+			MaxSizeMiB: 32,
+		}).
+		WithEventSinks(EventSinkConfig{
+			Name:          "oncall",
+			URLs:          []string{"https://oncall.example.com/hooks/daos"},
+			Events:        []string{"engine_died", "system_stop_failed"},
+			MinSeverity:   "warning",
+			Headers:       map[string]string{"Authorization": "Bearer example-token"},
+			BatchSize:     8,
+			BatchInterval: 10 * time.Second,
+			Timeout:       5 * time.Second,
+			MaxRetries:    5,
+			RetryInterval: 2 * time.Second,
+			SpoolSize:     512,
+		}).
+		WithMSBackup(MSBackupConfig{
+			Path:     "/var/lib/daos/ms_backup",
+			Interval: 12 * time.Hour,
+			Retain:   14,
+		}).
+		WithAuditLog(AuditLogConfig{
+			Path:       "/var/log/daos/daos_server_audit.log",
+			MaxSizeMiB: 64,
+			MaxFiles:   10,
+		}).
 		WithSystemName("daos_server").
 		WithSocketDir("./.daos/daos_server").
 		WithFabricProvider("ofi+verbs;ofi_rxm").
@@ -287,6 +323,14 @@ func TestServerConfig_Constructed(t *testing.T) {
 			WithPinnedNumaNode(0).
 			WithBypassHealthChk(&bypass).
 			WithEnvVars("CRT_TIMEOUT=30").
+			WithRestartPolicy(engine.RestartPolicy{
+				Mode:          engine.RestartOnFailure,
+				MaxRetries:    3,
+				BackoffMin:    5 * time.Second,
+				BackoffMax:    5 * time.Minute,
+				FlapWindow:    10 * time.Minute,
+				FlapThreshold: 5,
+			}).
 			WithLogFile("/tmp/daos_engine.0.log").
 			WithLogMask("INFO").
 			WithStorageEnableHotplug(true).
@@ -183,6 +183,9 @@ func (svc *ControlService) StopRanks(ctx context.Context, req *ctlpb.RanksReq) (
 
 	for _, ei := range instances {
 		if !ei.IsStarted() {
+			// make sure that a pending automatic restart doesn't
+			// start the instance again
+			ei.cancelRestart()
 			continue
 		}
 		if err := ei.Stop(signal); err != nil {
@@ -125,6 +125,7 @@ type Config struct {
 	Fabric            FabricConfig   `yaml:",inline"`
 	EnvVars           []string       `yaml:"env_vars,omitempty"`
 	EnvPassThrough    []string       `yaml:"env_pass_through,omitempty"`
+	RestartPolicy     RestartPolicy  `yaml:"restart_policy,omitempty"`
 	PinnedNumaNode    *uint          `yaml:"pinned_numa_node,omitempty" cmdLongFlag:"--pinned_numa_node" cmdShortFlag:"-p"`
 	Index             uint32         `yaml:"-" cmdLongFlag:"--instance_idx" cmdShortFlag:"-I"`
 	MemSize           int            `yaml:"-" cmdLongFlag:"--mem_size" cmdShortFlag:"-r"`
@@ -195,6 +196,10 @@ func (c *Config) Validate() error {
 		return err
 	}
 
+	if err := c.RestartPolicy.Validate(); err != nil {
+		return err
+	}
+
 	if err := ValidateLogMasks(c.LogMask); err != nil {
 		return errors.Wrap(err, "validate engine log masks")
 	}
@@ -523,6 +528,12 @@ func (c *Config) WithHugepageSize(hugepagesz int) *Config {
 	return c
 }
 
+// WithRestartPolicy sets the automatic restart policy for the I/O Engine instance.
+func (c *Config) WithRestartPolicy(rp RestartPolicy) *Config {
+	c.RestartPolicy = rp
+	return c
+}
+
 // WithPinnedNumaNode sets the NUMA node affinity for the I/O Engine instance.
 func (c *Config) WithPinnedNumaNode(numa uint) *Config {
 	c.PinnedNumaNode = &numa
@@ -13,6 +13,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -152,7 +153,15 @@ func TestConfig_Constructed(t *testing.T) {
 		WithTargetCount(12).
 		WithHelperStreamCount(1).
 		WithPinnedNumaNode(8).
-		WithBypassHealthChk(nil)
+		WithBypassHealthChk(nil).
+		WithRestartPolicy(RestartPolicy{
+			Mode:          RestartOnFailure,
+			MaxRetries:    5,
+			BackoffMin:    10 * time.Second,
+			BackoffMax:    10 * time.Minute,
+			FlapWindow:    time.Hour,
+			FlapThreshold: 8,
+		})
 
 	if *update {
 		outFile, err := os.Create(goldenPath)
@@ -539,6 +548,28 @@ func TestConfig_Validation(t *testing.T) {
 			cfg:    validConfig().WithEnvVars("DD_SUBSYS=all,MEM"),
 			expErr: errLogNameAllWithOther,
 		},
+		"valid restart policy in config": {
+			cfg: validConfig().WithRestartPolicy(RestartPolicy{
+				Mode:       RestartOnFailure,
+				BackoffMin: time.Second,
+				BackoffMax: time.Minute,
+			}),
+		},
+		"unknown restart policy mode in config": {
+			cfg:    validConfig().WithRestartPolicy(RestartPolicy{Mode: "always"}),
+			expErr: errors.New("unknown restart_policy mode"),
+		},
+		"negative restart policy max retries in config": {
+			cfg:    validConfig().WithRestartPolicy(RestartPolicy{MaxRetries: -1}),
+			expErr: errors.New("must not be negative"),
+		},
+		"restart policy backoff max less than min in config": {
+			cfg: validConfig().WithRestartPolicy(RestartPolicy{
+				BackoffMin: time.Minute,
+				BackoffMax: time.Second,
+			}),
+			expErr: errors.New("backoff_max must not be less than backoff_min"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			test.CmpErr(t, tc.expErr, tc.cfg.Validate())
@@ -0,0 +1,105 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package engine
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// RestartNever indicates that an engine should not be restarted
+	// automatically after an unexpected exit.
+	RestartNever = "never"
+	// RestartOnFailure indicates that an engine should be restarted
+	// automatically after an unexpected exit.
+	RestartOnFailure = "on-failure"
+
+	// DefaultRestartMaxRetries is the default number of consecutive
+	// automatic restarts attempted before giving up.
+	DefaultRestartMaxRetries = 3
+	// DefaultRestartBackoffMin is the default delay before the first
+	// automatic restart attempt.
+	DefaultRestartBackoffMin = 5 * time.Second
+	// DefaultRestartBackoffMax is the default upper bound on the delay
+	// between automatic restart attempts.
+	DefaultRestartBackoffMax = 5 * time.Minute
+	// DefaultRestartFlapWindow is the default period over which engine
+	// exits are counted when detecting a flapping engine.
+	DefaultRestartFlapWindow = 10 * time.Minute
+	// DefaultRestartFlapThreshold is the default number of exits within
+	// the flap window after which an engine is considered to be flapping.
+	DefaultRestartFlapThreshold = 5
+)
+
+// RestartPolicy defines whether and how an engine is restarted automatically
+// after an unexpected exit.
+type RestartPolicy struct {
+	Mode          string        `yaml:"mode,omitempty"`
+	MaxRetries    int           `yaml:"max_retries,omitempty"`
+	BackoffMin    time.Duration `yaml:"backoff_min,omitempty"`
+	BackoffMax    time.Duration `yaml:"backoff_max,omitempty"`
+	FlapWindow    time.Duration `yaml:"flap_window,omitempty"`
+	FlapThreshold int           `yaml:"flap_threshold,omitempty"`
+}
+
+// Enabled indicates whether automatic restarts are enabled by the policy.
+func (rp *RestartPolicy) Enabled() bool {
+	return rp != nil && rp.Mode == RestartOnFailure
+}
+
+// WithDefaults returns a copy of the policy with unset values replaced by
+// their defaults.
+func (rp RestartPolicy) WithDefaults() RestartPolicy {
+	if rp.Mode == "" {
+		rp.Mode = RestartNever
+	}
+	if rp.MaxRetries == 0 {
+		rp.MaxRetries = DefaultRestartMaxRetries
+	}
+	if rp.BackoffMin == 0 {
+		rp.BackoffMin = DefaultRestartBackoffMin
+	}
+	if rp.BackoffMax == 0 {
+		rp.BackoffMax = DefaultRestartBackoffMax
+	}
+	if rp.BackoffMax < rp.BackoffMin {
+		rp.BackoffMax = rp.BackoffMin
+	}
+	if rp.FlapWindow == 0 {
+		rp.FlapWindow = DefaultRestartFlapWindow
+	}
+	if rp.FlapThreshold == 0 {
+		rp.FlapThreshold = DefaultRestartFlapThreshold
+	}
+
+	return rp
+}
+
+// Validate ensures that the restart policy parameters are sensible.
+func (rp *RestartPolicy) Validate() error {
+	switch rp.Mode {
+	case "", RestartNever, RestartOnFailure:
+	default:
+		return errors.Errorf("unknown restart_policy mode %q (valid: %s, %s)",
+			rp.Mode, RestartNever, RestartOnFailure)
+	}
+
+	switch {
+	case rp.MaxRetries < 0:
+		return errors.New("restart_policy max_retries must not be negative")
+	case rp.FlapThreshold < 0:
+		return errors.New("restart_policy flap_threshold must not be negative")
+	case rp.BackoffMin < 0, rp.BackoffMax < 0, rp.FlapWindow < 0:
+		return errors.New("restart_policy durations must not be negative")
+	case rp.BackoffMax != 0 && rp.BackoffMax < rp.BackoffMin:
+		return errors.New("restart_policy backoff_max must not be less than backoff_min")
+	}
+
+	return nil
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -96,6 +96,16 @@ func FaultPoolScmTooSmall(minTotal, minSCM uint64) *fault.Fault {
 	)
 }
 
+// FaultRanksInMaintenance indicates that a request would affect ranks that
+// are undergoing maintenance.
+func FaultRanksInMaintenance(ranks *ranklist.RankSet) *fault.Fault {
+	return serverFault(
+		code.ServerRanksInMaintenance,
+		fmt.Sprintf("request affects ranks undergoing maintenance: %s", ranks),
+		"retry the request without these ranks or finish maintenance with 'dmg system maintenance finish'",
+	)
+}
+
 func FaultPoolInvalidRanks(invalid []ranklist.Rank) *fault.Fault {
 	rs := make([]string, len(invalid))
 	for i, r := range invalid {
@@ -27,11 +27,12 @@ import (
 )
 
 type (
-	systemJoinFn     func(context.Context, *control.SystemJoinReq) (*control.SystemJoinResp, error)
-	onAwaitFormatFn  func(context.Context, uint32, string) error
-	onStorageReadyFn func(context.Context) error
-	onReadyFn        func(context.Context) error
-	onInstanceExitFn func(context.Context, uint32, ranklist.Rank, error, int) error
+	systemJoinFn         func(context.Context, *control.SystemJoinReq) (*control.SystemJoinResp, error)
+	onAwaitFormatFn      func(context.Context, uint32, string) error
+	onStorageReadyFn     func(context.Context) error
+	onReadyFn            func(context.Context) error
+	onInstanceExitFn     func(context.Context, uint32, ranklist.Rank, error, int) error
+	onRestartAbandonedFn func(context.Context, uint32, ranklist.Rank, error, error) error
 )
 
 // EngineInstance encapsulates control-plane specific configuration
@@ -42,22 +43,24 @@ type (
 // be used with EngineHarness to manage and monitor multiple instances
 // per node.
 type EngineInstance struct {
-	log             logging.Logger
-	runner          EngineRunner
-	storage         *storage.Provider
-	waitFormat      atm.Bool
-	storageReady    chan bool
-	waitDrpc        atm.Bool
-	drpcReady       chan *srvpb.NotifyReadyReq
-	ready           atm.Bool
-	startRequested  chan bool
-	fsRoot          string
-	hostFaultDomain *system.FaultDomain
-	joinSystem      systemJoinFn
-	onAwaitFormat   []onAwaitFormatFn
-	onStorageReady  []onStorageReadyFn
-	onReady         []onReadyFn
-	onInstanceExit  []onInstanceExitFn
+	log                logging.Logger
+	runner             EngineRunner
+	storage            *storage.Provider
+	waitFormat         atm.Bool
+	storageReady       chan bool
+	waitDrpc           atm.Bool
+	drpcReady          chan *srvpb.NotifyReadyReq
+	ready              atm.Bool
+	startRequested     chan bool
+	stopRequested      atm.Bool
+	fsRoot             string
+	hostFaultDomain    *system.FaultDomain
+	joinSystem         systemJoinFn
+	onAwaitFormat      []onAwaitFormatFn
+	onStorageReady     []onStorageReadyFn
+	onReady            []onReadyFn
+	onInstanceExit     []onInstanceExitFn
+	onRestartAbandoned []onRestartAbandonedFn
 
 	sync.RWMutex
 	// these must be protected by a mutex in order to
@@ -132,6 +135,12 @@ func (ei *EngineInstance) OnInstanceExit(fns ...onInstanceExitFn) {
 	ei.onInstanceExit = append(ei.onInstanceExit, fns...)
 }
 
+// OnRestartAbandoned adds a list of callbacks to invoke when automatic restarts
+// of the instance are abandoned by its restart policy.
+func (ei *EngineInstance) OnRestartAbandoned(fns ...onRestartAbandonedFn) {
+	ei.onRestartAbandoned = append(ei.onRestartAbandoned, fns...)
+}
+
 // LocalState returns local perspective of the current instance state
 // (doesn't consider state info held by the global system membership).
 func (ei *EngineInstance) LocalState() system.MemberState {
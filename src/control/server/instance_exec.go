@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/pkg/errors"
 
@@ -70,15 +71,20 @@ func (ei *EngineInstance) start(ctx context.Context) (chan *engine.RunnerExitInf
 // waitReady awaits ready signal from I/O Engine before starting
 // management service on MS replicas immediately so other instances can join.
 // I/O Engine modules are then loaded.
-func (ei *EngineInstance) waitReady(ctx context.Context) error {
+//
+// If the I/O Engine exits before signaling that it is ready, the exit details
+// are returned.
+func (ei *EngineInstance) waitReady(ctx context.Context, runnerExit engine.RunnerExitChan) (*engine.RunnerExitInfo, error) {
 	select {
 	case <-ctx.Done(): // propagated harness exit
-		return ctx.Err()
+		return nil, ctx.Err()
+	case exitInfo := <-runnerExit:
+		return exitInfo, nil
 	case ready := <-ei.awaitDrpcReady():
 		if err := ei.finishStartup(ctx, ready); err != nil {
-			return err
+			return nil, err
 		}
-		return nil
+		return nil, nil
 	}
 }
 
@@ -178,7 +184,15 @@ func (ei *EngineInstance) startRunner(parent context.Context) (_ chan *engine.Ru
 	}
 	ei.waitDrpc.SetTrue()
 
-	return runnerExitChan, ei.waitReady(ctx)
+	exitInfo, err := ei.waitReady(ctx, runnerExitChan)
+	if exitInfo != nil {
+		// The engine exited before it became ready, hand the exit back
+		// to the control loop so that it is handled like any other.
+		runnerExitChan = make(engine.RunnerExitChan, 1)
+		runnerExitChan <- exitInfo
+	}
+
+	return runnerExitChan, err
 }
 
 // requestStart makes a request to (re-)start the engine, and blocks
@@ -190,14 +204,107 @@ func (ei *EngineInstance) requestStart(ctx context.Context) {
 	}
 }
 
+// restartAfterExit applies the instance restart policy after an exit that was
+// neither requested nor caused by harness shutdown. If the policy allows, a timer
+// is returned which fires when the instance should be restarted, otherwise the
+// registered callbacks are notified that automatic restarts have been abandoned.
+func (ei *EngineInstance) restartAfterExit(ctx context.Context, restarts *restartTracker, exitErr error) *time.Timer {
+	if restarts == nil || ctx.Err() != nil || ei.stopRequested.Load() {
+		return nil
+	}
+	engineIdx := ei.Index()
+
+	delay, reason := restarts.onExit()
+	if reason == nil {
+		ei.log.Noticef("instance %d: restarting in %s", engineIdx, delay)
+		return time.NewTimer(delay)
+	}
+	ei.log.Errorf("instance %d: abandoning automatic restart: %s", engineIdx, reason)
+
+	rank, err := ei.GetRank()
+	if err != nil {
+		ei.log.Debugf("instance %d: no rank (%s)", engineIdx, err)
+	}
+	for _, abandonFn := range ei.onRestartAbandoned {
+		if err := abandonFn(ctx, engineIdx, rank, exitErr, reason); err != nil {
+			ei.log.Errorf("onRestartAbandoned: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// createPublishRestartAbandonedFunc returns onRestartAbandonedFn which will publish
+// an event using the provided publish function.
+func createPublishRestartAbandonedFunc(publish func(*events.RASEvent), hostname string) onRestartAbandonedFn {
+	return func(_ context.Context, engineIdx uint32, rank ranklist.Rank, exitErr, reason error) error {
+		if reason == nil {
+			return errors.New("expected non-nil reason")
+		}
+
+		var exitStatus common.ExitStatus
+		if exitErr != nil {
+			exitStatus = common.ExitStatus(exitErr.Error())
+		}
+		evt := events.NewEngineRestartAbandonedEvent(hostname, engineIdx, rank.Uint32(),
+			reason.Error(), exitStatus)
+
+		// set forwardable if there is a rank for the MS to operate on
+		publish(evt.WithForwardable(!rank.Equals(ranklist.NilRank)))
+
+		return nil
+	}
+}
+
 // Run starts the control loop for an EngineInstance. Engine starts are triggered by
 // calling requestStart() on the instance.
+//
+// If enabled in the engine configuration, the instance is restarted automatically
+// after an unexpected exit, subject to the configured restart policy.
 func (ei *EngineInstance) Run(ctx context.Context) {
+	var restarts *restartTracker
+	if cfg := ei.runner.GetConfig(); cfg != nil && cfg.RestartPolicy.Enabled() {
+		restarts = newRestartTracker(cfg.RestartPolicy)
+
+		// Only an engine that has joined the system counts as a successful
+		// restart, an engine that keeps exiting before it becomes ready will
+		// exhaust the retries allowed by the policy.
+		ei.OnReady(func(_ context.Context) error {
+			restarts.ready()
+			return nil
+		})
+	}
+
 	// Start the instance control loop.
 	go func() {
 		var runnerExitCh engine.RunnerExitChan
 		var err error
 		var restartRequested bool
+		var restartTimer *time.Timer
+		var restartCh <-chan time.Time
+
+		setRestartTimer := func(timer *time.Timer) {
+			if restartTimer != nil {
+				restartTimer.Stop()
+			}
+			restartTimer, restartCh = timer, nil
+			if timer != nil {
+				restartCh = timer.C
+			}
+		}
+		defer setRestartTimer(nil)
+
+		launch := func() {
+			runnerExitCh, err = ei.startRunner(ctx)
+			if err != nil {
+				ei.log.Errorf("runner exited without starting process: %s", err)
+				ei.handleExit(ctx, 0, err)
+				if runnerExitCh == nil {
+					setRestartTimer(ei.restartAfterExit(ctx, restarts, err))
+				}
+			}
+		}
+
 		for {
 			select {
 			case <-ctx.Done():
@@ -207,24 +314,36 @@ func (ei *EngineInstance) Run(ctx context.Context) {
 					return
 				}
 
+				// A requested start supersedes any pending automatic restart.
+				ei.stopRequested.SetFalse()
+				setRestartTimer(nil)
+				if restarts != nil {
+					restarts.reset()
+				}
+
 				if runnerExitCh != nil {
 					restartRequested = true
 					continue
 				}
 
-				runnerExitCh, err = ei.startRunner(ctx)
-				if err != nil {
-					ei.log.Errorf("runner exited without starting process: %s", err)
-					ei.handleExit(ctx, 0, err)
+				launch()
+			case <-restartCh:
+				setRestartTimer(nil)
+				if ei.stopRequested.Load() {
 					continue
 				}
+
+				launch()
 			case runnerExit := <-runnerExitCh:
 				ei.handleExit(ctx, runnerExit.PID, runnerExit.Error)
 				runnerExitCh = nil // next runner will reset this
 				if restartRequested {
 					go ei.requestStart(ctx)
 					restartRequested = false
+					continue
 				}
+
+				setRestartTimer(ei.restartAfterExit(ctx, restarts, runnerExit.Error))
 			}
 		}
 	}()
@@ -233,8 +352,16 @@ func (ei *EngineInstance) Run(ctx context.Context) {
 	ei.requestStart(ctx)
 }
 
-// Stop sends signal to stop EngineInstance runner (nonblocking).
+// cancelRestart prevents any pending automatic restart of the instance until
+// a start is next requested.
+func (ei *EngineInstance) cancelRestart() {
+	ei.stopRequested.SetTrue()
+}
+
+// Stop sends signal to stop EngineInstance runner (nonblocking). Any pending
+// automatic restart of the instance is cancelled.
 func (ei *EngineInstance) Stop(signal os.Signal) error {
+	ei.cancelRestart()
 	ei.runner.Signal(signal)
 	return nil
 }
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2021-2022 Intel Corporation.
+// (C) Copyright 2021-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -7,9 +7,13 @@
 package server
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"path/filepath"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/pkg/errors"
@@ -18,7 +22,11 @@ import (
 	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
+	sysprov "github.com/daos-stack/daos/src/control/provider/system"
 	"github.com/daos-stack/daos/src/control/server/engine"
+	"github.com/daos-stack/daos/src/control/server/storage"
+	"github.com/daos-stack/daos/src/control/server/storage/bdev"
+	"github.com/daos-stack/daos/src/control/server/storage/scm"
 )
 
 // TestIOEngineInstance_exit establishes that event is published on exit.
@@ -97,3 +105,152 @@ func TestIOEngineInstance_exit(t *testing.T) {
 		})
 	}
 }
+
+// TestIOEngineInstance_restartAfterExit establishes that an automatic restart is
+// scheduled according to the restart policy and that an event is published when
+// restarts are abandoned.
+func TestIOEngineInstance_restartAfterExit(t *testing.T) {
+	exitErr := errors.New("killed")
+
+	for name, tc := range map[string]struct {
+		policy        *engine.RestartPolicy
+		stopRequested bool
+		priorExits    int
+		expRestart    bool
+		expEvtMsg     string
+	}{
+		"restarts disabled": {},
+		"restart scheduled": {
+			policy:     &engine.RestartPolicy{Mode: engine.RestartOnFailure},
+			expRestart: true,
+		},
+		"stop requested": {
+			policy:        &engine.RestartPolicy{Mode: engine.RestartOnFailure},
+			stopRequested: true,
+		},
+		"retries exhausted": {
+			policy: &engine.RestartPolicy{
+				Mode:       engine.RestartOnFailure,
+				MaxRetries: 1,
+			},
+			priorExits: 1,
+			expEvtMsg:  "DAOS engine 0 will not be restarted automatically: engine failed to start after 1 restart attempts",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			var rxEvts []*events.RASEvent
+			fakePublish := func(evt *events.RASEvent) {
+				rxEvts = append(rxEvts, evt)
+			}
+
+			runner := engine.NewTestRunner(nil, &engine.Config{})
+			ei := NewEngineInstance(log, nil, nil, runner)
+			ei.setSuperblock(&Superblock{
+				Rank: ranklist.NewRankPtr(1), ValidRank: true,
+			})
+			ei.OnRestartAbandoned(createPublishRestartAbandonedFunc(fakePublish, "foo"))
+			ei.stopRequested.Store(tc.stopRequested)
+
+			var restarts *restartTracker
+			if tc.policy != nil {
+				restarts = newRestartTracker(*tc.policy)
+				for i := 0; i < tc.priorExits; i++ {
+					if _, err := restarts.onExit(); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			timer := ei.restartAfterExit(test.Context(t), restarts, exitErr)
+			if timer != nil {
+				timer.Stop()
+			}
+			test.AssertEqual(t, tc.expRestart, timer != nil, "unexpected restart state")
+
+			if tc.expEvtMsg == "" {
+				test.AssertEqual(t, 0, len(rxEvts), "unexpected number of events published")
+				return
+			}
+			test.AssertEqual(t, 1, len(rxEvts), "unexpected number of events published")
+			test.AssertEqual(t, events.RASEngineRestartAbandoned, rxEvts[0].ID, "unexpected event ID")
+			test.AssertEqual(t, uint32(1), rxEvts[0].Rank, "unexpected event rank")
+			test.AssertTrue(t, rxEvts[0].ShouldForward(), "expected event to be forwardable")
+			test.AssertEqual(t, tc.expEvtMsg, rxEvts[0].Msg, "unexpected event message")
+		})
+	}
+}
+
+// TestIOEngineInstance_Run_restartsExhausted establishes that an engine which
+// repeatedly exits before becoming ready is only restarted as many times as the
+// restart policy allows.
+func TestIOEngineInstance_Run_restartsExhausted(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	maxRetries := 3
+	engineCfg := engine.MockConfig().
+		WithStorage(
+			storage.NewTierConfig().
+				WithStorageClass("ram").
+				WithScmRamdiskSize(1).
+				WithScmMountPoint(filepath.Join(testDir, "0")),
+		).
+		WithRestartPolicy(engine.RestartPolicy{
+			Mode:          engine.RestartOnFailure,
+			MaxRetries:    maxRetries,
+			BackoffMin:    time.Millisecond,
+			BackoffMax:    time.Millisecond,
+			FlapThreshold: 100,
+		})
+
+	var starts uint32
+	runner := engine.NewTestRunner(&engine.TestRunnerConfig{
+		StartCb: func() {
+			atomic.AddUint32(&starts, 1)
+		},
+		RunnerExitInfo: &engine.RunnerExitInfo{
+			Error: errors.New("died before ready"),
+		},
+	}, engineCfg)
+
+	msc := &sysprov.MockSysConfig{IsMountedBool: true}
+	provider := storage.MockProvider(log, 0, &engineCfg.Storage,
+		sysprov.NewMockSysProvider(log, msc),
+		scm.NewMockProvider(log, nil, msc),
+		bdev.NewMockProvider(log, &bdev.MockBackendConfig{}),
+		nil,
+	)
+
+	ei := NewEngineInstance(log, provider, nil, runner)
+	ei.setSuperblock(&Superblock{
+		Rank: ranklist.NewRankPtr(1), ValidRank: true,
+	})
+
+	abandoned := make(chan error, 1)
+	ei.OnRestartAbandoned(func(_ context.Context, _ uint32, _ ranklist.Rank, _, reason error) error {
+		abandoned <- reason
+		return nil
+	})
+
+	ctx, cancel := context.WithTimeout(test.Context(t), 10*time.Second)
+	defer cancel()
+
+	ei.Run(ctx)
+
+	select {
+	case reason := <-abandoned:
+		test.CmpErr(t, errors.New("after 3 restart attempts"), reason)
+	case <-ctx.Done():
+		t.Fatalf("restarts were not abandoned (%d starts)", atomic.LoadUint32(&starts))
+	}
+
+	test.AssertEqual(t, uint32(maxRetries+1), atomic.LoadUint32(&starts),
+		"unexpected number of engine starts")
+	test.AssertFalse(t, ei.IsReady(), "engine should not be ready")
+}
@@ -0,0 +1,84 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+// restartTracker applies an engine restart policy to the sequence of
+// unexpected exits of an engine instance.
+//
+// Consecutive restarts that fail to bring the engine to a ready state are
+// limited by the policy's retry count and delayed with an exponential backoff.
+// Independently, an engine that exits too often within the flap window is
+// considered to be flapping and is no longer restarted.
+type restartTracker struct {
+	policy  engine.RestartPolicy
+	now     func() time.Time
+	exits   []time.Time
+	retries int
+}
+
+func newRestartTracker(policy engine.RestartPolicy) *restartTracker {
+	return &restartTracker{
+		policy: policy.WithDefaults(),
+		now:    time.Now,
+	}
+}
+
+// reset clears the restart history, e.g. after a requested (re-)start.
+func (rt *restartTracker) reset() {
+	rt.exits = nil
+	rt.retries = 0
+}
+
+// ready records that the engine has started successfully, resetting the count
+// of consecutive restart attempts.
+func (rt *restartTracker) ready() {
+	rt.retries = 0
+}
+
+// onExit records an unexpected engine exit and returns the delay to wait before
+// restarting the engine, or an error indicating why it should not be restarted.
+func (rt *restartTracker) onExit() (time.Duration, error) {
+	now := rt.now()
+	cutoff := now.Add(-rt.policy.FlapWindow)
+
+	exits := rt.exits[:0]
+	for _, ts := range rt.exits {
+		if ts.After(cutoff) {
+			exits = append(exits, ts)
+		}
+	}
+	rt.exits = append(exits, now)
+
+	if len(rt.exits) >= rt.policy.FlapThreshold {
+		return 0, errors.Errorf("engine flapping, exited %d times within %s",
+			len(rt.exits), rt.policy.FlapWindow)
+	}
+
+	if rt.retries >= rt.policy.MaxRetries {
+		return 0, errors.Errorf("engine failed to start after %d restart attempts",
+			rt.retries)
+	}
+	rt.retries++
+
+	delay := rt.policy.BackoffMin
+	for i := 1; i < rt.retries && delay < rt.policy.BackoffMax; i++ {
+		delay *= 2
+	}
+	if delay > rt.policy.BackoffMax {
+		delay = rt.policy.BackoffMax
+	}
+
+	return delay, nil
+}
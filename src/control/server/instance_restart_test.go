@@ -0,0 +1,124 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/server/engine"
+)
+
+func TestServer_restartTracker(t *testing.T) {
+	policy := engine.RestartPolicy{
+		Mode:          engine.RestartOnFailure,
+		MaxRetries:    3,
+		BackoffMin:    time.Second,
+		BackoffMax:    3 * time.Second,
+		FlapWindow:    time.Minute,
+		FlapThreshold: 5,
+	}
+
+	type exit struct {
+		after    time.Duration // elapsed since previous exit
+		ready    bool          // engine became ready before exiting
+		expDelay time.Duration
+		expErr   error
+	}
+
+	for name, tc := range map[string]struct {
+		exits []exit
+	}{
+		"backoff doubles up to max": {
+			exits: []exit{
+				{expDelay: time.Second},
+				{after: time.Second, expDelay: 2 * time.Second},
+				{after: 2 * time.Second, expDelay: 3 * time.Second},
+			},
+		},
+		"retries exhausted": {
+			exits: []exit{
+				{expDelay: time.Second},
+				{after: time.Second, expDelay: 2 * time.Second},
+				{after: 2 * time.Second, expDelay: 3 * time.Second},
+				{after: 3 * time.Second, expErr: errors.New("after 3 restart attempts")},
+			},
+		},
+		"ready resets retries": {
+			exits: []exit{
+				{expDelay: time.Second},
+				{after: time.Second, expDelay: 2 * time.Second},
+				{after: 30 * time.Second, ready: true, expDelay: time.Second},
+			},
+		},
+		"flapping detected": {
+			exits: []exit{
+				{expDelay: time.Second},
+				{after: 10 * time.Second, ready: true, expDelay: time.Second},
+				{after: 10 * time.Second, ready: true, expDelay: time.Second},
+				{after: 10 * time.Second, ready: true, expDelay: time.Second},
+				{after: 10 * time.Second, ready: true, expErr: errors.New("flapping")},
+			},
+		},
+		"exits outside flap window ignored": {
+			exits: []exit{
+				{expDelay: time.Second},
+				{after: 40 * time.Second, ready: true, expDelay: time.Second},
+				{after: 40 * time.Second, ready: true, expDelay: time.Second},
+				{after: 40 * time.Second, ready: true, expDelay: time.Second},
+				{after: 40 * time.Second, ready: true, expDelay: time.Second},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			now := time.Now()
+			rt := newRestartTracker(policy)
+			rt.now = func() time.Time { return now }
+
+			for i, e := range tc.exits {
+				now = now.Add(e.after)
+				if e.ready {
+					rt.ready()
+				}
+
+				delay, err := rt.onExit()
+				test.CmpErr(t, e.expErr, err)
+				if e.expErr != nil {
+					continue
+				}
+				test.AssertEqual(t, e.expDelay, delay, fmt.Sprintf("unexpected delay for exit %d", i))
+			}
+		})
+	}
+}
+
+func TestServer_restartTracker_reset(t *testing.T) {
+	rt := newRestartTracker(engine.RestartPolicy{
+		Mode:          engine.RestartOnFailure,
+		MaxRetries:    1,
+		FlapThreshold: 2,
+	})
+
+	if _, err := rt.onExit(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.onExit(); err == nil {
+		t.Fatal("expected restart to be abandoned")
+	}
+
+	rt.reset()
+
+	delay, err := rt.onExit()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, engine.DefaultRestartBackoffMin, delay, "unexpected delay")
+}
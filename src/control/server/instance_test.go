@@ -254,6 +254,8 @@ func (mi *MockInstance) SetupRank(_ context.Context, _ ranklist.Rank, _ uint32)
 	return mi.cfg.SetupRankErr
 }
 
+func (mi *MockInstance) cancelRestart() {}
+
 func (mi *MockInstance) Stop(os.Signal) error {
 	return mi.cfg.StopErr
 }
@@ -0,0 +1,57 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// SystemAuditList returns the summaries of mutating requests recorded in the
+// system database by the MS leader.
+func (svc *mgmtSvc) SystemAuditList(ctx context.Context, req *mgmtpb.SystemAuditListReq) (*mgmtpb.SystemAuditListResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("MgmtSvc.SystemAuditList dispatch, req:%+v\n", req)
+
+	filter := &system.AuditFilter{
+		Method:     req.GetMethod(),
+		Caller:     req.GetCaller(),
+		Peer:       req.GetPeer(),
+		FailedOnly: req.GetFailedOnly(),
+	}
+	var err error
+	if req.GetSince() != "" {
+		if filter.Since, err = common.ParseTime(req.GetSince()); err != nil {
+			return nil, errors.Wrap(err, "invalid since time")
+		}
+	}
+	if req.GetUntil() != "" {
+		if filter.Until, err = common.ParseTime(req.GetUntil()); err != nil {
+			return nil, errors.Wrap(err, "invalid until time")
+		}
+	}
+
+	recs, err := svc.sysdb.AuditRecords(filter, int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.SystemAuditListResp)
+	if err := convert.Types(recs, &resp.Records); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,153 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_SystemAuditList(t *testing.T) {
+	start := time.Date(2023, 6, 1, 12, 0, 0, 0, time.UTC)
+	recs := []*system.AuditRecord{
+		{
+			Timestamp: start,
+			Host:      "host1",
+			Method:    "/mgmt.MgmtSvc/PoolCreate",
+			Caller:    "admin",
+			Peer:      "10.0.0.1:40000",
+			Request:   `{"uuid":"pool1"}`,
+			Status:    system.AuditStatusOK,
+			Duration:  time.Second,
+		},
+		{
+			Timestamp: start.Add(time.Hour),
+			Host:      "host1",
+			Method:    "/mgmt.MgmtSvc/PoolDestroy",
+			Caller:    "admin",
+			Peer:      "10.0.0.2:40000",
+			Request:   `{"id":"pool1"}`,
+			Status:    "Unknown",
+			Error:     "DER_BUSY(-1012): Device or resource busy",
+			Duration:  time.Millisecond,
+		},
+		{
+			Timestamp: start.Add(2 * time.Hour),
+			Host:      "host1",
+			Method:    "/mgmt.MgmtSvc/PoolDestroy",
+			Caller:    "admin",
+			Peer:      "10.0.0.2:40000",
+			Request:   `{"id":"pool1","force":true}`,
+			Status:    system.AuditStatusOK,
+			Duration:  2 * time.Millisecond,
+		},
+	}
+	pbRecs := make([]*mgmtpb.AuditRecord, len(recs))
+	for i, rec := range recs {
+		pbRecs[i] = &mgmtpb.AuditRecord{
+			Sequence:  uint64(i + 1),
+			Timestamp: rec.Timestamp.Format(time.RFC3339Nano),
+			Host:      rec.Host,
+			Method:    rec.Method,
+			Caller:    rec.Caller,
+			Peer:      rec.Peer,
+			Request:   rec.Request,
+			Status:    rec.Status,
+			Error:     rec.Error,
+			Duration:  uint64(rec.Duration),
+		}
+	}
+
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.SystemAuditListReq
+		expResp *mgmtpb.SystemAuditListResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"invalid since": {
+			req: &mgmtpb.SystemAuditListReq{
+				Sys:   build.DefaultSystemName,
+				Since: "yesterday",
+			},
+			expErr: errors.New("invalid since"),
+		},
+		"all records": {
+			req: &mgmtpb.SystemAuditListReq{Sys: build.DefaultSystemName},
+			expResp: &mgmtpb.SystemAuditListResp{
+				Records: pbRecs,
+			},
+		},
+		"filtered by method": {
+			req: &mgmtpb.SystemAuditListReq{
+				Sys:    build.DefaultSystemName,
+				Method: "PoolDestroy",
+			},
+			expResp: &mgmtpb.SystemAuditListResp{
+				Records: pbRecs[1:],
+			},
+		},
+		"failed only": {
+			req: &mgmtpb.SystemAuditListReq{
+				Sys:        build.DefaultSystemName,
+				FailedOnly: true,
+			},
+			expResp: &mgmtpb.SystemAuditListResp{
+				Records: pbRecs[1:2],
+			},
+		},
+		"filtered by peer and time with limit": {
+			req: &mgmtpb.SystemAuditListReq{
+				Sys:   build.DefaultSystemName,
+				Peer:  "10.0.0.2",
+				Since: common.FormatTime(start.Add(30 * time.Minute)),
+				Limit: 1,
+			},
+			expResp: &mgmtpb.SystemAuditListResp{
+				Records: pbRecs[2:],
+			},
+		},
+		"no matches": {
+			req: &mgmtpb.SystemAuditListReq{
+				Sys:    build.DefaultSystemName,
+				Caller: "agent",
+			},
+			expResp: &mgmtpb.SystemAuditListResp{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if err := svc.sysdb.AddAuditRecords(recs); err != nil {
+				t.Fatal(err)
+			}
+
+			gotResp, gotErr := svc.SystemAuditList(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,210 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/hostlist"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+const (
+	// eventWatchBufSize is the number of events that may be queued for
+	// delivery to a single event stream subscriber before events are dropped.
+	eventWatchBufSize = 128
+	// eventWatchLeaderCheckInterval is the interval at which event stream
+	// subscriptions verify that this server is still the MS leader.
+	eventWatchLeaderCheckInterval = 1 * time.Second
+)
+
+// errEvtJournalDisabled is returned when the RAS event journal is not available.
+var errEvtJournalDisabled = errors.New("RAS event journal is disabled on this server")
+
+// eventFilterFromReq builds an event filter from the criteria supplied in a
+// protobuf request.
+func eventFilterFromReq(req interface {
+	GetIds() []uint32
+	GetMinSeverity() uint32
+	GetRanks() string
+}) (*events.Filter, error) {
+	filter := &events.Filter{
+		MinSeverity: events.RASSeverityID(req.GetMinSeverity()),
+	}
+	for _, id := range req.GetIds() {
+		filter.IDs = append(filter.IDs, events.RASID(id))
+	}
+
+	if req.GetRanks() != "" {
+		rs, err := ranklist.CreateRankSet(req.GetRanks())
+		if err != nil {
+			return nil, err
+		}
+		filter.Ranks = ranklist.RanksToUint32(rs.Ranks())
+	}
+
+	return filter, nil
+}
+
+// SystemEventsList returns RAS events recorded in the MS leader's event journal.
+func (svc *mgmtSvc) SystemEventsList(ctx context.Context, req *mgmtpb.SystemEventsListReq) (*mgmtpb.SystemEventsListResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	if svc.evtJournal == nil {
+		return nil, errEvtJournalDisabled
+	}
+	svc.log.Debugf("MgmtSvc.SystemEventsList dispatch, req:%+v\n", req)
+
+	filter, err := eventFilterFromReq(req)
+	if err != nil {
+		return nil, err
+	}
+	filter.Type = events.RASTypeID(req.GetType())
+	if req.GetHosts() != "" {
+		hs, err := hostlist.CreateSet(req.GetHosts())
+		if err != nil {
+			return nil, err
+		}
+		filter.Hosts = hs.Slice()
+	}
+	if req.GetSince() != "" {
+		if filter.Since, err = common.ParseTime(req.GetSince()); err != nil {
+			return nil, errors.Wrap(err, "invalid since time")
+		}
+	}
+	if req.GetUntil() != "" {
+		if filter.Until, err = common.ParseTime(req.GetUntil()); err != nil {
+			return nil, errors.Wrap(err, "invalid until time")
+		}
+	}
+
+	evts, err := svc.evtJournal.Query(filter, int(req.GetLimit()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.SystemEventsListResp{
+		Events: make([]*sharedpb.RASEvent, 0, len(evts)),
+	}
+	for _, evt := range evts {
+		pbEvt, err := evt.ToProto()
+		if err != nil {
+			return nil, err
+		}
+		resp.Events = append(resp.Events, pbEvt)
+	}
+
+	return resp, nil
+}
+
+// eventStreamHandler is a subscription handler which queues matching events
+// for delivery to an event stream client.
+type eventStreamHandler struct {
+	log     logging.Logger
+	filter  *events.Filter
+	evtChan chan *events.RASEvent
+}
+
+// OnEvent implements the events.Handler interface. Events are dropped rather
+// than blocking the publisher if the subscriber is not keeping up.
+func (h *eventStreamHandler) OnEvent(_ context.Context, evt *events.RASEvent) {
+	if !h.filter.Matches(evt) {
+		return
+	}
+
+	select {
+	case h.evtChan <- evt:
+	default:
+		h.log.Errorf("event stream subscriber is not keeping up; dropped %s event", evt.ID)
+	}
+}
+
+// leadershipChanged returns a channel that is closed on the next change of MS
+// leadership, once the event subscriptions have been reset.
+func (svc *mgmtSvc) leadershipChanged() <-chan struct{} {
+	svc.leaderChangeLock.Lock()
+	defer svc.leaderChangeLock.Unlock()
+
+	return svc.leaderChange
+}
+
+// notifyLeadershipChange wakes anything waiting on a change of MS leadership.
+// It must be called after the event subscriptions have been reset.
+func (svc *mgmtSvc) notifyLeadershipChange() {
+	svc.leaderChangeLock.Lock()
+	defer svc.leaderChangeLock.Unlock()
+
+	close(svc.leaderChange)
+	svc.leaderChange = make(chan struct{})
+}
+
+// SystemEventsWatch streams RAS events received by the MS leader to the
+// client until the client disconnects or MS leadership changes.
+func (svc *mgmtSvc) SystemEventsWatch(req *mgmtpb.SystemEventsWatchReq, stream mgmtpb.MgmtSvc_SystemEventsWatchServer) error {
+	// Subscriptions are reset on any change of leadership, even if it is
+	// regained before the next leader check, so the stream is ended and
+	// the client resubscribes. This is taken before subscribing so that a
+	// reset that removes the subscription can't be missed.
+	leaderChanged := svc.leadershipChanged()
+
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return err
+	}
+	svc.log.Debugf("MgmtSvc.SystemEventsWatch dispatch, req:%+v\n", req)
+
+	filter, err := eventFilterFromReq(req)
+	if err != nil {
+		return err
+	}
+
+	hdlr := &eventStreamHandler{
+		log:     svc.log,
+		filter:  filter,
+		evtChan: make(chan *events.RASEvent, eventWatchBufSize),
+	}
+	svc.events.Subscribe(events.RASTypeAny, hdlr)
+	defer svc.events.Unsubscribe(events.RASTypeAny, hdlr)
+
+	leaderCheck := time.NewTicker(eventWatchLeaderCheckInterval)
+	defer leaderCheck.Stop()
+
+	ctx := stream.Context()
+	for {
+		select {
+		case <-ctx.Done():
+			svc.log.Debug("event stream client disconnected")
+			return nil
+		case <-leaderChanged:
+			svc.log.Debug("MS leadership changed; ending event stream")
+			return nil
+		case <-leaderCheck.C:
+			// Handlers are reset on leadership change, so the client
+			// needs to resubscribe with the new leader.
+			if err := svc.sysdb.CheckLeader(); err != nil {
+				return err
+			}
+		case evt := <-hdlr.evtChan:
+			pbEvt, err := evt.ToProto()
+			if err != nil {
+				svc.log.Errorf("failed to convert %s event: %s", evt.ID, err)
+				continue
+			}
+			if err := stream.Send(&mgmtpb.SystemEventsWatchResp{Event: pbEvt}); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -0,0 +1,282 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	sharedpb "github.com/daos-stack/daos/src/control/common/proto/shared"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+func TestServer_MgmtSvc_SystemEventsList(t *testing.T) {
+	evts := []*events.RASEvent{
+		events.NewEngineDiedEvent("host1", 0, 0, common.NormalExit, 1234),
+		events.NewEngineDiedEvent("host2", 0, 1, common.NormalExit, 1234),
+		events.NewEngineFormatRequiredEvent("host2", 1, "Metadata").WithRank(2),
+	}
+	pbEvts := make([]*sharedpb.RASEvent, len(evts))
+	for i, evt := range evts {
+		pbEvt, err := evt.ToProto()
+		if err != nil {
+			t.Fatal(err)
+		}
+		pbEvts[i] = pbEvt
+	}
+
+	for name, tc := range map[string]struct {
+		noJournal bool
+		req       *mgmtpb.SystemEventsListReq
+		expResp   *mgmtpb.SystemEventsListResp
+		expErr    error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"journal disabled": {
+			noJournal: true,
+			req:       &mgmtpb.SystemEventsListReq{Sys: build.DefaultSystemName},
+			expErr:    errEvtJournalDisabled,
+		},
+		"invalid ranks": {
+			req: &mgmtpb.SystemEventsListReq{
+				Sys:   build.DefaultSystemName,
+				Ranks: "foo",
+			},
+			expErr: errors.New("unexpected alphabetic character"),
+		},
+		"invalid since": {
+			req: &mgmtpb.SystemEventsListReq{
+				Sys:   build.DefaultSystemName,
+				Since: "yesterday",
+			},
+			expErr: errors.New("invalid since"),
+		},
+		"all events": {
+			req: &mgmtpb.SystemEventsListReq{Sys: build.DefaultSystemName},
+			expResp: &mgmtpb.SystemEventsListResp{
+				Events: pbEvts,
+			},
+		},
+		"filtered by host and id": {
+			req: &mgmtpb.SystemEventsListReq{
+				Sys:   build.DefaultSystemName,
+				Ids:   []uint32{events.RASEngineDied.Uint32()},
+				Hosts: "host[2-3]",
+			},
+			expResp: &mgmtpb.SystemEventsListResp{
+				Events: pbEvts[1:2],
+			},
+		},
+		"filtered by rank with limit": {
+			req: &mgmtpb.SystemEventsListReq{
+				Sys:   build.DefaultSystemName,
+				Ranks: "0-2",
+				Limit: 1,
+			},
+			expResp: &mgmtpb.SystemEventsListResp{
+				Events: pbEvts[2:],
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			tmpDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			svc := newTestMgmtSvc(t, log)
+			if !tc.noJournal {
+				svc.evtJournal = events.NewJournal(log, events.JournalConfig{
+					Path: filepath.Join(tmpDir, evtJournalFile),
+				})
+				if err := svc.evtJournal.Open(); err != nil {
+					t.Fatal(err)
+				}
+				defer svc.evtJournal.Close()
+
+				for _, evt := range evts {
+					if err := svc.evtJournal.Append(evt); err != nil {
+						t.Fatal(err)
+					}
+				}
+			}
+
+			gotResp, gotErr := svc.SystemEventsList(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+type mockEventsWatchStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	sent   chan *mgmtpb.SystemEventsWatchResp
+	sendFn func(*mgmtpb.SystemEventsWatchResp) error
+}
+
+func (s *mockEventsWatchStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockEventsWatchStream) Send(resp *mgmtpb.SystemEventsWatchResp) error {
+	if s.sendFn != nil {
+		return s.sendFn(resp)
+	}
+	s.sent <- resp
+	return nil
+}
+
+func TestServer_MgmtSvc_SystemEventsWatch(t *testing.T) {
+	died := events.NewEngineDiedEvent("host1", 0, 1, common.NormalExit, 1234)
+	fmtReq := events.NewEngineFormatRequiredEvent("host1", 0, "Metadata").WithRank(1)
+	diedOther := events.NewEngineDiedEvent("host2", 0, 2, common.NormalExit, 1234)
+
+	for name, tc := range map[string]struct {
+		req      *mgmtpb.SystemEventsWatchReq
+		sendErr  error
+		expEvtID events.RASID
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"invalid ranks": {
+			req: &mgmtpb.SystemEventsWatchReq{
+				Sys:   build.DefaultSystemName,
+				Ranks: "foo",
+			},
+			expErr: errors.New("unexpected alphabetic character"),
+		},
+		"send fails": {
+			req:     &mgmtpb.SystemEventsWatchReq{Sys: build.DefaultSystemName},
+			sendErr: errors.New("send failed"),
+			expErr:  errors.New("send failed"),
+		},
+		"filtered by rank and severity": {
+			req: &mgmtpb.SystemEventsWatchReq{
+				Sys:         build.DefaultSystemName,
+				MinSeverity: uint32(events.RASSeverityError),
+				Ranks:       "1",
+			},
+			expEvtID: events.RASEngineDied,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+
+			ctx, cancel := context.WithCancel(test.Context(t))
+			defer cancel()
+			stream := &mockEventsWatchStream{
+				ctx:  ctx,
+				sent: make(chan *mgmtpb.SystemEventsWatchResp, 1),
+			}
+			if tc.sendErr != nil {
+				stream.sendFn = func(*mgmtpb.SystemEventsWatchResp) error {
+					return tc.sendErr
+				}
+			}
+
+			errCh := make(chan error, 1)
+			go func() {
+				errCh <- svc.SystemEventsWatch(tc.req, stream)
+			}()
+
+			// The subscription is registered asynchronously, so keep
+			// publishing until the handler returns or an event is sent.
+			ticker := time.NewTicker(10 * time.Millisecond)
+			defer ticker.Stop()
+			for {
+				select {
+				case gotErr := <-errCh:
+					test.CmpErr(t, tc.expErr, gotErr)
+					if tc.expErr == nil {
+						t.Fatal("expected event before stream completion")
+					}
+					return
+				case resp := <-stream.sent:
+					test.AssertEqual(t, tc.expEvtID.Uint32(), resp.Event.Id, "unexpected event")
+					cancel()
+					test.CmpErr(t, tc.expErr, <-errCh)
+					return
+				case <-ticker.C:
+					svc.events.Publish(diedOther)
+					svc.events.Publish(fmtReq)
+					svc.events.Publish(died)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemEventsWatch_LeadershipChange(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc := newTestMgmtSvc(t, log)
+	died := events.NewEngineDiedEvent("host1", 0, 1, common.NormalExit, 1234)
+
+	stream := &mockEventsWatchStream{
+		ctx:  test.Context(t),
+		sent: make(chan *mgmtpb.SystemEventsWatchResp, 1),
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- svc.SystemEventsWatch(&mgmtpb.SystemEventsWatchReq{Sys: build.DefaultSystemName}, stream)
+	}()
+
+	// Wait for the subscription to be established.
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for subscribed := false; !subscribed; {
+		select {
+		case gotErr := <-errCh:
+			t.Fatalf("stream ended unexpectedly: %v", gotErr)
+		case <-stream.sent:
+			subscribed = true
+		case <-ticker.C:
+			svc.events.Publish(died)
+		}
+	}
+
+	// Leadership is lost and regained before the next leader check; the
+	// subscription is dropped, so the stream must end.
+	svc.events.Reset()
+	svc.notifyLeadershipChange()
+
+	select {
+	case gotErr := <-errCh:
+		if gotErr != nil {
+			t.Fatalf("unexpected error: %s", gotErr)
+		}
+	case <-time.After(eventWatchLeaderCheckInterval / 2):
+		t.Fatal("stream not ended after leadership change")
+	}
+}
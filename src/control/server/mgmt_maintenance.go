@@ -0,0 +1,614 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const maintenanceCheckInterval = 5 * time.Second
+
+// maintenanceStepFn performs the work of a single maintenance phase for the
+// member. The maintenance record may be modified to record progress. Returns
+// true when the phase is complete.
+type maintenanceStepFn func(context.Context, *system.Member, *system.MemberMaintenance) (bool, error)
+
+// nextMaintenancePhase returns the phase that follows the supplied phase once
+// it has completed.
+func nextMaintenancePhase(cur system.MaintenancePhase) system.MaintenancePhase {
+	switch cur {
+	case system.MaintenancePhaseDraining:
+		return system.MaintenancePhaseRebuilding
+	case system.MaintenancePhaseRebuilding:
+		return system.MaintenancePhaseStopping
+	case system.MaintenancePhaseStopping:
+		return system.MaintenancePhaseServiceable
+	case system.MaintenancePhaseStarting:
+		return system.MaintenancePhaseReintegrating
+	case system.MaintenancePhaseReintegrating:
+		return system.MaintenancePhaseVerifying
+	case system.MaintenancePhaseVerifying:
+		return system.MaintenancePhaseNone
+	default:
+		return cur
+	}
+}
+
+// finishMaintenancePhase returns the phase from which the workflow resumes when
+// maintenance of a member in the supplied phase is finished.
+func finishMaintenancePhase(cur system.MaintenancePhase) system.MaintenancePhase {
+	switch {
+	case cur.IsFinishing():
+		return cur
+	case cur >= system.MaintenancePhaseStopping:
+		return system.MaintenancePhaseStarting
+	default:
+		// The rank has not been stopped, so it only needs to be
+		// reintegrated into any pools it has been drained from.
+		return system.MaintenancePhaseReintegrating
+	}
+}
+
+// getMaintenanceMembers returns the members that are undergoing maintenance,
+// sorted by rank.
+func (svc *mgmtSvc) getMaintenanceMembers() ([]*system.Member, error) {
+	members, err := svc.sysdb.AllMembers()
+	if err != nil {
+		return nil, err
+	}
+
+	var out []*system.Member
+	for _, m := range members {
+		if m.InMaintenance() {
+			out = append(out, m)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Rank < out[j].Rank })
+
+	return out, nil
+}
+
+// checkMaintenanceRanks returns an error if any of the supplied ranks are
+// undergoing maintenance. Unknown ranks are ignored.
+func (svc *mgmtSvc) checkMaintenanceRanks(ranks ...ranklist.Rank) error {
+	inMaint := ranklist.NewRankSet()
+	for _, r := range ranks {
+		m, err := svc.sysdb.FindMemberByRank(r)
+		if err != nil {
+			if system.IsMemberNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if m.InMaintenance() {
+			inMaint.Add(r)
+		}
+	}
+
+	if inMaint.Count() > 0 {
+		return FaultRanksInMaintenance(inMaint)
+	}
+	return nil
+}
+
+// filterMaintenanceRanks removes ranks undergoing maintenance from a fanout
+// request that targets the whole system. If hosts or ranks were specified
+// explicitly, an error is returned instead.
+func (svc *mgmtSvc) filterMaintenanceRanks(req systemReq, fReq *fanoutRequest) error {
+	if req.GetHosts() != "" || req.GetRanks() != "" {
+		return svc.checkMaintenanceRanks(fReq.Ranks.Ranks()...)
+	}
+
+	members, err := svc.getMaintenanceMembers()
+	if err != nil {
+		return err
+	}
+	for _, m := range members {
+		svc.log.Debugf("skipping rank %d (maintenance phase %s)", m.Rank, m.MaintenancePhase())
+		fReq.Ranks.Delete(m.Rank)
+	}
+
+	return nil
+}
+
+// SystemMaintenance starts or finishes maintenance of the requested ranks and
+// reports their maintenance state. The workflow itself is driven by the
+// maintenance loop on the MS leader.
+func (svc *mgmtSvc) SystemMaintenance(ctx context.Context, req *mgmtpb.SystemMaintenanceReq) (*mgmtpb.SystemMaintenanceResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if req.Hosts == "" && req.Ranks == "" {
+		if req.Action != mgmtpb.SystemMaintenanceReq_STATUS {
+			return nil, errors.New("no hosts or ranks specified")
+		}
+
+		members, err := svc.getMaintenanceMembers()
+		if err != nil {
+			return nil, err
+		}
+		return maintenanceMembersToPB(members), nil
+	}
+
+	fReq, fResp, err := svc.getFanout(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if fResp.AbsentHosts.Count() > 0 {
+		return nil, errors.Errorf("invalid host(s): %s", fResp.AbsentHosts.String())
+	}
+	if fResp.AbsentRanks.Count() > 0 {
+		return nil, errors.Errorf("invalid rank(s): %s", fResp.AbsentRanks.String())
+	}
+	ranks := fReq.Ranks.Ranks()
+
+	switch req.Action {
+	case mgmtpb.SystemMaintenanceReq_START:
+		err = svc.startMaintenance(ranks)
+	case mgmtpb.SystemMaintenanceReq_FINISH:
+		err = svc.finishMaintenance(ranks)
+	case mgmtpb.SystemMaintenanceReq_STATUS:
+	default:
+		err = errors.Errorf("unknown maintenance action %s", req.Action)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	members := make([]*system.Member, 0, len(ranks))
+	for _, r := range ranks {
+		m, err := svc.sysdb.FindMemberByRank(r)
+		if err != nil {
+			return nil, err
+		}
+		members = append(members, m)
+	}
+
+	return maintenanceMembersToPB(members), nil
+}
+
+// startMaintenance places the ranks into maintenance. All ranks are validated
+// before any are updated.
+func (svc *mgmtSvc) startMaintenance(ranks []ranklist.Rank) error {
+	for _, r := range ranks {
+		m, err := svc.sysdb.FindMemberByRank(r)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case m.MaintenancePhase().IsFinishing():
+			return errors.Errorf("maintenance of rank %d is being finished (phase %s)",
+				r, m.MaintenancePhase())
+		case m.InMaintenance():
+		case m.State != system.MemberStateJoined:
+			return errors.Errorf("rank %d must be %s to start maintenance (state %s)",
+				r, strings.ToLower(system.MemberStateJoined.String()),
+				strings.ToLower(m.State.String()))
+		}
+	}
+
+	for _, r := range ranks {
+		if _, err := svc.membership.UpdateMemberMaintenance(r, func(m *system.Member) (*system.MemberMaintenance, error) {
+			if m.InMaintenance() {
+				return m.Maintenance, nil
+			}
+			return &system.MemberMaintenance{Phase: system.MaintenancePhaseDraining}, nil
+		}); err != nil {
+			return err
+		}
+		svc.log.Noticef("rank %d: maintenance started", r)
+	}
+
+	return nil
+}
+
+// finishMaintenance returns the ranks to service. All ranks are validated
+// before any are updated.
+func (svc *mgmtSvc) finishMaintenance(ranks []ranklist.Rank) error {
+	for _, r := range ranks {
+		m, err := svc.sysdb.FindMemberByRank(r)
+		if err != nil {
+			return err
+		}
+		if !m.InMaintenance() {
+			return errors.Errorf("rank %d is not undergoing maintenance", r)
+		}
+	}
+
+	for _, r := range ranks {
+		if _, err := svc.membership.UpdateMemberMaintenance(r, func(m *system.Member) (*system.MemberMaintenance, error) {
+			mm := m.Maintenance.Copy()
+			if mm.Phase.IsFinishing() {
+				return mm, nil
+			}
+			mm.Phase = finishMaintenancePhase(mm.Phase)
+			mm.Error = ""
+			return mm, nil
+		}); err != nil {
+			return err
+		}
+		svc.log.Noticef("rank %d: maintenance finishing", r)
+	}
+
+	return nil
+}
+
+func maintenanceMembersToPB(members []*system.Member) *mgmtpb.SystemMaintenanceResp {
+	resp := new(mgmtpb.SystemMaintenanceResp)
+	for _, m := range members {
+		mm := &mgmtpb.MaintenanceMember{
+			Rank:  m.Rank.Uint32(),
+			Addr:  m.Addr.String(),
+			State: strings.ToLower(m.State.String()),
+			Phase: m.MaintenancePhase().String(),
+		}
+		if m.Maintenance != nil {
+			for _, p := range m.Maintenance.Pools {
+				mm.Pools = append(mm.Pools, p.String())
+			}
+			mm.Error = m.Maintenance.Error
+			if !m.Maintenance.LastUpdate.IsZero() {
+				mm.LastUpdate = m.Maintenance.LastUpdate.Format(time.RFC3339)
+			}
+		}
+		resp.Members = append(resp.Members, mm)
+	}
+
+	return resp
+}
+
+// maintenanceLoop periodically advances the maintenance workflow of members
+// undergoing maintenance while this instance is the MS leader. As progress is
+// recorded in the system database, the workflow is resumed by a new leader.
+func (svc *mgmtSvc) maintenanceLoop(parent context.Context) {
+	ticker := time.NewTicker(maintenanceCheckInterval)
+	defer ticker.Stop()
+
+	svc.log.Debug("starting maintenanceLoop")
+	for {
+		select {
+		case <-parent.Done():
+			svc.log.Debug("stopped maintenanceLoop")
+			return
+		case <-ticker.C:
+			if err := svc.stepMaintenance(parent); err != nil {
+				svc.log.Errorf("maintenance step failed: %s", err)
+			}
+		}
+	}
+}
+
+// stepMaintenance advances each member undergoing maintenance by at most one
+// phase.
+func (svc *mgmtSvc) stepMaintenance(ctx context.Context) error {
+	members, err := svc.getMaintenanceMembers()
+	if err != nil {
+		return err
+	}
+
+	for _, m := range members {
+		if err := svc.stepMemberMaintenance(ctx, m); err != nil {
+			svc.log.Errorf("rank %d: failed to update maintenance state: %s", m.Rank, err)
+		}
+	}
+
+	return nil
+}
+
+func (svc *mgmtSvc) getMaintenanceStep(phase system.MaintenancePhase) maintenanceStepFn {
+	switch phase {
+	case system.MaintenancePhaseDraining:
+		return svc.maintenanceDrain
+	case system.MaintenancePhaseRebuilding:
+		return svc.maintenanceRebuild
+	case system.MaintenancePhaseStopping:
+		return svc.maintenanceStop
+	case system.MaintenancePhaseStarting:
+		return svc.maintenanceStart
+	case system.MaintenancePhaseReintegrating, system.MaintenancePhaseVerifying:
+		return svc.maintenanceReintegrate
+	default:
+		return nil
+	}
+}
+
+// stepMemberMaintenance performs the current phase of the maintenance workflow
+// for the member and records any progress.
+func (svc *mgmtSvc) stepMemberMaintenance(ctx context.Context, m *system.Member) error {
+	phase := m.MaintenancePhase()
+	step := svc.getMaintenanceStep(phase)
+	if step == nil {
+		return nil
+	}
+
+	mm := m.Maintenance.Copy()
+	done, err := step(ctx, m, mm)
+	switch {
+	case err != nil:
+		mm.Error = err.Error()
+	case done:
+		mm.Phase = nextMaintenancePhase(phase)
+		mm.Error = ""
+	}
+
+	if mm.Phase == phase && mm.Error == m.Maintenance.Error && len(mm.Pools) == len(m.Maintenance.Pools) {
+		return nil
+	}
+
+	_, err = svc.membership.UpdateMemberMaintenance(m.Rank, func(cur *system.Member) (*system.MemberMaintenance, error) {
+		if cur.MaintenancePhase() != phase {
+			// The member has been moved on by another request
+			// since this step was started.
+			return cur.Maintenance, nil
+		}
+		if mm.Phase == system.MaintenancePhaseNone {
+			return nil, nil
+		}
+		return mm, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case mm.Phase == system.MaintenancePhaseNone:
+		svc.log.Noticef("rank %d: maintenance complete", m.Rank)
+	case mm.Phase != phase:
+		svc.log.Noticef("rank %d: maintenance phase %s", m.Rank, mm.Phase)
+	case mm.Error != "":
+		svc.log.Errorf("rank %d: maintenance phase %s: %s", m.Rank, phase, mm.Error)
+	}
+
+	return nil
+}
+
+// drainPoolRank drains (or reintegrates) a single rank of a pool, bypassing
+// the maintenance checks applied to the pool drain and reintegrate requests.
+func (svc *mgmtSvc) drainPoolRank(ctx context.Context, sys string, poolUUID uuid.UUID, rank ranklist.Rank, reint bool) (int32, error) {
+	if reint {
+		resp, err := svc.poolReintegrate(ctx, &mgmtpb.PoolReintegrateReq{
+			Sys:  sys,
+			Id:   poolUUID.String(),
+			Rank: rank.Uint32(),
+		})
+		return resp.GetStatus(), err
+	}
+
+	resp, err := svc.poolDrain(ctx, &mgmtpb.PoolDrainReq{
+		Sys:  sys,
+		Id:   poolUUID.String(),
+		Rank: rank.Uint32(),
+	})
+	return resp.GetStatus(), err
+}
+
+// getPoolRanks queries the pool for the set of ranks that it has targets on,
+// whether or not they are currently enabled. The pool map maintained by the
+// engines is used rather than the ranks recorded in the system database at
+// pool creation, as the pool may have been extended since.
+func (svc *mgmtSvc) getPoolRanks(ctx context.Context, poolUUID uuid.UUID) (*ranklist.RankSet, error) {
+	resp, err := svc.PoolQuery(ctx, &mgmtpb.PoolQueryReq{
+		Sys:                  svc.sysdb.SystemName(),
+		Id:                   poolUUID.String(),
+		IncludeEnabledRanks:  true,
+		IncludeDisabledRanks: true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "query pool %s", poolUUID)
+	}
+	if resp.GetStatus() != 0 {
+		return nil, errors.Wrapf(daos.Status(resp.GetStatus()), "query pool %s", poolUUID)
+	}
+
+	ranks, err := ranklist.CreateRankSet(resp.GetEnabledRanks())
+	if err != nil {
+		return nil, errors.Wrapf(err, "pool %s enabled ranks", poolUUID)
+	}
+	disabled, err := ranklist.CreateRankSet(resp.GetDisabledRanks())
+	if err != nil {
+		return nil, errors.Wrapf(err, "pool %s disabled ranks", poolUUID)
+	}
+	ranks.Merge(disabled)
+
+	return ranks, nil
+}
+
+// poolRankState describes the state of a rank in a pool.
+type poolRankState struct {
+	disabled   bool
+	rebuilding bool
+}
+
+// getPoolRankState queries the pool to determine whether the rank is disabled
+// and whether a rebuild is in progress. A nil state is returned if the pool no
+// longer exists.
+func (svc *mgmtSvc) getPoolRankState(ctx context.Context, poolUUID uuid.UUID, rank ranklist.Rank) (*poolRankState, error) {
+	if _, err := svc.sysdb.FindPoolServiceByUUID(poolUUID); err != nil {
+		if system.IsPoolNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	resp, err := svc.PoolQuery(ctx, &mgmtpb.PoolQueryReq{
+		Sys:                  svc.sysdb.SystemName(),
+		Id:                   poolUUID.String(),
+		IncludeDisabledRanks: true,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "query pool %s", poolUUID)
+	}
+	if resp.GetStatus() != 0 {
+		return nil, errors.Wrapf(daos.Status(resp.GetStatus()), "query pool %s", poolUUID)
+	}
+	if rs := resp.GetRebuild().GetStatus(); rs != 0 {
+		return nil, errors.Wrapf(daos.Status(rs), "pool %s rebuild failed", poolUUID)
+	}
+
+	disabled, err := ranklist.CreateRankSet(resp.GetDisabledRanks())
+	if err != nil {
+		return nil, errors.Wrapf(err, "pool %s disabled ranks", poolUUID)
+	}
+
+	return &poolRankState{
+		disabled:   rank.InList(disabled.Ranks()),
+		rebuilding: resp.GetRebuild().GetState() == mgmtpb.PoolRebuildStatus_BUSY,
+	}, nil
+}
+
+// maintenanceDrain drains the rank from each pool that has targets on it and
+// records the pools so that the rank can later be reintegrated.
+func (svc *mgmtSvc) maintenanceDrain(ctx context.Context, m *system.Member, mm *system.MemberMaintenance) (bool, error) {
+	pools, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		return false, err
+	}
+
+	var errMsgs []string
+	for _, ps := range pools {
+		if mm.HasPool(ps.PoolUUID) {
+			continue
+		}
+
+		poolRanks, err := svc.getPoolRanks(ctx, ps.PoolUUID)
+		if err != nil {
+			errMsgs = append(errMsgs, err.Error())
+			continue
+		}
+		if !m.Rank.InList(poolRanks.Ranks()) {
+			continue
+		}
+
+		status, err := svc.drainPoolRank(ctx, svc.sysdb.SystemName(), ps.PoolUUID, m.Rank, false)
+		if err == nil && status != 0 {
+			err = daos.Status(status)
+		}
+		if err != nil {
+			errMsgs = append(errMsgs, errors.Wrapf(err, "drain from pool %s", ps.PoolUUID).Error())
+			continue
+		}
+		mm.Pools = append(mm.Pools, ps.PoolUUID)
+	}
+
+	if len(errMsgs) > 0 {
+		return false, errors.New(strings.Join(errMsgs, ", "))
+	}
+	return true, nil
+}
+
+// maintenanceRebuild waits for the rank to be disabled in each of the pools it
+// has been drained from.
+func (svc *mgmtSvc) maintenanceRebuild(ctx context.Context, m *system.Member, mm *system.MemberMaintenance) (bool, error) {
+	for _, poolUUID := range mm.Pools {
+		state, err := svc.getPoolRankState(ctx, poolUUID, m.Rank)
+		if err != nil {
+			return false, err
+		}
+		if state != nil && (!state.disabled || state.rebuilding) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
+
+// maintenanceStop stops the rank once it has been drained.
+func (svc *mgmtSvc) maintenanceStop(ctx context.Context, m *system.Member, _ *system.MemberMaintenance) (bool, error) {
+	if m.State&(system.MemberStateStopped|system.MemberStateErrored|system.ExcludedMemberFilter) != 0 {
+		return true, nil
+	}
+
+	return svc.maintenanceFanout(ctx, m, control.StopRanks)
+}
+
+// maintenanceStart restarts the rank and waits for it to rejoin the system.
+func (svc *mgmtSvc) maintenanceStart(ctx context.Context, m *system.Member, _ *system.MemberMaintenance) (bool, error) {
+	switch m.State {
+	case system.MemberStateJoined:
+		return true, nil
+	case system.MemberStateStarting, system.MemberStateReady:
+		return false, nil
+	}
+
+	if _, err := svc.maintenanceFanout(ctx, m, control.StartRanks); err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+func (svc *mgmtSvc) maintenanceFanout(ctx context.Context, m *system.Member, method systemRanksFunc) (bool, error) {
+	fReq := &fanoutRequest{
+		Method: method,
+		Ranks:  ranklist.RankSetFromRanks(ranklist.RankList{m.Rank}),
+	}
+	fResp, _, err := svc.rpcFanout(ctx, fReq, nil, true)
+	if err != nil {
+		return false, err
+	}
+	if err := fResp.Results.Errors(); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// maintenanceReintegrate reintegrates the rank into each of the pools it has
+// been drained from. The reintegrating phase completes once all reintegrations
+// have been accepted and the verifying phase completes once the rank is
+// enabled in all pools and no rebuilds are in progress. Ranks that have been
+// disabled again while verifying are reintegrated again.
+func (svc *mgmtSvc) maintenanceReintegrate(ctx context.Context, m *system.Member, mm *system.MemberMaintenance) (bool, error) {
+	if m.State != system.MemberStateJoined {
+		return false, errors.Errorf("rank %d is %s", m.Rank, strings.ToLower(m.State.String()))
+	}
+
+	verifying := mm.Phase == system.MaintenancePhaseVerifying
+	done := true
+	var errMsgs []string
+	for _, poolUUID := range mm.Pools {
+		state, err := svc.getPoolRankState(ctx, poolUUID, m.Rank)
+		if err != nil {
+			errMsgs = append(errMsgs, err.Error())
+			continue
+		}
+		switch {
+		case state == nil:
+			continue
+		case state.disabled:
+			done = false
+			status, err := svc.drainPoolRank(ctx, svc.sysdb.SystemName(), poolUUID, m.Rank, true)
+			if err == nil && status != 0 {
+				err = daos.Status(status)
+			}
+			if err != nil {
+				errMsgs = append(errMsgs, errors.Wrapf(err, "reintegrate into pool %s", poolUUID).Error())
+			}
+		case verifying && state.rebuilding:
+			done = false
+		}
+	}
+
+	if len(errMsgs) > 0 {
+		return false, errors.New(strings.Join(errMsgs, ", "))
+	}
+	return done, nil
+}
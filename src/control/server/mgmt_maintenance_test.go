@@ -0,0 +1,508 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/drpc"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// newTestMaintenanceSvc returns a mgmtSvc with members 0-3 joined and
+// a single pool with targets on all ranks. The supplied maintenance records
+// are applied to the members with matching ranks.
+func newTestMaintenanceSvc(t *testing.T, log logging.Logger, maint map[uint32]*system.MemberMaintenance, resps ...*mockDrpcResponse) (*mgmtSvc, *mockDrpcClient) {
+	t.Helper()
+
+	svc := newTestMgmtSvc(t, log)
+	for i := uint32(0); i < 4; i++ {
+		m := system.MockMember(t, i, system.MemberStateJoined)
+		m.Maintenance = maint[i]
+		if err := svc.sysdb.AddMember(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ps := system.NewPoolService(test.MockPoolUUID(1), []uint64{1, 1}, []ranklist.Rank{0, 1, 2, 3})
+	ps.PoolLabel = "pool-a"
+	ps.State = system.PoolServiceStateReady
+	ps.Replicas = []ranklist.Rank{0}
+	addTestPoolService(t, svc.sysdb, ps)
+
+	cfg := new(mockDrpcClientConfig)
+	cfg.setSendMsgResponseList(t, resps...)
+	mdc := newMockDrpcClient(cfg)
+	svc.harness.instances[0].(*EngineInstance).setDrpcClient(mdc)
+
+	return svc, mdc
+}
+
+func TestServer_MgmtSvc_SystemMaintenance(t *testing.T) {
+	addr := func(idx uint32) string {
+		return system.MockControlAddr(t, idx).String()
+	}
+	inPhase := func(phase system.MaintenancePhase, pools ...uuid.UUID) *system.MemberMaintenance {
+		return &system.MemberMaintenance{Phase: phase, Pools: pools}
+	}
+
+	for name, tc := range map[string]struct {
+		maint   map[uint32]*system.MemberMaintenance
+		req     *mgmtpb.SystemMaintenanceReq
+		expResp *mgmtpb.SystemMaintenanceResp
+		expErr  error
+	}{
+		"nil req": {
+			req:    (*mgmtpb.SystemMaintenanceReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"not system leader": {
+			req:    &mgmtpb.SystemMaintenanceReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"start without hosts or ranks": {
+			req:    &mgmtpb.SystemMaintenanceReq{Action: mgmtpb.SystemMaintenanceReq_START},
+			expErr: errors.New("no hosts or ranks"),
+		},
+		"invalid ranks": {
+			req: &mgmtpb.SystemMaintenanceReq{
+				Ranks:  "41",
+				Action: mgmtpb.SystemMaintenanceReq_START,
+			},
+			expErr: errors.New("invalid rank(s): 41"),
+		},
+		"status of all members; none in maintenance": {
+			req:     &mgmtpb.SystemMaintenanceReq{},
+			expResp: &mgmtpb.SystemMaintenanceResp{},
+		},
+		"status of all members": {
+			maint: map[uint32]*system.MemberMaintenance{
+				2: inPhase(system.MaintenancePhaseServiceable, test.MockPoolUUID(1)),
+			},
+			req: &mgmtpb.SystemMaintenanceReq{},
+			expResp: &mgmtpb.SystemMaintenanceResp{
+				Members: []*mgmtpb.MaintenanceMember{
+					{
+						Rank:  2,
+						Addr:  addr(2),
+						State: "joined",
+						Phase: "serviceable",
+						Pools: []string{test.MockPoolUUID(1).String()},
+					},
+				},
+			},
+		},
+		"status of requested ranks": {
+			maint: map[uint32]*system.MemberMaintenance{
+				2: inPhase(system.MaintenancePhaseDraining),
+			},
+			req: &mgmtpb.SystemMaintenanceReq{Ranks: "1-2"},
+			expResp: &mgmtpb.SystemMaintenanceResp{
+				Members: []*mgmtpb.MaintenanceMember{
+					{Rank: 1, Addr: addr(1), State: "joined", Phase: "none"},
+					{Rank: 2, Addr: addr(2), State: "joined", Phase: "draining"},
+				},
+			},
+		},
+		"start": {
+			maint: map[uint32]*system.MemberMaintenance{
+				2: inPhase(system.MaintenancePhaseRebuilding),
+			},
+			req: &mgmtpb.SystemMaintenanceReq{
+				Ranks:  "1-2",
+				Action: mgmtpb.SystemMaintenanceReq_START,
+			},
+			expResp: &mgmtpb.SystemMaintenanceResp{
+				Members: []*mgmtpb.MaintenanceMember{
+					{Rank: 1, Addr: addr(1), State: "joined", Phase: "draining"},
+					{Rank: 2, Addr: addr(2), State: "joined", Phase: "rebuilding"},
+				},
+			},
+		},
+		"start on finishing rank": {
+			maint: map[uint32]*system.MemberMaintenance{
+				2: inPhase(system.MaintenancePhaseVerifying),
+			},
+			req: &mgmtpb.SystemMaintenanceReq{
+				Ranks:  "1-2",
+				Action: mgmtpb.SystemMaintenanceReq_START,
+			},
+			expErr: errors.New("rank 2 is being finished"),
+		},
+		"finish rank not in maintenance": {
+			maint: map[uint32]*system.MemberMaintenance{
+				2: inPhase(system.MaintenancePhaseServiceable),
+			},
+			req: &mgmtpb.SystemMaintenanceReq{
+				Ranks:  "1-2",
+				Action: mgmtpb.SystemMaintenanceReq_FINISH,
+			},
+			expErr: errors.New("rank 1 is not undergoing maintenance"),
+		},
+		"finish": {
+			maint: map[uint32]*system.MemberMaintenance{
+				0: inPhase(system.MaintenancePhaseRebuilding),
+				1: inPhase(system.MaintenancePhaseServiceable),
+				2: inPhase(system.MaintenancePhaseVerifying),
+			},
+			req: &mgmtpb.SystemMaintenanceReq{
+				Ranks:  "0-2",
+				Action: mgmtpb.SystemMaintenanceReq_FINISH,
+			},
+			expResp: &mgmtpb.SystemMaintenanceResp{
+				Members: []*mgmtpb.MaintenanceMember{
+					{Rank: 0, Addr: addr(0), State: "joined", Phase: "reintegrating"},
+					{Rank: 1, Addr: addr(1), State: "joined", Phase: "starting"},
+					{Rank: 2, Addr: addr(2), State: "joined", Phase: "verifying"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc, _ := newTestMaintenanceSvc(t, log, tc.maint)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			gotResp, gotErr := svc.SystemMaintenance(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			for _, m := range gotResp.Members {
+				m.LastUpdate = ""
+			}
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_MaintenanceRanksRefused(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	svc, _ := newTestMaintenanceSvc(t, log, map[uint32]*system.MemberMaintenance{
+		1: {Phase: system.MaintenancePhaseServiceable},
+	})
+	sys := build.DefaultSystemName
+	expErr := FaultRanksInMaintenance(ranklist.MustCreateRankSet("1"))
+
+	_, err := svc.PoolDrain(test.Context(t), &mgmtpb.PoolDrainReq{Sys: sys, Id: test.MockUUID(1), Rank: 1})
+	test.CmpErr(t, expErr, err)
+
+	_, err = svc.PoolReintegrate(test.Context(t), &mgmtpb.PoolReintegrateReq{Sys: sys, Id: test.MockUUID(1), Rank: 1})
+	test.CmpErr(t, expErr, err)
+
+	_, err = svc.SystemDrain(test.Context(t), &mgmtpb.SystemDrainReq{Sys: sys, Ranks: "0-1"})
+	test.CmpErr(t, expErr, err)
+
+	_, err = svc.SystemExclude(test.Context(t), &mgmtpb.SystemExcludeReq{Sys: sys, Ranks: "1"})
+	test.CmpErr(t, expErr, err)
+
+	// Ranks in maintenance are silently skipped by full-system requests.
+	req := &mgmtpb.SystemStopReq{Sys: sys}
+	fReq, _, err := svc.getFanout(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.filterMaintenanceRanks(req, fReq); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "0,2-3", fReq.Ranks.String(), "unexpected fanout ranks")
+
+	// Ranks in maintenance are not used for new pools.
+	err = svc.resolvePoolCreatePlacement(&mgmtpb.PoolCreateReq{Numranks: 4})
+	test.CmpErr(t, FaultPoolInvalidNumRanks(4, 3), err)
+	err = svc.resolvePoolCreatePlacement(&mgmtpb.PoolCreateReq{Ranks: []uint32{0, 1}})
+	test.CmpErr(t, expErr, err)
+}
+
+func TestServer_MgmtSvc_stepMemberMaintenance(t *testing.T) {
+	drpcSuccess := func(msg proto.Message) *mockDrpcResponse {
+		return &mockDrpcResponse{Status: drpc.Status_SUCCESS, Message: msg}
+	}
+	queryResp := func(disabled string, state mgmtpb.PoolRebuildStatus_State) *mockDrpcResponse {
+		return drpcSuccess(&mgmtpb.PoolQueryResp{
+			DisabledRanks: disabled,
+			Rebuild:       &mgmtpb.PoolRebuildStatus{State: state},
+		})
+	}
+	poolMapResp := func(enabled string) *mockDrpcResponse {
+		return drpcSuccess(&mgmtpb.PoolQueryResp{EnabledRanks: enabled})
+	}
+	pools := []uuid.UUID{test.MockPoolUUID(1)}
+
+	for name, tc := range map[string]struct {
+		maint      *system.MemberMaintenance
+		state      system.MemberState
+		drpcResps  []*mockDrpcResponse
+		expMethods []drpc.Method
+		expMaint   *system.MemberMaintenance
+	}{
+		"draining": {
+			maint: &system.MemberMaintenance{Phase: system.MaintenancePhaseDraining},
+			drpcResps: []*mockDrpcResponse{
+				poolMapResp("0-3"),
+				drpcSuccess(&mgmtpb.PoolDrainResp{}),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery, drpc.MethodPoolDrain},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseRebuilding,
+				Pools: pools,
+			},
+		},
+		"rank not in pool map": {
+			maint: &system.MemberMaintenance{Phase: system.MaintenancePhaseDraining},
+			drpcResps: []*mockDrpcResponse{
+				poolMapResp("0,2-3"),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseRebuilding,
+			},
+		},
+		"pool query fails": {
+			maint: &system.MemberMaintenance{Phase: system.MaintenancePhaseDraining},
+			drpcResps: []*mockDrpcResponse{
+				drpcSuccess(&mgmtpb.PoolQueryResp{Status: daos.Busy.Int32()}),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseDraining,
+				Error: "query pool " + pools[0].String() + ": " + daos.Busy.Error(),
+			},
+		},
+		"drain fails": {
+			maint: &system.MemberMaintenance{Phase: system.MaintenancePhaseDraining},
+			drpcResps: []*mockDrpcResponse{
+				poolMapResp("0-3"),
+				drpcSuccess(&mgmtpb.PoolDrainResp{Status: daos.Busy.Int32()}),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery, drpc.MethodPoolDrain},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseDraining,
+				Error: "drain from pool " + pools[0].String() + ": " + daos.Busy.Error(),
+			},
+		},
+		"already drained": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseDraining,
+				Pools: pools,
+			},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseRebuilding,
+				Pools: pools,
+			},
+		},
+		"rebuild in progress": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseRebuilding,
+				Pools: pools,
+			},
+			drpcResps: []*mockDrpcResponse{
+				queryResp("", mgmtpb.PoolRebuildStatus_BUSY),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseRebuilding,
+				Pools: pools,
+			},
+		},
+		"rebuild complete": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseRebuilding,
+				Pools: pools,
+			},
+			drpcResps: []*mockDrpcResponse{
+				queryResp("1", mgmtpb.PoolRebuildStatus_DONE),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseStopping,
+				Pools: pools,
+			},
+		},
+		"already stopped": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseStopping,
+				Pools: pools,
+			},
+			state: system.MemberStateStopped,
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseServiceable,
+				Pools: pools,
+			},
+		},
+		"serviceable": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseServiceable,
+				Pools: pools,
+			},
+			state: system.MemberStateStopped,
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseServiceable,
+				Pools: pools,
+			},
+		},
+		"waiting for rank to join": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseStarting,
+				Pools: pools,
+			},
+			state: system.MemberStateReady,
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseStarting,
+				Pools: pools,
+			},
+		},
+		"rank joined": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseStarting,
+				Pools: pools,
+			},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseReintegrating,
+				Pools: pools,
+			},
+		},
+		"reintegrate": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseReintegrating,
+				Pools: pools,
+			},
+			drpcResps: []*mockDrpcResponse{
+				queryResp("1", mgmtpb.PoolRebuildStatus_DONE),
+				drpcSuccess(&mgmtpb.PoolReintegrateResp{}),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery, drpc.MethodPoolReintegrate},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseReintegrating,
+				Pools: pools,
+			},
+		},
+		"reintegrated": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseReintegrating,
+				Pools: pools,
+			},
+			drpcResps: []*mockDrpcResponse{
+				queryResp("", mgmtpb.PoolRebuildStatus_BUSY),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseVerifying,
+				Pools: pools,
+			},
+		},
+		"verifying; rebuild in progress": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseVerifying,
+				Pools: pools,
+			},
+			drpcResps: []*mockDrpcResponse{
+				queryResp("", mgmtpb.PoolRebuildStatus_BUSY),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseVerifying,
+				Pools: pools,
+			},
+		},
+		"verifying; rebuild failed": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseVerifying,
+				Pools: pools,
+			},
+			drpcResps: []*mockDrpcResponse{
+				drpcSuccess(&mgmtpb.PoolQueryResp{
+					Rebuild: &mgmtpb.PoolRebuildStatus{
+						Status: daos.NoSpace.Int32(),
+						State:  mgmtpb.PoolRebuildStatus_DONE,
+					},
+				}),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery},
+			expMaint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseVerifying,
+				Pools: pools,
+				Error: "pool " + pools[0].String() + " rebuild failed: " + daos.NoSpace.Error(),
+			},
+		},
+		"verified": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseVerifying,
+				Pools: pools,
+			},
+			drpcResps: []*mockDrpcResponse{
+				queryResp("", mgmtpb.PoolRebuildStatus_DONE),
+			},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery},
+		},
+		"pool destroyed": {
+			maint: &system.MemberMaintenance{
+				Phase: system.MaintenancePhaseVerifying,
+				Pools: []uuid.UUID{test.MockPoolUUID(2)},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc, mdc := newTestMaintenanceSvc(t, log,
+				map[uint32]*system.MemberMaintenance{1: tc.maint}, tc.drpcResps...)
+
+			m, err := svc.sysdb.FindMemberByRank(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tc.state != 0 {
+				m.State = tc.state
+				if err := svc.sysdb.UpdateMember(m); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if err := svc.stepMemberMaintenance(test.Context(t), m); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := svc.sysdb.FindMemberByRank(1)
+			if err != nil {
+				t.Fatal(err)
+			}
+			gotMaint := got.Maintenance.Copy()
+			if gotMaint != nil {
+				gotMaint.LastUpdate = tc.maint.LastUpdate
+			}
+			if diff := cmp.Diff(tc.expMaint, gotMaint); diff != "" {
+				t.Fatalf("unexpected maintenance record (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expMethods, mdc.CalledMethods()); diff != "" {
+				t.Fatalf("unexpected dRPC calls (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
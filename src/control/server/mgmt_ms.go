@@ -0,0 +1,217 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"net"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/control"
+)
+
+// resolveReplicaHost resolves the supplied host to a control plane address. If
+// no port is specified, the port of the local replica is assumed.
+func (svc *mgmtSvc) resolveReplicaHost(host string, lookup ipLookupFn) (*net.TCPAddr, error) {
+	if host == "" {
+		return nil, errors.New("replica host must be specified")
+	}
+
+	repAddr, err := svc.sysdb.ReplicaAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	h, p, err := common.SplitPort(host, repAddr.Port)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid replica host %q", host)
+	}
+
+	return resolveFirstAddr(net.JoinHostPort(h, p), lookup)
+}
+
+// replicasResp returns the current replica set and leader.
+func (svc *mgmtSvc) replicasResp() (*mgmtpb.SystemReplicasResp, error) {
+	leader, replicas, err := svc.sysdb.LeaderQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.SystemReplicasResp{
+		Replicas: replicas,
+		Leader:   leader,
+	}, nil
+}
+
+// notifyReplicas sends the supplied replica set to the control plane
+// instance at the supplied address.
+func (svc *mgmtSvc) notifyReplicas(ctx context.Context, addr *net.TCPAddr, replicas []string) error {
+	req := &control.SetReplicasReq{Replicas: replicas}
+	req.SetSystem(svc.sysdb.SystemName())
+	req.SetHostList([]string{addr.String()})
+
+	return control.SetReplicas(ctx, svc.rpcClient, req)
+}
+
+// SystemAddReplica adds a system member's control plane instance to the set
+// of MS replicas. The new replica is started before it is added as a raft voter
+// so that it is able to participate in the updated quorum immediately.
+func (svc *mgmtSvc) SystemAddReplica(ctx context.Context, req *mgmtpb.SystemReplicaReq) (*mgmtpb.SystemReplicasResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	addr, err := svc.resolveReplicaHost(req.GetHost(), net.LookupIP)
+	if err != nil {
+		return nil, err
+	}
+
+	curReplicas := svc.sysdb.ReplicaAddrs()
+	for _, rep := range curReplicas {
+		if rep == addr.String() {
+			return nil, errors.Errorf("%s is already a replica", addr)
+		}
+	}
+	if _, err := svc.sysdb.FindMembersByAddr(addr); err != nil {
+		return nil, errors.Wrap(err, "replica must be a system member")
+	}
+
+	svc.log.Noticef("adding %s as MS replica", addr)
+	newReplicas := append(append([]string{}, curReplicas...), addr.String())
+	if err := svc.notifyReplicas(ctx, addr, newReplicas); err != nil {
+		return nil, errors.Wrapf(err, "failed to start replica on %s", addr)
+	}
+
+	if err := svc.sysdb.AddReplica(addr); err != nil {
+		if rbErr := svc.notifyReplicas(ctx, addr, curReplicas); rbErr != nil {
+			svc.log.Errorf("failed to revert replica set on %s: %s", addr, rbErr)
+		}
+		return nil, err
+	}
+
+	return svc.replicasResp()
+}
+
+// SystemRemoveReplica removes a control plane instance from the set of MS
+// replicas, provided that the remaining replicas are able to maintain quorum.
+func (svc *mgmtSvc) SystemRemoveReplica(ctx context.Context, req *mgmtpb.SystemReplicaReq) (*mgmtpb.SystemReplicasResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	addr, err := svc.resolveReplicaHost(req.GetHost(), net.LookupIP)
+	if err != nil {
+		return nil, err
+	}
+
+	svc.log.Noticef("removing %s as MS replica", addr)
+	if err := svc.sysdb.RemoveReplica(addr); err != nil {
+		return nil, err
+	}
+
+	resp, err := svc.replicasResp()
+	if err != nil {
+		return nil, err
+	}
+
+	// The removed replica may be unavailable, in which case it will pick
+	// up the change the next time it contacts the MS.
+	if err := svc.notifyReplicas(ctx, addr, resp.Replicas); err != nil {
+		svc.log.Errorf("failed to notify %s of replica removal: %s", addr, err)
+	}
+
+	return resp, nil
+}
+
+// SystemListReplicas lists the current set of MS replicas.
+func (svc *mgmtSvc) SystemListReplicas(ctx context.Context, req *mgmtpb.SystemListReplicasReq) (*mgmtpb.SystemReplicasResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	return svc.replicasResp()
+}
+
+// SetReplicas handles a notification from the MS leader that the replica set
+// has changed, starting or stopping the local replica as necessary.
+func (svc *mgmtSvc) SetReplicas(ctx context.Context, req *mgmtpb.SetReplicasReq) (*mgmtpb.DaosResp, error) {
+	if err := svc.checkSystemRequest(req); err != nil {
+		return nil, err
+	}
+
+	// Process the request serially with the server's long-lived context,
+	// as a newly-started replica must outlive this request.
+	resp, err := svc.submitSerialRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	daosResp, ok := resp.(*mgmtpb.DaosResp)
+	if !ok {
+		return nil, errors.Errorf("unexpected response type %T", resp)
+	}
+
+	return daosResp, nil
+}
+
+func (svc *mgmtSvc) setReplicas(ctx context.Context, req *mgmtpb.SetReplicasReq) (*mgmtpb.DaosResp, error) {
+	svc.log.Debugf("updating MS replicas: %v", req.GetReplicas())
+	if err := svc.sysdb.SetReplicas(ctx, req.GetReplicas()); err != nil {
+		return nil, err
+	}
+
+	return new(mgmtpb.DaosResp), nil
+}
+
+// SystemLeaderTransfer transfers MS leadership from this replica to another
+// replica. If no target is specified, the most up-to-date replica is chosen.
+func (svc *mgmtSvc) SystemLeaderTransfer(ctx context.Context, req *mgmtpb.SystemLeaderTransferReq) (*mgmtpb.SystemLeaderTransferResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	var target *net.TCPAddr
+	if req.GetTarget() != "" {
+		addr, err := svc.resolveReplicaHost(req.GetTarget(), net.LookupIP)
+		if err != nil {
+			return nil, err
+		}
+		target = addr
+	}
+
+	oldLeader, err := svc.sysdb.ReplicaAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	if target != nil {
+		svc.log.Noticef("transferring MS leadership from %s to %s", oldLeader, target)
+	} else {
+		svc.log.Noticef("transferring MS leadership from %s", oldLeader)
+	}
+	if err := svc.sysdb.TransferLeadership(target); err != nil {
+		return nil, err
+	}
+
+	newLeader, replicas, err := svc.sysdb.LeaderQuery()
+	if err != nil {
+		return nil, err
+	}
+	if newLeader == oldLeader.String() {
+		// The new leader is not yet known.
+		newLeader = ""
+	}
+
+	return &mgmtpb.SystemLeaderTransferResp{
+		OldLeader: oldLeader.String(),
+		NewLeader: newLeader,
+		Replicas:  replicas,
+	}, nil
+}
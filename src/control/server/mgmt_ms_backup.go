@@ -0,0 +1,150 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+var errBackupsNotConfigured = errors.New("management service database backups are not configured (ms_backup.path)")
+
+func backupToPB(bd *raft.BackupDetails) *mgmtpb.SystemBackup {
+	return &mgmtpb.SystemBackup{
+		Name:     bd.Name,
+		Path:     bd.Path,
+		Time:     bd.Time.Format(time.RFC3339),
+		Index:    bd.Index,
+		Term:     bd.Term,
+		Size:     bd.Size,
+		Checksum: bd.Checksum,
+		Error:    bd.Error,
+	}
+}
+
+// backupSystemDB takes a backup of the system database and then removes the
+// oldest backups in excess of the configured retention count.
+func (svc *mgmtSvc) backupSystemDB() (*raft.BackupDetails, []string, error) {
+	if !svc.backupCfg.Enabled() {
+		return nil, nil, errBackupsNotConfigured
+	}
+
+	svc.backupMu.Lock()
+	defer svc.backupMu.Unlock()
+
+	bd, err := svc.sysdb.Backup(svc.backupCfg.Path)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to back up system database")
+	}
+	svc.log.Noticef("system database backup written to %s", bd.Path)
+
+	removed, err := raft.PruneBackups(svc.backupCfg.Path, svc.backupCfg.GetRetain())
+	if err != nil {
+		svc.log.Errorf("failed to prune system database backups: %s", err)
+	}
+	for _, path := range removed {
+		svc.log.Debugf("removed expired system database backup %s", path)
+	}
+
+	return bd, removed, nil
+}
+
+// nextBackupDelay returns the time remaining until the next scheduled backup,
+// based on the time of the most recent valid backup.
+func (svc *mgmtSvc) nextBackupDelay(now time.Time) time.Duration {
+	backups, err := raft.ListBackups(svc.backupCfg.Path)
+	if err != nil {
+		svc.log.Errorf("failed to list system database backups: %s", err)
+		return 0
+	}
+
+	for i := len(backups) - 1; i >= 0; i-- {
+		if !backups[i].Valid() {
+			continue
+		}
+		if delay := backups[i].Time.Add(svc.backupCfg.GetInterval()).Sub(now); delay > 0 {
+			return delay
+		}
+		break
+	}
+
+	return 0
+}
+
+// backupLoop periodically takes backups of the system database while this
+// instance is the MS leader.
+func (svc *mgmtSvc) backupLoop(parent context.Context) {
+	timer := time.NewTimer(svc.nextBackupDelay(time.Now()))
+	defer timer.Stop()
+
+	svc.log.Debug("starting backupLoop")
+	for {
+		select {
+		case <-parent.Done():
+			svc.log.Debug("stopped backupLoop")
+			return
+		case <-timer.C:
+			if _, _, err := svc.backupSystemDB(); err != nil {
+				svc.log.Errorf("scheduled backup failed: %s", err)
+			}
+			timer.Reset(svc.backupCfg.GetInterval())
+		}
+	}
+}
+
+// SystemBackup takes a backup of the system database on the MS leader.
+func (svc *mgmtSvc) SystemBackup(ctx context.Context, req *mgmtpb.SystemBackupReq) (*mgmtpb.SystemBackupResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	bd, removed, err := svc.backupSystemDB()
+	if err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.SystemBackupResp{
+		Backup:  backupToPB(bd),
+		Removed: removed,
+	}, nil
+}
+
+// SystemListBackups lists the system database backups held by the MS leader.
+func (svc *mgmtSvc) SystemListBackups(ctx context.Context, req *mgmtpb.SystemListBackupsReq) (*mgmtpb.SystemListBackupsResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if !svc.backupCfg.Enabled() {
+		return nil, errBackupsNotConfigured
+	}
+
+	leader, _, err := svc.sysdb.LeaderQuery()
+	if err != nil {
+		return nil, err
+	}
+
+	backups, err := raft.ListBackups(svc.backupCfg.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.SystemListBackupsResp{
+		Leader: leader,
+		Dir:    svc.backupCfg.Path,
+	}
+	for _, bd := range backups {
+		resp.Backups = append(resp.Backups, backupToPB(bd))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,165 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
+)
+
+func TestServer_MgmtSvc_SystemBackup(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nilPath        bool
+		retain         int
+		oldBackups     []string
+		invalidBackups []string
+		req            *mgmtpb.SystemBackupReq
+		expRemoved     []string
+		expErr         error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"not configured": {
+			nilPath: true,
+			req:     &mgmtpb.SystemBackupReq{Sys: build.DefaultSystemName},
+			expErr:  errBackupsNotConfigured,
+		},
+		"success": {
+			req: &mgmtpb.SystemBackupReq{Sys: build.DefaultSystemName},
+		},
+		"oldest backups removed": {
+			retain: 2,
+			oldBackups: []string{
+				"backup-20200101T000000Z-1-1",
+				"backup-20200102T000000Z-1-2",
+			},
+			req:        &mgmtpb.SystemBackupReq{Sys: build.DefaultSystemName},
+			expRemoved: []string{"backup-20200101T000000Z-1-1"},
+		},
+		"invalid backups not retained": {
+			retain: 2,
+			oldBackups: []string{
+				"backup-20200102T000000Z-1-2",
+			},
+			invalidBackups: []string{
+				"backup-20200101T000000Z-1-1",
+				"backup-20200103T000000Z-1-3",
+			},
+			req:        &mgmtpb.SystemBackupReq{Sys: build.DefaultSystemName},
+			expRemoved: []string{"backup-20200101T000000Z-1-1"},
+		},
+		"invalid backups older than retained removed": {
+			retain: 1,
+			invalidBackups: []string{
+				"backup-20200101T000000Z-1-1",
+			},
+			req:        &mgmtpb.SystemBackupReq{Sys: build.DefaultSystemName},
+			expRemoved: []string{"backup-20200101T000000Z-1-1"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			for _, invalid := range tc.invalidBackups {
+				if err := os.Mkdir(filepath.Join(testDir, invalid), 0700); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			svc := newTestMgmtSvc(t, log)
+			svc.backupCfg = config.MSBackupConfig{Retain: tc.retain}
+			if !tc.nilPath {
+				svc.backupCfg.Path = testDir
+			}
+
+			// Create valid old backups by renaming new ones.
+			for _, old := range tc.oldBackups {
+				svc.backupCfg.Retain = len(tc.oldBackups) + len(tc.invalidBackups) + 1
+				resp, err := svc.SystemBackup(test.Context(t), tc.req)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if err := os.Rename(resp.GetBackup().GetPath(), filepath.Join(testDir, old)); err != nil {
+					t.Fatal(err)
+				}
+				svc.backupCfg.Retain = tc.retain
+			}
+
+			gotResp, gotErr := svc.SystemBackup(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			bd := gotResp.GetBackup()
+			test.AssertEqual(t, "", bd.GetError(), "unexpected backup error")
+			test.AssertEqual(t, filepath.Join(testDir, bd.GetName()), bd.GetPath(), "unexpected backup path")
+			if _, err := os.Stat(bd.GetPath()); err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, len(tc.expRemoved), len(gotResp.GetRemoved()), "unexpected removed backups")
+			for i, removed := range tc.expRemoved {
+				test.AssertEqual(t, filepath.Join(testDir, removed), gotResp.GetRemoved()[i], "unexpected removed backup")
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemListBackups(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	svc := newTestMgmtSvc(t, log)
+	req := &mgmtpb.SystemListBackupsReq{Sys: build.DefaultSystemName}
+
+	if _, err := svc.SystemListBackups(test.Context(t), req); err != errBackupsNotConfigured {
+		t.Fatalf("expected %v, got %v", errBackupsNotConfigured, err)
+	}
+
+	svc.backupCfg = config.MSBackupConfig{Path: testDir}
+	if err := os.Mkdir(filepath.Join(testDir, "backup-20200101T000000Z-1-1"), 0700); err != nil {
+		t.Fatal(err)
+	}
+	backupResp, err := svc.SystemBackup(test.Context(t), &mgmtpb.SystemBackupReq{Sys: build.DefaultSystemName})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resp, err := svc.SystemListBackups(test.Context(t), req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, testDir, resp.GetDir(), "unexpected backup dir")
+	test.AssertEqual(t, 2, len(resp.GetBackups()), "unexpected number of backups")
+
+	invalid := resp.GetBackups()[0]
+	test.AssertEqual(t, "backup-20200101T000000Z-1-1", invalid.GetName(), "unexpected oldest backup")
+	test.AssertTrue(t, strings.Contains(invalid.GetError(), "SHA256SUMS"), "expected invalid backup: "+invalid.GetError())
+
+	valid := resp.GetBackups()[1]
+	test.AssertEqual(t, backupResp.GetBackup().GetName(), valid.GetName(), "unexpected newest backup")
+	test.AssertEqual(t, "", valid.GetError(), "unexpected error for valid backup")
+}
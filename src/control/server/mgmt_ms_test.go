@@ -0,0 +1,292 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"net"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+	"github.com/daos-stack/daos/src/control/system/raft"
+)
+
+func newTestReplicaMgmtSvc(t *testing.T, log logging.Logger, members []*system.Member, replicas ...*net.TCPAddr) *mgmtSvc {
+	t.Helper()
+
+	svc := newTestMgmtSvc(t, log)
+	svc.sysdb = raft.MockDatabaseWithCfg(t, log, &raft.DatabaseConfig{
+		SystemName: build.DefaultSystemName,
+		Replicas:   append([]*net.TCPAddr{common.LocalhostCtrlAddr()}, replicas...),
+	})
+	svc.membership = system.MockMembership(t, log, svc.sysdb, mockTCPResolver)
+	for _, m := range members {
+		if _, err := svc.membership.Add(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return svc
+}
+
+func mockSetReplicasResp(addr string, err error) *control.UnaryResponse {
+	hr := &control.HostResponse{Addr: addr, Error: err}
+	if err == nil {
+		hr.Message = &mgmtpb.DaosResp{}
+	}
+	return &control.UnaryResponse{Responses: []*control.HostResponse{hr}}
+}
+
+func TestServer_MgmtSvc_SystemAddReplica(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+	m2 := system.MockMember(t, 2, system.MemberStateJoined)
+	m4 := system.MockMember(t, 4, system.MemberStateJoined)
+
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.SystemReplicaReq
+		setResp *control.UnaryResponse
+		expResp *mgmtpb.SystemReplicasResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.SystemReplicaReq{Sys: "quack", Host: "127.0.0.4"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"missing host": {
+			req:    &mgmtpb.SystemReplicaReq{Sys: build.DefaultSystemName},
+			expErr: errors.New("must be specified"),
+		},
+		"already a replica": {
+			req:    &mgmtpb.SystemReplicaReq{Sys: build.DefaultSystemName, Host: "127.0.0.2"},
+			expErr: errors.New("already a replica"),
+		},
+		"not a member": {
+			req:    &mgmtpb.SystemReplicaReq{Sys: build.DefaultSystemName, Host: "127.0.0.5"},
+			expErr: errors.New("must be a system member"),
+		},
+		"replica fails to start": {
+			req:     &mgmtpb.SystemReplicaReq{Sys: build.DefaultSystemName, Host: "127.0.0.4"},
+			setResp: mockSetReplicasResp(m4.Addr.String(), errors.New("no scm")),
+			expErr:  errors.New("no scm"),
+		},
+		"success": {
+			req:     &mgmtpb.SystemReplicaReq{Sys: build.DefaultSystemName, Host: "127.0.0.4"},
+			setResp: mockSetReplicasResp(m4.Addr.String(), nil),
+			expResp: &mgmtpb.SystemReplicasResp{
+				Replicas: []string{local.String(), m2.Addr.String(), m4.Addr.String()},
+			},
+		},
+		"success with port": {
+			req:     &mgmtpb.SystemReplicaReq{Sys: build.DefaultSystemName, Host: m4.Addr.String()},
+			setResp: mockSetReplicasResp(m4.Addr.String(), nil),
+			expResp: &mgmtpb.SystemReplicasResp{
+				Replicas: []string{local.String(), m2.Addr.String(), m4.Addr.String()},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestReplicaMgmtSvc(t, log, []*system.Member{m2, m4}, m2.Addr)
+			svc.rpcClient = control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: tc.setResp,
+			})
+
+			gotResp, gotErr := svc.SystemAddReplica(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemRemoveReplica(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+	m2 := system.MockMember(t, 2, system.MemberStateJoined)
+	m3 := system.MockMember(t, 3, system.MemberStateStopped)
+
+	for name, tc := range map[string]struct {
+		req     *mgmtpb.SystemReplicaReq
+		setResp *control.UnaryResponse
+		expResp *mgmtpb.SystemReplicasResp
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"leader": {
+			req:    &mgmtpb.SystemReplicaReq{Sys: build.DefaultSystemName, Host: local.String()},
+			expErr: errors.New("transfer leadership"),
+		},
+		"quorum would be lost": {
+			req:    &mgmtpb.SystemReplicaReq{Sys: build.DefaultSystemName, Host: "127.0.0.2"},
+			expErr: errors.New("quorum"),
+		},
+		"success": {
+			req:     &mgmtpb.SystemReplicaReq{Sys: build.DefaultSystemName, Host: "127.0.0.3"},
+			setResp: mockSetReplicasResp(m3.Addr.String(), nil),
+			expResp: &mgmtpb.SystemReplicasResp{
+				Replicas: []string{local.String(), m2.Addr.String()},
+			},
+		},
+		"success with removed replica unavailable": {
+			req:     &mgmtpb.SystemReplicaReq{Sys: build.DefaultSystemName, Host: "127.0.0.3"},
+			setResp: mockSetReplicasResp(m3.Addr.String(), errors.New("unreachable")),
+			expResp: &mgmtpb.SystemReplicasResp{
+				Replicas: []string{local.String(), m2.Addr.String()},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestReplicaMgmtSvc(t, log, []*system.Member{m2, m3}, m2.Addr, m3.Addr)
+			svc.rpcClient = control.NewMockInvoker(log, &control.MockInvokerConfig{
+				UnaryResponse: tc.setResp,
+			})
+
+			gotResp, gotErr := svc.SystemRemoveReplica(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemListReplicas(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+	m2 := system.MockMember(t, 2, system.MemberStateJoined)
+
+	for name, tc := range map[string]struct {
+		nonReplica bool
+		req        *mgmtpb.SystemListReplicasReq
+		expResp    *mgmtpb.SystemReplicasResp
+		expErr     error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"not a replica": {
+			nonReplica: true,
+			req:        &mgmtpb.SystemListReplicasReq{Sys: build.DefaultSystemName},
+			expErr:     errors.New("not a"),
+		},
+		"success": {
+			req: &mgmtpb.SystemListReplicasReq{Sys: build.DefaultSystemName},
+			expResp: &mgmtpb.SystemReplicasResp{
+				Replicas: []string{local.String(), m2.Addr.String()},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestReplicaMgmtSvc(t, log, []*system.Member{m2}, m2.Addr)
+			if tc.nonReplica {
+				svc.sysdb = raft.MockDatabaseWithAddr(t, log, nil)
+			}
+
+			gotResp, gotErr := svc.SystemListReplicas(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_SystemLeaderTransfer(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+	m2 := system.MockMember(t, 2, system.MemberStateJoined)
+	m3 := system.MockMember(t, 3, system.MemberStateJoined)
+
+	for name, tc := range map[string]struct {
+		replicas []*net.TCPAddr
+		req      *mgmtpb.SystemLeaderTransferReq
+		expResp  *mgmtpb.SystemLeaderTransferResp
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req:    &mgmtpb.SystemLeaderTransferReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"no other replicas": {
+			req:    &mgmtpb.SystemLeaderTransferReq{Sys: build.DefaultSystemName},
+			expErr: errors.New("no other"),
+		},
+		"target not a replica": {
+			replicas: []*net.TCPAddr{m2.Addr},
+			req:      &mgmtpb.SystemLeaderTransferReq{Sys: build.DefaultSystemName, Target: "127.0.0.3"},
+			expErr:   errors.New("not a"),
+		},
+		"any replica": {
+			replicas: []*net.TCPAddr{m2.Addr, m3.Addr},
+			req:      &mgmtpb.SystemLeaderTransferReq{Sys: build.DefaultSystemName},
+			expResp: &mgmtpb.SystemLeaderTransferResp{
+				OldLeader: local.String(),
+				Replicas:  []string{local.String(), m2.Addr.String(), m3.Addr.String()},
+			},
+		},
+		"named replica": {
+			replicas: []*net.TCPAddr{m2.Addr, m3.Addr},
+			req:      &mgmtpb.SystemLeaderTransferReq{Sys: build.DefaultSystemName, Target: "127.0.0.3"},
+			expResp: &mgmtpb.SystemLeaderTransferResp{
+				OldLeader: local.String(),
+				Replicas:  []string{local.String(), m2.Addr.String(), m3.Addr.String()},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestReplicaMgmtSvc(t, log, []*system.Member{m2, m3}, tc.replicas...)
+
+			gotResp, gotErr := svc.SystemLeaderTransfer(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			test.AssertFalse(t, svc.sysdb.IsLeader(), "leadership not transferred")
+		})
+	}
+}
@@ -242,100 +242,54 @@ func (svc *mgmtSvc) calculateCreateStorage(req *mgmtpb.PoolCreateReq) error {
 	return nil
 }
 
-// PoolCreate implements the method defined for the Management Service.
-//
-// NB: Only one pool create request may be processed at a time.
-func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *mgmtpb.PoolCreateReq) (*mgmtpb.PoolCreateResp, error) {
-	if err := svc.checkLeaderRequest(req); err != nil {
-		return nil, err
-	}
-
-	msg, err := svc.submitSerialRequest(ctx, req)
-	if err != nil {
-		return nil, err
-	}
-	return msg.(*mgmtpb.PoolCreateResp), nil
-}
-
-// poolCreate handles the actual pool creation request. This is separated from
-// PoolCreate() so that it can be called from the batch request handler.
-//
-// Validate minimum SCM/NVMe pool size per VOS target, pool size request params
-// are per-engine so need to be larger than (minimum_target_allocation *
-// target_count).
-func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq) (resp *mgmtpb.PoolCreateResp, err error) {
-	if err := svc.poolCreateAddSystemProps(req); err != nil {
-		return nil, err
-	}
-
-	poolUUID, err := uuid.Parse(req.GetUuid())
-	if err != nil {
-		return nil, errors.Wrapf(err, "failed to parse pool UUID %q", req.GetUuid())
-	}
-
-	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID)
-	if err != nil {
-		return nil, err
-	}
-	defer lock.Release()
-	ctx := lock.InContext(parent)
-
-	resp = new(mgmtpb.PoolCreateResp)
-	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
-	if ps != nil {
-		svc.log.Debugf("found pool %s state=%s", ps.PoolUUID, ps.State)
-		if ps.State != system.PoolServiceStateReady {
-			resp.Status = int32(daos.TryAgain)
-			return resp, svc.checkPools(ctx, false, ps)
-		}
-
-		// If the pool is already created and is Ready, just return the existing pool info.
-		// This can happen in the case of a retried PoolCreate after a leadership
-		// shuffle that results in the pool being successfully created by the previous
-		// gRPC handler which returned an error to the client after being unable to
-		// persist the state update.
-		qr, err := svc.PoolQuery(ctx, &mgmtpb.PoolQueryReq{Id: req.Uuid, Sys: req.Sys})
-		if err != nil {
-			return nil, errors.Wrap(err, "query on already-created pool failed")
-		}
-
-		resp.Leader = qr.Leader
-		resp.SvcReps = ranklist.RanksToUint32(ps.Replicas)
-		resp.TgtRanks = ranklist.RanksToUint32(ps.Storage.CreationRanks())
-		resp.TierBytes = ps.Storage.PerRankTierStorage
-		// TODO DAOS-14223: Store Meta-Blob-Size in sysdb.
-
-		return resp, nil
-	}
-	if _, ok := err.(*system.ErrPoolNotFound); !ok {
-		return nil, err
-	}
-
-	labelExists := false
-	var poolLabel string
+// checkPoolCreateLabel returns the label supplied in the pool create request
+// properties, verifying that it is set and not already in use.
+func (svc *mgmtSvc) checkPoolCreateLabel(req *mgmtpb.PoolCreateReq) (string, error) {
 	for _, prop := range req.GetProperties() {
 		if prop.Number != daos.PoolPropertyLabel {
 			continue
 		}
 
-		poolLabel = prop.GetStrval()
+		poolLabel := prop.GetStrval()
 		if poolLabel == "" {
 			break
 		}
 
-		labelExists = true
 		if _, err := svc.sysdb.FindPoolServiceByLabel(poolLabel); err == nil {
-			return nil, FaultPoolDuplicateLabel(poolLabel)
+			return "", FaultPoolDuplicateLabel(poolLabel)
 		}
+		return poolLabel, nil
 	}
 
-	if !labelExists {
-		return nil, FaultPoolNoLabel
-	}
+	return "", FaultPoolNoLabel
+}
 
-	allRanks, err := svc.sysdb.MemberRanks(system.AvailableMemberFilter)
+// resolvePoolCreatePlacement selects the target ranks for a pool create
+// request, populates the fault domain tree used for placement and determines
+// the amount of storage to allocate on each rank.
+func (svc *mgmtSvc) resolvePoolCreatePlacement(req *mgmtpb.PoolCreateReq) error {
+	availRanks, err := svc.sysdb.MemberRanks(system.AvailableMemberFilter)
 	if err != nil {
-		return nil, err
+		return err
+	}
+	maintMembers, err := svc.getMaintenanceMembers()
+	if err != nil {
+		return err
+	}
+
+	// Ranks undergoing maintenance are not used for new pools.
+	allRanks := make([]ranklist.Rank, 0, len(availRanks))
+	for _, r := range availRanks {
+		inMaint := false
+		for _, m := range maintMembers {
+			if m.Rank == r {
+				inMaint = true
+				break
+			}
+		}
+		if !inMaint {
+			allRanks = append(allRanks, r)
+		}
 	}
 
 	if len(req.GetRanks()) > 0 {
@@ -346,8 +300,11 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 		// Create a RankSet to sort/dedupe the ranks.
 		reqRanks = ranklist.RankSetFromRanks(reqRanks).Ranks()
 
+		if err := svc.checkMaintenanceRanks(reqRanks...); err != nil {
+			return err
+		}
 		if invalid := ranklist.CheckRankMembership(allRanks, reqRanks); len(invalid) > 0 {
-			return nil, FaultPoolInvalidRanks(invalid)
+			return FaultPoolInvalidRanks(invalid)
 		}
 
 		req.Ranks = ranklist.RanksToUint32(reqRanks)
@@ -361,7 +318,7 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 			nRanks = int(req.GetNumranks())
 
 			if nRanks > nAllRanks {
-				return nil, FaultPoolInvalidNumRanks(nRanks, nAllRanks)
+				return FaultPoolInvalidNumRanks(nRanks, nAllRanks)
 			}
 
 			// TODO (DAOS-6263): Improve rank selection algorithm.
@@ -382,7 +339,7 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 	}
 
 	if len(req.GetRanks()) == 0 {
-		return nil, errors.New("pool request contains zero target ranks")
+		return errors.New("pool request contains zero target ranks")
 	}
 
 	// Clamp the maximum allowed svc replicas to the smaller of requested
@@ -396,21 +353,167 @@ func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq
 
 	// If Numsvcreps is not specified, daos_engine will choose a value.
 	if req.GetNumsvcreps() > maxSvcReps {
-		return nil, FaultPoolInvalidServiceReps(maxSvcReps)
+		return FaultPoolInvalidServiceReps(maxSvcReps)
 	}
 
 	// IO engine needs the fault domain tree for placement purposes
 	req.FaultDomains, err = svc.membership.CompressedFaultDomainTree(req.Ranks...)
 	if err != nil {
+		return err
+	}
+
+	return svc.calculateCreateStorage(req)
+}
+
+// PoolCreate implements the method defined for the Management Service.
+//
+// NB: Only one pool create request may be processed at a time.
+func (svc *mgmtSvc) PoolCreate(ctx context.Context, req *mgmtpb.PoolCreateReq) (*mgmtpb.PoolCreateResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	msg, err := svc.submitSerialRequest(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return msg.(*mgmtpb.PoolCreateResp), nil
+}
+
+// PoolCreatePlan implements the method defined for the Management Service.
+//
+// Resolve the ranks and per-rank storage allocations that would be used to
+// fulfill a pool create request without creating the pool.
+func (svc *mgmtSvc) PoolCreatePlan(ctx context.Context, req *mgmtpb.PoolCreateReq) (*mgmtpb.PoolCreatePlanResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
 	}
+	svc.log.Debugf("MgmtSvc.PoolCreatePlan dispatch, req:%+v\n", req)
 
-	if err := svc.calculateCreateStorage(req); err != nil {
+	if _, err := svc.checkPoolCreateLabel(req); err != nil {
+		return nil, err
+	}
+	if _, err := system.ParsePoolTags(req.GetTags()); err != nil {
+		return nil, err
+	}
+	if err := svc.checkPoolCreateProfile(req); err != nil {
 		return nil, err
 	}
+	if err := svc.resolvePoolCreatePlacement(req); err != nil {
+		return nil, err
+	}
+
+	resp := &mgmtpb.PoolCreatePlanResp{
+		TgtRanks:     req.GetRanks(),
+		TierBytes:    req.GetTierbytes(),
+		MetaBlobSize: req.GetMetaBlobSize(),
+		TgtsPerRank:  uint32(svc.harness.Instances()[0].GetTargetCount()),
+	}
+
+	fdSet := make(map[string]struct{})
+	for _, rank := range ranklist.RanksFromUint32(req.GetRanks()) {
+		m, err := svc.membership.Get(rank)
+		if err != nil {
+			return nil, err
+		}
+		fd := m.FaultDomain.String()
+		if _, found := fdSet[fd]; found {
+			continue
+		}
+		fdSet[fd] = struct{}{}
+		resp.FaultDomains = append(resp.FaultDomains, fd)
+	}
+	sort.Strings(resp.FaultDomains)
+
+	return resp, nil
+}
+
+// poolCreate handles the actual pool creation request. This is separated from
+// PoolCreate() so that it can be called from the batch request handler.
+//
+// Validate minimum SCM/NVMe pool size per VOS target, pool size request params
+// are per-engine so need to be larger than (minimum_target_allocation *
+// target_count).
+func (svc *mgmtSvc) poolCreate(parent context.Context, req *mgmtpb.PoolCreateReq) (resp *mgmtpb.PoolCreateResp, err error) {
+	if err := svc.poolCreateAddSystemProps(req); err != nil {
+		return nil, err
+	}
+
+	poolUUID, err := uuid.Parse(req.GetUuid())
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse pool UUID %q", req.GetUuid())
+	}
+
+	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx := lock.InContext(parent)
+
+	resp = new(mgmtpb.PoolCreateResp)
+	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
+	if ps != nil {
+		svc.log.Debugf("found pool %s state=%s", ps.PoolUUID, ps.State)
+		if ps.State != system.PoolServiceStateReady {
+			resp.Status = int32(daos.TryAgain)
+			return resp, svc.checkPools(ctx, false, ps)
+		}
+
+		// If the pool is already created and is Ready, just return the existing pool info.
+		// This can happen in the case of a retried PoolCreate after a leadership
+		// shuffle that results in the pool being successfully created by the previous
+		// gRPC handler which returned an error to the client after being unable to
+		// persist the state update.
+		qr, err := svc.PoolQuery(ctx, &mgmtpb.PoolQueryReq{Id: req.Uuid, Sys: req.Sys})
+		if err != nil {
+			return nil, errors.Wrap(err, "query on already-created pool failed")
+		}
+
+		resp.Leader = qr.Leader
+		resp.SvcReps = ranklist.RanksToUint32(ps.Replicas)
+		resp.TgtRanks = ranklist.RanksToUint32(ps.Storage.CreationRanks())
+		resp.TierBytes = ps.Storage.PerRankTierStorage
+		// TODO DAOS-14223: Store Meta-Blob-Size in sysdb.
+
+		return resp, nil
+	}
+	if _, ok := err.(*system.ErrPoolNotFound); !ok {
+		return nil, err
+	}
+
+	poolLabel, err := svc.checkPoolCreateLabel(req)
+	if err != nil {
+		return nil, err
+	}
+
+	// Tags are recorded by the MS and are not forwarded to the engine.
+	poolTags, err := system.ParsePoolTags(req.GetTags())
+	if err != nil {
+		return nil, err
+	}
+	req.Tags = nil
+
+	// The profile is only used by the MS to check the requested ranks.
+	if err := svc.checkPoolCreateProfile(req); err != nil {
+		return nil, err
+	}
+	req.Profile = ""
+
+	if err := svc.resolvePoolCreatePlacement(req); err != nil {
+		return nil, err
+	}
+
+	// Properties stored by the MS are not forwarded to the engine.
+	var mgmtProps []*mgmtpb.PoolProperty
+	req.Properties, mgmtProps = splitMgmtPoolProps(req.GetProperties())
 
 	ps = system.NewPoolService(poolUUID, req.Tierbytes, ranklist.RanksFromUint32(req.GetRanks()))
 	ps.PoolLabel = poolLabel
+	ps.Tags = poolTags
+	if err := setPoolMgmtProps(ps, mgmtProps); err != nil {
+		return nil, err
+	}
 	if err := svc.sysdb.AddPoolService(ctx, ps); err != nil {
 		return nil, err
 	}
@@ -812,6 +915,9 @@ func (svc *mgmtSvc) PoolExclude(ctx context.Context, req *mgmtpb.PoolExcludeReq)
 	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
 	}
+	if err := svc.checkMaintenanceRanks(ranklist.Rank(req.GetRank())); err != nil {
+		return nil, err
+	}
 
 	dresp, err := svc.makeLockedPoolServiceCall(ctx, drpc.MethodPoolExclude, req)
 	if err != nil {
@@ -831,7 +937,16 @@ func (svc *mgmtSvc) PoolDrain(ctx context.Context, req *mgmtpb.PoolDrainReq) (*m
 	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
 	}
+	if err := svc.checkMaintenanceRanks(ranklist.Rank(req.GetRank())); err != nil {
+		return nil, err
+	}
 
+	return svc.poolDrain(ctx, req)
+}
+
+// poolDrain forwards the drain request to the pool service without checking
+// whether the rank is undergoing maintenance.
+func (svc *mgmtSvc) poolDrain(ctx context.Context, req *mgmtpb.PoolDrainReq) (*mgmtpb.PoolDrainResp, error) {
 	dresp, err := svc.makeLockedPoolServiceCall(ctx, drpc.MethodPoolDrain, req)
 	if err != nil {
 		return nil, err
@@ -886,7 +1001,16 @@ func (svc *mgmtSvc) PoolReintegrate(ctx context.Context, req *mgmtpb.PoolReinteg
 	if err := svc.checkLeaderRequest(req); err != nil {
 		return nil, err
 	}
+	if err := svc.checkMaintenanceRanks(ranklist.Rank(req.GetRank())); err != nil {
+		return nil, err
+	}
 
+	return svc.poolReintegrate(ctx, req)
+}
+
+// poolReintegrate forwards the reintegrate request to the pool service without
+// checking whether the rank is undergoing maintenance.
+func (svc *mgmtSvc) poolReintegrate(ctx context.Context, req *mgmtpb.PoolReintegrateReq) (*mgmtpb.PoolReintegrateResp, error) {
 	// Look up the pool service record to find the storage allocations
 	// used at creation.
 	ps, err := svc.getPoolService(req.GetId())
@@ -1043,8 +1167,9 @@ func (svc *mgmtSvc) PoolSetProp(parent context.Context, req *mgmtpb.PoolSetPropR
 		return nil, errors.New("PoolSetProp() request with 0 properties")
 	}
 
-	miscProps := make([]*mgmtpb.PoolProperty, 0, len(req.GetProperties()))
-	for _, prop := range req.GetProperties() {
+	engineProps, mgmtProps := splitMgmtPoolProps(req.GetProperties())
+	miscProps := make([]*mgmtpb.PoolProperty, 0, len(engineProps))
+	for _, prop := range engineProps {
 		// Label is a special case, in that we need to ensure that it's unique
 		// and also to update the pool service entry. Handle it first and separately
 		// so that if it fails, none of the other props are changed.
@@ -1058,6 +1183,13 @@ func (svc *mgmtSvc) PoolSetProp(parent context.Context, req *mgmtpb.PoolSetPropR
 		miscProps = append(miscProps, prop)
 	}
 
+	// Properties stored by the MS are not forwarded to the engine.
+	if len(mgmtProps) > 0 {
+		if err := svc.updatePoolMgmtProps(ctx, poolUUID, mgmtProps); err != nil {
+			return nil, err
+		}
+	}
+
 	resp := new(mgmtpb.PoolSetPropResp)
 	if len(miscProps) == 0 {
 		return resp, nil
@@ -1091,18 +1223,32 @@ func (svc *mgmtSvc) PoolGetProp(ctx context.Context, req *mgmtpb.PoolGetPropReq)
 		return nil, errors.Errorf("PoolGetProp() request with 0 properties")
 	}
 
-	dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolGetProp, req)
-	if err != nil {
-		return nil, err
-	}
+	engineProps, mgmtProps := splitMgmtPoolProps(req.GetProperties())
 
 	resp := new(mgmtpb.PoolGetPropResp)
-	if err = proto.Unmarshal(dresp.Body, resp); err != nil {
-		return nil, errors.Wrap(err, "unmarshal PoolGetProp response")
+	if len(engineProps) > 0 {
+		req.Properties = engineProps
+		dresp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolGetProp, req)
+		if err != nil {
+			return nil, err
+		}
+
+		if err = proto.Unmarshal(dresp.Body, resp); err != nil {
+			return nil, errors.Wrap(err, "unmarshal PoolGetProp response")
+		}
+
+		if resp.GetStatus() != 0 {
+			return resp, nil
+		}
 	}
 
-	if resp.GetStatus() != 0 {
-		return resp, nil
+	// Properties stored by the MS are answered from the pool service entry.
+	if len(mgmtProps) > 0 {
+		props, err := svc.getPoolMgmtProps(req.GetId(), mgmtProps)
+		if err != nil {
+			return nil, err
+		}
+		resp.Properties = append(resp.Properties, props...)
 	}
 
 	return resp, nil
@@ -1204,6 +1350,7 @@ func (svc *mgmtSvc) ListPools(ctx context.Context, req *mgmtpb.ListPoolsReq) (*m
 			Label:   ps.PoolLabel,
 			SvcReps: ranklist.RanksToUint32(ps.Replicas),
 			State:   ps.State.String(),
+			Tags:    ps.Tags.Strings(),
 		})
 	}
 
@@ -0,0 +1,111 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/proto/convert"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// PoolProfileCreate stores a named pool profile in the system database.
+func (svc *mgmtSvc) PoolProfileCreate(ctx context.Context, req *mgmtpb.PoolProfileCreateReq) (*mgmtpb.PoolProfileCreateResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("MgmtSvc.PoolProfileCreate dispatch, req:%+v\n", req)
+
+	if req.GetProfile() == nil {
+		return nil, errors.New("no pool profile supplied")
+	}
+
+	pp := new(system.PoolProfile)
+	if err := convert.Types(req.GetProfile(), pp); err != nil {
+		return nil, err
+	}
+	if pp.Ranks != "" {
+		// Store the allowed ranks in canonical form.
+		rs, err := pp.AllowedRanks()
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid pool profile rank list")
+		}
+		pp.Ranks = rs.String()
+	}
+
+	if err := svc.sysdb.AddPoolProfile(pp, req.GetReplace()); err != nil {
+		return nil, err
+	}
+
+	return new(mgmtpb.PoolProfileCreateResp), nil
+}
+
+// PoolProfileList returns the requested pool profiles from the system database.
+func (svc *mgmtSvc) PoolProfileList(ctx context.Context, req *mgmtpb.PoolProfileListReq) (*mgmtpb.PoolProfileListResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("MgmtSvc.PoolProfileList dispatch, req:%+v\n", req)
+
+	profiles, err := svc.sysdb.PoolProfiles(req.GetNames()...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := new(mgmtpb.PoolProfileListResp)
+	if err := convert.Types(profiles, &resp.Profiles); err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// PoolProfileDelete removes a named pool profile from the system database.
+// Pools previously created with the profile are unaffected.
+func (svc *mgmtSvc) PoolProfileDelete(ctx context.Context, req *mgmtpb.PoolProfileDeleteReq) (*mgmtpb.PoolProfileDeleteResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("MgmtSvc.PoolProfileDelete dispatch, req:%+v\n", req)
+
+	if err := svc.sysdb.RemovePoolProfile(req.GetName()); err != nil {
+		return nil, err
+	}
+
+	return new(mgmtpb.PoolProfileDeleteResp), nil
+}
+
+// checkPoolCreateProfile verifies that a pool create request made with a named
+// pool profile honors the profile's rank restrictions. The profile's other
+// values are applied by the client, but the ranks are checked here so that the
+// restriction can't be bypassed. If the request specifies neither ranks nor a
+// rank count, the pool is created on the ranks allowed by the profile.
+func (svc *mgmtSvc) checkPoolCreateProfile(req *mgmtpb.PoolCreateReq) error {
+	if req.GetProfile() == "" {
+		return nil
+	}
+
+	profiles, err := svc.sysdb.PoolProfiles(req.GetProfile())
+	if err != nil {
+		return err
+	}
+	pp := profiles[0]
+
+	allowed, err := pp.AllowedRanks()
+	if err != nil {
+		return errors.Wrapf(err, "pool profile %q", pp.Name)
+	}
+	if allowed != nil && len(req.GetRanks()) == 0 && req.GetNumranks() == 0 {
+		req.Ranks = ranklist.RanksToUint32(allowed.Ranks())
+	}
+
+	return pp.CheckRanks(req.GetNumranks(), ranklist.RanksFromUint32(req.GetRanks()))
+}
@@ -0,0 +1,248 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func testPoolProfile(name string) *system.PoolProfile {
+	return &system.PoolProfile{
+		Name:        name,
+		Description: "test profile",
+		Properties:  map[string]string{"reclaim": "lazy"},
+		ACL:         []string{"A::OWNER@:rw"},
+		TierRatio:   []float64{0.1, 0.9},
+		NumSvcReps:  3,
+		Ranks:       "0-3",
+	}
+}
+
+func testPoolProfilePB(name string) *mgmtpb.PoolProfile {
+	return &mgmtpb.PoolProfile{
+		Name:        name,
+		Description: "test profile",
+		Properties:  map[string]string{"reclaim": "lazy"},
+		Acl:         []string{"A::OWNER@:rw"},
+		TierRatio:   []float64{0.1, 0.9},
+		NumSvcReps:  3,
+		Ranks:       "0-3",
+	}
+}
+
+func TestServer_MgmtSvc_PoolProfileCreate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		existing   []string
+		req        *mgmtpb.PoolProfileCreateReq
+		expErr     error
+		expProfile *system.PoolProfile
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"missing profile": {
+			req:    &mgmtpb.PoolProfileCreateReq{Sys: build.DefaultSystemName},
+			expErr: errors.New("no pool profile"),
+		},
+		"invalid profile": {
+			req: &mgmtpb.PoolProfileCreateReq{
+				Sys: build.DefaultSystemName,
+				Profile: &mgmtpb.PoolProfile{
+					Name:       "scratch",
+					Properties: map[string]string{"whizbang": "on"},
+				},
+			},
+			expErr: errors.New("unknown property"),
+		},
+		"exists": {
+			existing: []string{"scratch"},
+			req: &mgmtpb.PoolProfileCreateReq{
+				Sys:     build.DefaultSystemName,
+				Profile: testPoolProfilePB("scratch"),
+			},
+			expErr: errors.New("already exists"),
+		},
+		"replace": {
+			existing: []string{"scratch"},
+			req: &mgmtpb.PoolProfileCreateReq{
+				Sys: build.DefaultSystemName,
+				Profile: &mgmtpb.PoolProfile{
+					Name:     "scratch",
+					NumRanks: 2,
+				},
+				Replace: true,
+			},
+			expProfile: &system.PoolProfile{
+				Name:     "scratch",
+				NumRanks: 2,
+			},
+		},
+		"success": {
+			req: &mgmtpb.PoolProfileCreateReq{
+				Sys: build.DefaultSystemName,
+				Profile: &mgmtpb.PoolProfile{
+					Name:        "scratch",
+					Description: "test profile",
+					Properties:  map[string]string{"reclaim": "lazy"},
+					Acl:         []string{"A::OWNER@:rw"},
+					TierRatio:   []float64{0.1, 0.9},
+					NumSvcReps:  3,
+					Ranks:       "3,0,1,2",
+				},
+			},
+			expProfile: testPoolProfile("scratch"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, name := range tc.existing {
+				if err := svc.sysdb.AddPoolProfile(testPoolProfile(name), false); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			_, gotErr := svc.PoolProfileCreate(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			profiles, err := svc.sysdb.PoolProfiles(tc.req.Profile.Name)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expProfile, profiles[0]); diff != "" {
+				t.Fatalf("unexpected stored profile (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolProfileList(t *testing.T) {
+	for name, tc := range map[string]struct {
+		existing []string
+		req      *mgmtpb.PoolProfileListReq
+		expResp  *mgmtpb.PoolProfileListResp
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no profiles": {
+			req:     &mgmtpb.PoolProfileListReq{Sys: build.DefaultSystemName},
+			expResp: &mgmtpb.PoolProfileListResp{},
+		},
+		"all profiles": {
+			existing: []string{"b", "a"},
+			req:      &mgmtpb.PoolProfileListReq{Sys: build.DefaultSystemName},
+			expResp: &mgmtpb.PoolProfileListResp{
+				Profiles: []*mgmtpb.PoolProfile{
+					testPoolProfilePB("a"),
+					testPoolProfilePB("b"),
+				},
+			},
+		},
+		"by name": {
+			existing: []string{"b", "a"},
+			req: &mgmtpb.PoolProfileListReq{
+				Sys:   build.DefaultSystemName,
+				Names: []string{"b"},
+			},
+			expResp: &mgmtpb.PoolProfileListResp{
+				Profiles: []*mgmtpb.PoolProfile{
+					testPoolProfilePB("b"),
+				},
+			},
+		},
+		"unknown name": {
+			existing: []string{"a"},
+			req: &mgmtpb.PoolProfileListReq{
+				Sys:   build.DefaultSystemName,
+				Names: []string{"b"},
+			},
+			expErr: errors.New("unable to find pool profile"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for _, name := range tc.existing {
+				if err := svc.sysdb.AddPoolProfile(testPoolProfile(name), false); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			gotResp, gotErr := svc.PoolProfileList(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolProfileDelete(t *testing.T) {
+	for name, tc := range map[string]struct {
+		req    *mgmtpb.PoolProfileDeleteReq
+		expErr error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"unknown name": {
+			req: &mgmtpb.PoolProfileDeleteReq{
+				Sys:  build.DefaultSystemName,
+				Name: "archive",
+			},
+			expErr: errors.New("unable to find pool profile"),
+		},
+		"success": {
+			req: &mgmtpb.PoolProfileDeleteReq{
+				Sys:  build.DefaultSystemName,
+				Name: "scratch",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			if err := svc.sysdb.AddPoolProfile(testPoolProfile("scratch"), false); err != nil {
+				t.Fatal(err)
+			}
+
+			_, gotErr := svc.PoolProfileDelete(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if _, err := svc.sysdb.PoolProfiles(tc.req.Name); !system.IsPoolProfileNotFound(err) {
+				t.Fatalf("expected profile to be removed, got %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/events"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+const (
+	poolSpaceCheckInterval = time.Minute
+	// poolSpaceHysteresis is the number of percentage points by which the
+	// used space of a pool must fall below a threshold before an alert
+	// for that threshold is cleared.
+	poolSpaceHysteresis = 5
+)
+
+// poolSpaceLevel indicates the space alert level of a pool.
+type poolSpaceLevel int
+
+const (
+	poolSpaceLevelOK poolSpaceLevel = iota
+	poolSpaceLevelWarning
+	poolSpaceLevelCritical
+)
+
+func (psl poolSpaceLevel) eventID() events.RASID {
+	switch psl {
+	case poolSpaceLevelWarning:
+		return events.RASPoolSpaceWarning
+	case poolSpaceLevelCritical:
+		return events.RASPoolSpaceCritical
+	default:
+		return events.RASPoolSpaceCleared
+	}
+}
+
+// poolSpaceThresholds holds the used space percentages at which pool space
+// alerts are raised. A threshold of zero is disabled.
+type poolSpaceThresholds struct {
+	warn uint64
+	crit uint64
+}
+
+// poolTierUsedPct returns the percentage of used space in a pool tier.
+func poolTierUsedPct(ts *mgmtpb.StorageUsageStats) uint64 {
+	if ts.GetTotal() == 0 || ts.GetFree() > ts.GetTotal() {
+		return 0
+	}
+	return (ts.GetTotal() - ts.GetFree()) * 100 / ts.GetTotal()
+}
+
+// nextPoolSpaceLevel returns the alert level for a pool with the supplied
+// tier usage stats. Thresholds that are already in effect at the current
+// level are only released once usage has dropped below the threshold by
+// poolSpaceHysteresis percentage points, in order to avoid flapping. The
+// returned string describes the usage of each tier.
+func nextPoolSpaceLevel(cur poolSpaceLevel, th poolSpaceThresholds, tierStats []*mgmtpb.StorageUsageStats) (poolSpaceLevel, string) {
+	exceeds := func(used, threshold uint64, held bool) bool {
+		if threshold == 0 {
+			return false
+		}
+		return used >= threshold || (held && used+poolSpaceHysteresis > threshold)
+	}
+
+	next := poolSpaceLevelOK
+	var usage []string
+	for i, ts := range tierStats {
+		if ts.GetTotal() == 0 {
+			continue
+		}
+		tierName := "SCM"
+		if i > 0 {
+			tierName = "NVMe"
+		}
+		used := poolTierUsedPct(ts)
+		usage = append(usage, fmt.Sprintf("%s %d%% used", tierName, used))
+
+		switch {
+		case exceeds(used, th.crit, cur >= poolSpaceLevelCritical):
+			next = poolSpaceLevelCritical
+		case exceeds(used, th.warn, cur >= poolSpaceLevelWarning):
+			if next < poolSpaceLevelWarning {
+				next = poolSpaceLevelWarning
+			}
+		}
+	}
+
+	return next, strings.Join(usage, ", ")
+}
+
+// splitMgmtPoolProps separates the pool properties that are stored by the MS
+// from those that are handled by the engine.
+func splitMgmtPoolProps(in []*mgmtpb.PoolProperty) (engineProps, mgmtProps []*mgmtpb.PoolProperty) {
+	for _, prop := range in {
+		if daos.IsMgmtPoolProperty(prop.GetNumber()) {
+			mgmtProps = append(mgmtProps, prop)
+			continue
+		}
+		engineProps = append(engineProps, prop)
+	}
+
+	return
+}
+
+// setPoolMgmtProps stores the supplied MS pool property values in the
+// supplied pool service entry.
+func setPoolMgmtProps(ps *system.PoolService, props []*mgmtpb.PoolProperty) error {
+	for _, prop := range props {
+		numVal, ok := prop.GetValue().(*mgmtpb.PoolProperty_Numval)
+		if !ok {
+			return errors.Errorf("pool property %d requires a numeric value", prop.GetNumber())
+		}
+
+		switch prop.GetNumber() {
+		case daos.PoolPropertySpaceWarn, daos.PoolPropertySpaceCrit:
+			if numVal.Numval > 100 {
+				return errors.Errorf("invalid pool space threshold %d (valid values: 0-100)", numVal.Numval)
+			}
+		default:
+			return errors.Errorf("unknown pool property %d", prop.GetNumber())
+		}
+
+		if ps.MgmtProperties == nil {
+			ps.MgmtProperties = make(map[uint32]uint64)
+		}
+		ps.MgmtProperties[prop.GetNumber()] = numVal.Numval
+	}
+
+	return nil
+}
+
+// updatePoolMgmtProps persists the supplied MS pool property values for the
+// pool. The caller must hold the pool lock.
+func (svc *mgmtSvc) updatePoolMgmtProps(ctx context.Context, poolUUID uuid.UUID, props []*mgmtpb.PoolProperty) error {
+	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
+	if err != nil {
+		return err
+	}
+
+	if err := setPoolMgmtProps(ps, props); err != nil {
+		return err
+	}
+
+	return svc.sysdb.UpdatePoolService(ctx, ps)
+}
+
+// getPoolMgmtProp returns the value of the MS pool property for the supplied
+// pool. If the property has not been set on the pool, the value of the
+// corresponding system property is returned.
+func (svc *mgmtSvc) getPoolMgmtProp(ps *system.PoolService, number uint32) (uint64, error) {
+	if val, found := ps.MgmtProperties[number]; found {
+		return val, nil
+	}
+
+	for sp := range svc.systemProps.Iter() {
+		pp, ok := sp2pp(sp)
+		if !ok || pp.Number != number {
+			continue
+		}
+
+		val, err := system.GetUserProperty(svc.sysdb, svc.systemProps, sp.Key.String())
+		if err != nil {
+			return 0, err
+		}
+		if err := pp.SetValue(val); err != nil {
+			return 0, err
+		}
+		return pp.Value.GetNumber()
+	}
+
+	return 0, errors.Errorf("unknown pool property %d", number)
+}
+
+// getPoolMgmtProps returns the requested MS pool property values for the pool.
+func (svc *mgmtSvc) getPoolMgmtProps(id string, props []*mgmtpb.PoolProperty) ([]*mgmtpb.PoolProperty, error) {
+	ps, err := svc.getPoolService(id)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make([]*mgmtpb.PoolProperty, 0, len(props))
+	for _, prop := range props {
+		val, err := svc.getPoolMgmtProp(ps, prop.GetNumber())
+		if err != nil {
+			return nil, err
+		}
+		outProp := &mgmtpb.PoolProperty{Number: prop.GetNumber()}
+		outProp.SetValueNumber(val)
+		out = append(out, outProp)
+	}
+
+	return out, nil
+}
+
+// getPoolSpaceThresholds returns the space alert thresholds in effect for
+// the supplied pool.
+func (svc *mgmtSvc) getPoolSpaceThresholds(ps *system.PoolService) (th poolSpaceThresholds, err error) {
+	if th.warn, err = svc.getPoolMgmtProp(ps, daos.PoolPropertySpaceWarn); err != nil {
+		return
+	}
+	th.crit, err = svc.getPoolMgmtProp(ps, daos.PoolPropertySpaceCrit)
+	return
+}
+
+// checkPoolSpace queries the usage of each pool in the system and publishes
+// a pool space event for each pool whose alert level has changed since the
+// previous check.
+func (svc *mgmtSvc) checkPoolSpace(ctx context.Context, levels map[uuid.UUID]poolSpaceLevel) error {
+	pools, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		return err
+	}
+
+	current := make(map[uuid.UUID]struct{}, len(pools))
+	for _, ps := range pools {
+		current[ps.PoolUUID] = struct{}{}
+
+		th, err := svc.getPoolSpaceThresholds(ps)
+		if err != nil {
+			svc.log.Errorf("failed to get space thresholds for pool %s: %s", ps.PoolUUID, err)
+			continue
+		}
+
+		resp, err := svc.PoolQuery(ctx, &mgmtpb.PoolQueryReq{
+			Sys: svc.sysdb.SystemName(),
+			Id:  ps.PoolUUID.String(),
+		})
+		if err != nil {
+			svc.log.Debugf("pool space check: query of pool %s failed: %s", ps.PoolUUID, err)
+			continue
+		}
+		if resp.GetStatus() != 0 {
+			svc.log.Debugf("pool space check: query of pool %s failed: %s",
+				ps.PoolUUID, daos.Status(resp.GetStatus()))
+			continue
+		}
+
+		cur := levels[ps.PoolUUID]
+		next, usage := nextPoolSpaceLevel(cur, th, resp.GetTierStats())
+		levels[ps.PoolUUID] = next
+		if next == cur {
+			continue
+		}
+
+		svc.log.Debugf("pool %s space alert level changed from %d to %d (%s)", ps.PoolUUID, cur, next, usage)
+		svc.events.Publish(events.NewPoolSpaceEvent(next.eventID(), ps.PoolUUID.String(), usage))
+	}
+
+	// Forget about pools that have been destroyed.
+	for poolUUID := range levels {
+		if _, found := current[poolUUID]; !found {
+			delete(levels, poolUUID)
+		}
+	}
+
+	return nil
+}
+
+// poolSpaceLoop periodically checks the space usage of all pools in the
+// system while this instance is the MS leader.
+func (svc *mgmtSvc) poolSpaceLoop(parent context.Context) {
+	ticker := time.NewTicker(poolSpaceCheckInterval)
+	defer ticker.Stop()
+
+	levels := make(map[uuid.UUID]poolSpaceLevel)
+
+	svc.log.Debug("starting poolSpaceLoop")
+	for {
+		select {
+		case <-parent.Done():
+			svc.log.Debug("stopped poolSpaceLoop")
+			return
+		case <-ticker.C:
+			if err := svc.checkPoolSpace(parent, levels); err != nil {
+				svc.log.Errorf("pool space check failed: %s", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,256 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_nextPoolSpaceLevel(t *testing.T) {
+	defTh := poolSpaceThresholds{warn: 80, crit: 95}
+	tier := func(pctUsed uint64) *mgmtpb.StorageUsageStats {
+		return &mgmtpb.StorageUsageStats{Total: 100, Free: 100 - pctUsed}
+	}
+
+	for name, tc := range map[string]struct {
+		cur       poolSpaceLevel
+		th        poolSpaceThresholds
+		tierStats []*mgmtpb.StorageUsageStats
+		expLevel  poolSpaceLevel
+		expUsage  string
+	}{
+		"no tiers": {
+			th:       defTh,
+			expLevel: poolSpaceLevelOK,
+		},
+		"below thresholds": {
+			th:        defTh,
+			tierStats: []*mgmtpb.StorageUsageStats{tier(50), tier(70)},
+			expLevel:  poolSpaceLevelOK,
+			expUsage:  "SCM 50% used, NVMe 70% used",
+		},
+		"empty tier ignored": {
+			th:        defTh,
+			tierStats: []*mgmtpb.StorageUsageStats{tier(50), {}},
+			expLevel:  poolSpaceLevelOK,
+			expUsage:  "SCM 50% used",
+		},
+		"warning on nvme": {
+			th:        defTh,
+			tierStats: []*mgmtpb.StorageUsageStats{tier(10), tier(80)},
+			expLevel:  poolSpaceLevelWarning,
+			expUsage:  "SCM 10% used, NVMe 80% used",
+		},
+		"critical on scm": {
+			th:        defTh,
+			tierStats: []*mgmtpb.StorageUsageStats{tier(96), tier(85)},
+			expLevel:  poolSpaceLevelCritical,
+			expUsage:  "SCM 96% used, NVMe 85% used",
+		},
+		"warning held within hysteresis": {
+			cur:       poolSpaceLevelWarning,
+			th:        defTh,
+			tierStats: []*mgmtpb.StorageUsageStats{tier(10), tier(76)},
+			expLevel:  poolSpaceLevelWarning,
+			expUsage:  "SCM 10% used, NVMe 76% used",
+		},
+		"warning cleared below hysteresis": {
+			cur:       poolSpaceLevelWarning,
+			th:        defTh,
+			tierStats: []*mgmtpb.StorageUsageStats{tier(10), tier(75)},
+			expLevel:  poolSpaceLevelOK,
+			expUsage:  "SCM 10% used, NVMe 75% used",
+		},
+		"critical drops to warning": {
+			cur:       poolSpaceLevelCritical,
+			th:        defTh,
+			tierStats: []*mgmtpb.StorageUsageStats{tier(10), tier(88)},
+			expLevel:  poolSpaceLevelWarning,
+			expUsage:  "SCM 10% used, NVMe 88% used",
+		},
+		"no hysteresis when not held": {
+			cur:       poolSpaceLevelOK,
+			th:        defTh,
+			tierStats: []*mgmtpb.StorageUsageStats{tier(10), tier(79)},
+			expLevel:  poolSpaceLevelOK,
+			expUsage:  "SCM 10% used, NVMe 79% used",
+		},
+		"thresholds disabled": {
+			tierStats: []*mgmtpb.StorageUsageStats{tier(100), tier(100)},
+			expLevel:  poolSpaceLevelOK,
+			expUsage:  "SCM 100% used, NVMe 100% used",
+		},
+		"warning disabled": {
+			th:        poolSpaceThresholds{crit: 95},
+			tierStats: []*mgmtpb.StorageUsageStats{tier(90), tier(90)},
+			expLevel:  poolSpaceLevelOK,
+			expUsage:  "SCM 90% used, NVMe 90% used",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotLevel, gotUsage := nextPoolSpaceLevel(tc.cur, tc.th, tc.tierStats)
+
+			test.AssertEqual(t, tc.expLevel, gotLevel, "unexpected level")
+			test.AssertEqual(t, tc.expUsage, gotUsage, "unexpected usage")
+		})
+	}
+}
+
+func TestServer_setPoolMgmtProps(t *testing.T) {
+	numProp := func(number uint32, val uint64) *mgmtpb.PoolProperty {
+		prop := &mgmtpb.PoolProperty{Number: number}
+		prop.SetValueNumber(val)
+		return prop
+	}
+
+	for name, tc := range map[string]struct {
+		props    []*mgmtpb.PoolProperty
+		expProps map[uint32]uint64
+		expErr   error
+	}{
+		"no props": {},
+		"thresholds set": {
+			props: []*mgmtpb.PoolProperty{
+				numProp(daos.PoolPropertySpaceWarn, 70),
+				numProp(daos.PoolPropertySpaceCrit, 0),
+			},
+			expProps: map[uint32]uint64{
+				daos.PoolPropertySpaceWarn: 70,
+				daos.PoolPropertySpaceCrit: 0,
+			},
+		},
+		"threshold out of range": {
+			props:  []*mgmtpb.PoolProperty{numProp(daos.PoolPropertySpaceWarn, 101)},
+			expErr: errors.New("invalid pool space threshold"),
+		},
+		"string value": {
+			props: []*mgmtpb.PoolProperty{
+				{
+					Number: daos.PoolPropertySpaceWarn,
+					Value:  &mgmtpb.PoolProperty_Strval{Strval: "80"},
+				},
+			},
+			expErr: errors.New("requires a numeric value"),
+		},
+		"unknown property": {
+			props:  []*mgmtpb.PoolProperty{numProp(daos.PoolPropertySpaceCrit+1, 1)},
+			expErr: errors.New("unknown pool property"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			ps := &system.PoolService{}
+
+			gotErr := setPoolMgmtProps(ps, tc.props)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expProps, ps.MgmtProperties); diff != "" {
+				t.Fatalf("unexpected props (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolSetProp_MgmtProps(t *testing.T) {
+	numProp := func(number uint32, val uint64) *mgmtpb.PoolProperty {
+		prop := &mgmtpb.PoolProperty{Number: number}
+		prop.SetValueNumber(val)
+		return prop
+	}
+
+	for name, tc := range map[string]struct {
+		initProps map[uint32]uint64
+		props     []*mgmtpb.PoolProperty
+		expProps  map[uint32]uint64
+		expErr    error
+	}{
+		"set on pool without thresholds": {
+			props: []*mgmtpb.PoolProperty{numProp(daos.PoolPropertySpaceWarn, 70)},
+			expProps: map[uint32]uint64{
+				daos.PoolPropertySpaceWarn: 70,
+			},
+		},
+		"update existing thresholds": {
+			initProps: map[uint32]uint64{
+				daos.PoolPropertySpaceWarn: 70,
+				daos.PoolPropertySpaceCrit: 90,
+			},
+			props: []*mgmtpb.PoolProperty{numProp(daos.PoolPropertySpaceCrit, 95)},
+			expProps: map[uint32]uint64{
+				daos.PoolPropertySpaceWarn: 70,
+				daos.PoolPropertySpaceCrit: 95,
+			},
+		},
+		"invalid threshold not stored": {
+			initProps: map[uint32]uint64{
+				daos.PoolPropertySpaceWarn: 70,
+			},
+			props:  []*mgmtpb.PoolProperty{numProp(daos.PoolPropertySpaceWarn, 101)},
+			expErr: errors.New("invalid pool space threshold"),
+			expProps: map[uint32]uint64{
+				daos.PoolPropertySpaceWarn: 70,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ms := newTestMgmtSvc(t, log)
+			addTestPoolService(t, ms.sysdb, &system.PoolService{
+				PoolUUID:       uuid.MustParse(mockUUID),
+				PoolLabel:      "test",
+				State:          system.PoolServiceStateReady,
+				Replicas:       []ranklist.Rank{0},
+				MgmtProperties: tc.initProps,
+			})
+
+			_, gotErr := ms.PoolSetProp(test.Context(t), &mgmtpb.PoolSetPropReq{
+				Sys:        build.DefaultSystemName,
+				Id:         mockUUID,
+				Properties: tc.props,
+			})
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			ps, err := ms.sysdb.FindPoolServiceByUUID(uuid.MustParse(mockUUID))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expProps, ps.MgmtProperties); diff != "" {
+				t.Fatalf("unexpected stored props (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_splitMgmtPoolProps(t *testing.T) {
+	label := &mgmtpb.PoolProperty{Number: daos.PoolPropertyLabel}
+	warn := &mgmtpb.PoolProperty{Number: daos.PoolPropertySpaceWarn}
+	crit := &mgmtpb.PoolProperty{Number: daos.PoolPropertySpaceCrit}
+
+	engineProps, mgmtProps := splitMgmtPoolProps([]*mgmtpb.PoolProperty{warn, label, crit})
+
+	test.AssertEqual(t, 1, len(engineProps), "unexpected engine props")
+	test.AssertEqual(t, label.GetNumber(), engineProps[0].GetNumber(), "unexpected engine prop")
+	test.AssertEqual(t, 2, len(mgmtProps), "unexpected mgmt props")
+	test.AssertEqual(t, warn.GetNumber(), mgmtProps[0].GetNumber(), "unexpected mgmt prop")
+	test.AssertEqual(t, crit.GetNumber(), mgmtProps[1].GetNumber(), "unexpected mgmt prop")
+}
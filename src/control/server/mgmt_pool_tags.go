@@ -0,0 +1,83 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// PoolTag sets or removes operator-defined tags on a pool service. Tags are
+// stored only in the system database and are not forwarded to the engine.
+func (svc *mgmtSvc) PoolTag(parent context.Context, req *mgmtpb.PoolTagReq) (*mgmtpb.PoolTagResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+	svc.log.Debugf("MgmtSvc.PoolTag dispatch, req:%+v\n", req)
+
+	if len(req.GetSet()) == 0 && len(req.GetUnset()) == 0 {
+		return nil, errors.New("no pool tags to set or unset")
+	}
+
+	setTags, err := system.ParsePoolTags(req.GetSet())
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range req.GetUnset() {
+		if err := system.ValidatePoolTagKey(key); err != nil {
+			return nil, err
+		}
+		if _, found := setTags[key]; found {
+			return nil, errors.Errorf("pool tag %q may not be both set and unset", key)
+		}
+	}
+
+	poolUUID, err := svc.resolvePoolID(req.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	lock, err := svc.sysdb.TakePoolLock(parent, poolUUID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Release()
+	ctx := lock.InContext(parent)
+
+	ps, err := svc.sysdb.FindPoolServiceByUUID(poolUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := ps.Tags.Copy()
+	if tags == nil {
+		tags = make(system.PoolTags)
+	}
+	for _, key := range req.GetUnset() {
+		delete(tags, key)
+	}
+	for key, value := range setTags {
+		tags[key] = value
+	}
+	if err := tags.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(tags) == 0 {
+		tags = nil
+	}
+	ps.Tags = tags
+	if err := svc.sysdb.UpdatePoolService(ctx, ps); err != nil {
+		return nil, err
+	}
+
+	return &mgmtpb.PoolTagResp{Tags: tags.Strings()}, nil
+}
@@ -0,0 +1,177 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package server
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	mgmtpb "github.com/daos-stack/daos/src/control/common/proto/mgmt"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestServer_MgmtSvc_PoolTag(t *testing.T) {
+	manyTags := make([]string, 0, system.MaxPoolTags)
+	for i := 0; i < system.MaxPoolTags; i++ {
+		manyTags = append(manyTags, fmt.Sprintf("key%d=value", i))
+	}
+
+	for name, tc := range map[string]struct {
+		tags    system.PoolTags
+		req     *mgmtpb.PoolTagReq
+		expResp *mgmtpb.PoolTagResp
+		expTags system.PoolTags
+		expErr  error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"wrong system": {
+			req: &mgmtpb.PoolTagReq{
+				Sys: "bad",
+				Id:  test.MockUUID(),
+				Set: []string{"project=climate"},
+			},
+			expErr: FaultWrongSystem("bad", build.DefaultSystemName),
+		},
+		"nothing to do": {
+			req: &mgmtpb.PoolTagReq{
+				Id: test.MockUUID(),
+			},
+			expErr: errors.New("no pool tags"),
+		},
+		"invalid tag": {
+			req: &mgmtpb.PoolTagReq{
+				Id:  test.MockUUID(),
+				Set: []string{"project"},
+			},
+			expErr: errors.New("expected key=value"),
+		},
+		"invalid unset key": {
+			req: &mgmtpb.PoolTagReq{
+				Id:    test.MockUUID(),
+				Unset: []string{"bad key"},
+			},
+			expErr: errors.New("invalid character"),
+		},
+		"set and unset same key": {
+			req: &mgmtpb.PoolTagReq{
+				Id:    test.MockUUID(),
+				Set:   []string{"project=climate"},
+				Unset: []string{"project"},
+			},
+			expErr: errors.New("both set and unset"),
+		},
+		"unknown pool": {
+			req: &mgmtpb.PoolTagReq{
+				Id:  "unknown",
+				Set: []string{"project=climate"},
+			},
+			expErr: system.ErrPoolLabelNotFound("unknown"),
+		},
+		"set on untagged pool": {
+			req: &mgmtpb.PoolTagReq{
+				Id:  test.MockUUID(),
+				Set: []string{"project=climate", "owner=bob"},
+			},
+			expResp: &mgmtpb.PoolTagResp{
+				Tags: []string{"owner=bob", "project=climate"},
+			},
+			expTags: system.PoolTags{"project": "climate", "owner": "bob"},
+		},
+		"set by label replaces value": {
+			tags: system.PoolTags{"project": "fusion", "owner": "bob"},
+			req: &mgmtpb.PoolTagReq{
+				Id:  "test-pool",
+				Set: []string{"project=climate"},
+			},
+			expResp: &mgmtpb.PoolTagResp{
+				Tags: []string{"owner=bob", "project=climate"},
+			},
+			expTags: system.PoolTags{"project": "climate", "owner": "bob"},
+		},
+		"set and unset": {
+			tags: system.PoolTags{"project": "climate", "owner": "bob"},
+			req: &mgmtpb.PoolTagReq{
+				Id:    test.MockUUID(),
+				Set:   []string{"tier=gold"},
+				Unset: []string{"owner", "missing"},
+			},
+			expResp: &mgmtpb.PoolTagResp{
+				Tags: []string{"project=climate", "tier=gold"},
+			},
+			expTags: system.PoolTags{"project": "climate", "tier": "gold"},
+		},
+		"unset all": {
+			tags: system.PoolTags{"project": "climate"},
+			req: &mgmtpb.PoolTagReq{
+				Id:    test.MockUUID(),
+				Unset: []string{"project"},
+			},
+			expResp: &mgmtpb.PoolTagResp{},
+		},
+		"too many tags": {
+			tags: system.PoolTags{"project": "climate"},
+			req: &mgmtpb.PoolTagReq{
+				Id:  test.MockUUID(),
+				Set: manyTags,
+			},
+			expErr: errors.New("exceeds maximum"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			ps := &system.PoolService{
+				PoolUUID:  test.MockPoolUUID(),
+				PoolLabel: "test-pool",
+				State:     system.PoolServiceStateReady,
+				Replicas:  []ranklist.Rank{0},
+				Tags:      tc.tags,
+			}
+			lock, ctx := getPoolLockCtx(t, nil, svc.sysdb, ps.PoolUUID)
+			if err := svc.sysdb.AddPoolService(ctx, ps); err != nil {
+				t.Fatal(err)
+			}
+			lock.Release()
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolTag(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr == nil {
+				if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+					t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+				}
+			}
+
+			// Failed requests must leave the stored tags unchanged.
+			gotPS, err := svc.sysdb.FindPoolServiceByUUID(ps.PoolUUID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expTags := tc.expTags
+			if tc.expErr != nil {
+				expTags = tc.tags
+			}
+			if diff := cmp.Diff(expTags, gotPS.Tags); diff != "" {
+				t.Fatalf("unexpected pool tags (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -342,6 +342,8 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 		req            *mgmtpb.PoolCreateReq
 		drpcRet        *mgmtpb.PoolCreateResp
 		expResp        *mgmtpb.PoolCreateResp
+		expTags        system.PoolTags
+		profiles       []*system.PoolProfile
 		expErr         error
 	}{
 		"nil request": {
@@ -544,6 +546,51 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 			},
 			expErr: FaultPoolNoLabel,
 		},
+		"successful creation with tags": {
+			targetCount: 8,
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:       test.MockUUID(1),
+				Tierbytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Properties: testPoolLabelProp(),
+				Tags:       []string{"project=climate", "cost-center=1234"},
+			},
+			drpcRet: &mgmtpb.PoolCreateResp{
+				TierBytes: []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				TgtRanks:  []uint32{0, 1},
+			},
+			expResp: &mgmtpb.PoolCreateResp{
+				TierBytes: []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				TgtRanks:  []uint32{0, 1},
+			},
+			expTags: system.PoolTags{
+				"project":     "climate",
+				"cost-center": "1234",
+			},
+		},
+		"invalid tags": {
+			targetCount: 8,
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:       test.MockUUID(1),
+				Tierbytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Properties: testPoolLabelProp(),
+				Tags:       []string{"project"},
+			},
+			expErr: errors.New("expected key=value"),
+		},
+		"rank not allowed by profile": {
+			targetCount: 8,
+			profiles: []*system.PoolProfile{
+				{Name: "restricted", Ranks: "0"},
+			},
+			req: &mgmtpb.PoolCreateReq{
+				Uuid:       test.MockUUID(1),
+				Tierbytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Ranks:      []uint32{0, 1},
+				Properties: testPoolLabelProp(),
+				Profile:    "restricted",
+			},
+			expErr: errors.New("rank 1 is not allowed by pool profile"),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			buf.Reset()
@@ -594,6 +641,11 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 					t.Fatal(err)
 				}
 			}
+			for _, pp := range tc.profiles {
+				if err := tc.mgmtSvc.sysdb.AddPoolProfile(pp, false); err != nil {
+					t.Fatal(err)
+				}
+			}
 
 			if tc.setupMockDrpc == nil {
 				tc.setupMockDrpc = func(svc *mgmtSvc, err error) {
@@ -617,6 +669,186 @@ func TestServer_MgmtSvc_PoolCreate(t *testing.T) {
 			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
 				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
 			}
+
+			ps, err := tc.mgmtSvc.sysdb.FindPoolServiceByUUID(uuid.MustParse(tc.req.Uuid))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tc.expTags, ps.Tags); diff != "" {
+				t.Fatalf("unexpected pool tags (-want, +got)\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestServer_MgmtSvc_PoolCreatePlan(t *testing.T) {
+	defaultTierRatios := []float64{0.06, 0.94}
+
+	profile := &system.PoolProfile{Name: "restricted", Ranks: "1-2"}
+
+	for name, tc := range map[string]struct {
+		profiles []*system.PoolProfile
+		req      *mgmtpb.PoolCreateReq
+		expResp  *mgmtpb.PoolCreatePlanResp
+		expErr   error
+	}{
+		"nil request": {
+			expErr: errors.New("nil request"),
+		},
+		"no label": {
+			req: &mgmtpb.PoolCreateReq{
+				Tierbytes: []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+			},
+			expErr: FaultPoolNoLabel,
+		},
+		"invalid ranks": {
+			req: &mgmtpb.PoolCreateReq{
+				Tierbytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Ranks:      []uint32{1, 9},
+				Properties: testPoolLabelProp(),
+			},
+			expErr: FaultPoolInvalidRanks([]ranklist.Rank{9}),
+		},
+		"manual sizes with ranks": {
+			req: &mgmtpb.PoolCreateReq{
+				Tierbytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Ranks:      []uint32{3, 1},
+				Properties: testPoolLabelProp(),
+			},
+			expResp: &mgmtpb.PoolCreatePlanResp{
+				TgtRanks:     []uint32{1, 3},
+				TierBytes:    []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				TgtsPerRank:  8,
+				FaultDomains: []string{"/rack0/node1", "/rack1/node3"},
+			},
+		},
+		"unknown profile": {
+			req: &mgmtpb.PoolCreateReq{
+				Tierbytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Properties: testPoolLabelProp(),
+				Profile:    "restricted",
+			},
+			expErr: system.ErrPoolProfileNotFound("restricted"),
+		},
+		"profile ranks used by default": {
+			profiles: []*system.PoolProfile{profile},
+			req: &mgmtpb.PoolCreateReq{
+				Tierbytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Properties: testPoolLabelProp(),
+				Profile:    "restricted",
+			},
+			expResp: &mgmtpb.PoolCreatePlanResp{
+				TgtRanks:     []uint32{1, 2},
+				TierBytes:    []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				TgtsPerRank:  8,
+				FaultDomains: []string{"/rack0/node1", "/rack1/node2"},
+			},
+		},
+		"rank count with profile ranks": {
+			profiles: []*system.PoolProfile{profile},
+			req: &mgmtpb.PoolCreateReq{
+				Totalbytes: 400 * humanize.GiByte,
+				Tierratio:  defaultTierRatios,
+				Numranks:   2,
+				Properties: testPoolLabelProp(),
+				Profile:    "restricted",
+			},
+			expErr: errors.New("rank count may not be used with pool profile"),
+		},
+		"rank not allowed by profile": {
+			profiles: []*system.PoolProfile{profile},
+			req: &mgmtpb.PoolCreateReq{
+				Tierbytes:  []uint64{100 * humanize.GiByte, 10 * humanize.TByte},
+				Ranks:      []uint32{1, 3},
+				Properties: testPoolLabelProp(),
+				Profile:    "restricted",
+			},
+			expErr: errors.New("rank 3 is not allowed by pool profile"),
+		},
+		"auto total size": {
+			req: &mgmtpb.PoolCreateReq{
+				Totalbytes: 400 * humanize.GiByte,
+				Tierratio:  defaultTierRatios,
+				Properties: testPoolLabelProp(),
+			},
+			expResp: &mgmtpb.PoolCreatePlanResp{
+				TgtRanks: []uint32{0, 1, 2, 3},
+				TierBytes: []uint64{
+					uint64(float64(400*humanize.GiByte)*defaultTierRatios[0]) / 4,
+					uint64(float64(400*humanize.GiByte)*defaultTierRatios[1]) / 4,
+				},
+				TgtsPerRank: 8,
+				FaultDomains: []string{
+					"/rack0/node0", "/rack0/node1", "/rack1/node2", "/rack1/node3",
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			ctx := test.Context(t)
+
+			engineCfg := engine.MockConfig().
+				WithTargetCount(8).
+				WithStorage(storage.NewTierConfig().
+					WithStorageClass("nvme").
+					WithBdevDeviceList("foo", "bar"))
+			r := engine.NewTestRunner(nil, engineCfg)
+			if _, err := r.Start(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			mp := storage.NewProvider(log, 0, &engineCfg.Storage,
+				nil, nil, nil, nil)
+			srv := NewEngineInstance(log, mp, nil, r)
+			srv.ready.SetTrue()
+
+			harness := NewEngineHarness(log)
+			if err := harness.AddInstance(srv); err != nil {
+				t.Fatal(err)
+			}
+			harness.started.SetTrue()
+
+			db := raft.MockDatabase(t, log)
+			ms := system.MockMembership(t, log, db, mockTCPResolver)
+			svc := newMgmtSvc(harness, ms, db, nil, events.NewPubSub(ctx, log))
+			for _, pp := range tc.profiles {
+				if err := db.AddPoolProfile(pp, false); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			for i := 0; i < 4; i++ {
+				mm := system.MockMember(t, uint32(i), system.MemberStateJoined).
+					WithFaultDomain(system.MustCreateFaultDomain(
+						fmt.Sprintf("rack%d", i/2), fmt.Sprintf("node%d", i)))
+				if _, err := svc.membership.Add(mm); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+
+			gotResp, gotErr := svc.PoolCreatePlan(ctx, tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, test.DefaultCmpOpts()...); diff != "" {
+				t.Fatalf("unexpected response (-want, +got)\n%s\n", diff)
+			}
+
+			// No pool should have been created.
+			pools, err := svc.sysdb.PoolServiceList(true)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, 0, len(pools), "unexpected pool services")
 		})
 	}
 }
@@ -1431,6 +1663,7 @@ func TestListPools_Success(t *testing.T) {
 			PoolLabel: "1",
 			State:     system.PoolServiceStateReady,
 			Replicas:  []ranklist.Rank{0, 1, 2},
+			Tags:      system.PoolTags{"project": "climate", "owner": "bob"},
 		},
 	}
 	expectedResp := &mgmtpb.ListPoolsResp{
@@ -1449,6 +1682,7 @@ func TestListPools_Success(t *testing.T) {
 			Label:   ps.PoolLabel,
 			SvcReps: []uint32{0, 1, 2},
 			State:   system.PoolServiceStateReady.String(),
+			Tags:    ps.Tags.Strings(),
 		})
 	}
 
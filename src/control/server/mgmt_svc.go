@@ -10,6 +10,7 @@ import (
 	"context"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -22,6 +23,7 @@ import (
 	"github.com/daos-stack/daos/src/control/lib/control"
 	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/server/config"
 	"github.com/daos-stack/daos/src/control/system"
 	"github.com/daos-stack/daos/src/control/system/raft"
 )
@@ -74,6 +76,9 @@ type mgmtSvc struct {
 	sysdb             *raft.Database
 	rpcClient         control.UnaryInvoker
 	events            *events.PubSub
+	evtJournal        *events.Journal
+	backupCfg         config.MSBackupConfig
+	backupMu          sync.Mutex
 	systemProps       daos.SystemPropertyMap
 	clientNetworkHint *mgmtpb.ClientNetHint
 	batchInterval     time.Duration
@@ -81,6 +86,8 @@ type mgmtSvc struct {
 	serialReqs        batchReqChan
 	groupUpdateReqs   chan bool
 	lastMapVer        uint32
+	leaderChangeLock  sync.Mutex
+	leaderChange      chan struct{}
 }
 
 func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c control.UnaryInvoker, p *events.PubSub) *mgmtSvc {
@@ -97,6 +104,7 @@ func newMgmtSvc(h *EngineHarness, m *system.Membership, s *raft.Database, c cont
 		batchReqs:         make(batchReqChan),
 		serialReqs:        make(batchReqChan),
 		groupUpdateReqs:   make(chan bool),
+		leaderChange:      make(chan struct{}),
 	}
 }
 
@@ -146,6 +154,11 @@ func (svc *mgmtSvc) checkReplicaRequest(req proto.Message) error {
 // that will be canceled on leadership loss.
 func (svc *mgmtSvc) startLeaderLoops(ctx context.Context) {
 	go svc.leaderTaskLoop(ctx)
+	go svc.poolSpaceLoop(ctx)
+	go svc.maintenanceLoop(ctx)
+	if svc.backupCfg.Enabled() {
+		go svc.backupLoop(ctx)
+	}
 }
 
 // startAsyncLoops kicks off the asynchronous processing loops.
@@ -296,6 +309,9 @@ func (svc *mgmtSvc) processSerialRequest(ctx context.Context, req *batchRequest)
 	case *mgmtpb.PoolCreateReq:
 		resp, err := svc.poolCreate(ctx, msg)
 		req.sendResponse(ctx, resp, err)
+	case *mgmtpb.SetReplicasReq:
+		resp, err := svc.setReplicas(ctx, msg)
+		req.sendResponse(ctx, resp, err)
 	default:
 		svc.log.Errorf("no serial handler for message type %T", req.msg)
 	}
@@ -14,6 +14,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"runtime"
+	"sort"
 	"strings"
 	"time"
 
@@ -78,6 +79,7 @@ func (svc *mgmtSvc) GetAttachInfo(ctx context.Context, req *mgmtpb.GetAttachInfo
 	}
 	resp.ClientNetHint = svc.clientNetworkHint
 	resp.MsRanks = ranklist.RanksToUint32(groupMap.MSRanks)
+	resp.MsReplicas = svc.sysdb.ReplicaAddrs()
 
 	v, err := svc.sysdb.DataVersion()
 	if err != nil {
@@ -607,6 +609,9 @@ func (svc *mgmtSvc) SystemStop(ctx context.Context, req *mgmtpb.SystemStopReq) (
 	if err != nil {
 		return nil, err
 	}
+	if err := svc.filterMaintenanceRanks(req, fReq); err != nil {
+		return nil, err
+	}
 
 	// First phase: Prepare the ranks for shutdown, but only if the request
 	// is for an unforced full system stop.
@@ -683,6 +688,9 @@ func (svc *mgmtSvc) SystemStart(ctx context.Context, req *mgmtpb.SystemStartReq)
 	if err != nil {
 		return nil, err
 	}
+	if err := svc.filterMaintenanceRanks(req, fReq); err != nil {
+		return nil, err
+	}
 
 	fReq.Method = control.StartRanks
 	fResp, _, err = svc.rpcFanout(ctx, fReq, fResp, true)
@@ -719,6 +727,9 @@ func (svc *mgmtSvc) SystemExclude(ctx context.Context, req *mgmtpb.SystemExclude
 	if fResp.AbsentRanks.Count() > 0 {
 		return nil, errors.Errorf("invalid rank(s): %s", fResp.AbsentRanks.String())
 	}
+	if err := svc.checkMaintenanceRanks(fReq.Ranks.Ranks()...); err != nil {
+		return nil, err
+	}
 
 	resp := new(mgmtpb.SystemExcludeResp)
 	for _, r := range fReq.Ranks.Ranks() {
@@ -746,6 +757,130 @@ func (svc *mgmtSvc) SystemExclude(ctx context.Context, req *mgmtpb.SystemExclude
 	return resp, nil
 }
 
+// SystemDrain drains (or reintegrates) the specified ranks from all of the
+// pools that have targets on those ranks.
+func (svc *mgmtSvc) SystemDrain(ctx context.Context, req *mgmtpb.SystemDrainReq) (*mgmtpb.SystemDrainResp, error) {
+	if err := svc.checkLeaderRequest(req); err != nil {
+		return nil, err
+	}
+
+	if req.Hosts == "" && req.Ranks == "" {
+		return nil, errors.New("no hosts or ranks specified")
+	}
+
+	fReq, fResp, err := svc.getFanout(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if fResp.AbsentHosts.Count() > 0 {
+		return nil, errors.Errorf("invalid host(s): %s", fResp.AbsentHosts.String())
+	}
+	if fResp.AbsentRanks.Count() > 0 {
+		return nil, errors.Errorf("invalid rank(s): %s", fResp.AbsentRanks.String())
+	}
+	if err := svc.checkMaintenanceRanks(fReq.Ranks.Ranks()...); err != nil {
+		return nil, err
+	}
+
+	pools, err := svc.sysdb.PoolServiceList(false)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(pools, func(i, j int) bool {
+		if pools[i].PoolLabel != pools[j].PoolLabel {
+			return pools[i].PoolLabel < pools[j].PoolLabel
+		}
+		return pools[i].PoolUUID.String() < pools[j].PoolUUID.String()
+	})
+
+	resp := &mgmtpb.SystemDrainResp{Reint: req.Reint}
+	reqRanks := fReq.Ranks.Ranks()
+	for _, ps := range pools {
+		curRanks, err := svc.getPoolRanks(ctx, ps.PoolUUID)
+		if err != nil {
+			svc.log.Errorf("%s", err)
+			resp.Results = append(resp.Results, &mgmtpb.PoolRankResult{
+				Status: daos.MiscError.Int32(),
+				Msg:    err.Error(),
+				PoolId: poolDisplayID(ps),
+				Ranks:  fReq.Ranks.String(),
+			})
+			continue
+		}
+
+		var poolRanks []ranklist.Rank
+		for _, r := range curRanks.Ranks() {
+			if r.InList(reqRanks) {
+				poolRanks = append(poolRanks, r)
+			}
+		}
+		if len(poolRanks) == 0 {
+			continue
+		}
+
+		resp.Results = append(resp.Results, svc.drainPoolRanks(ctx, req, ps, poolRanks)...)
+	}
+
+	return resp, nil
+}
+
+// poolDisplayID returns the label of the pool if set, or its UUID otherwise.
+func poolDisplayID(ps *system.PoolService) string {
+	if ps.PoolLabel != "" {
+		return ps.PoolLabel
+	}
+	return ps.PoolUUID.String()
+}
+
+// drainPoolRanks drains (or reintegrates) each of the supplied ranks on the
+// pool. Ranks with identical outcomes are reported in a single result.
+func (svc *mgmtSvc) drainPoolRanks(ctx context.Context, req *mgmtpb.SystemDrainReq, ps *system.PoolService, ranks []ranklist.Rank) []*mgmtpb.PoolRankResult {
+	poolID := poolDisplayID(ps)
+
+	var results []*mgmtpb.PoolRankResult
+	resultRanks := make(map[*mgmtpb.PoolRankResult]*ranklist.RankSet)
+	for _, r := range ranks {
+		status, err := svc.drainPoolRank(ctx, req.Sys, ps.PoolUUID, r, req.Reint)
+
+		var msg string
+		switch {
+		case err != nil:
+			status = daos.MiscError.Int32()
+			msg = err.Error()
+		case status != 0:
+			msg = daos.Status(status).Error()
+		}
+		if status != 0 {
+			svc.log.Errorf("pool %s rank %d: %s", poolID, r, msg)
+		}
+
+		var res *mgmtpb.PoolRankResult
+		for _, extant := range results {
+			if extant.Status == status && extant.Msg == msg {
+				res = extant
+				break
+			}
+		}
+		if res == nil {
+			res = &mgmtpb.PoolRankResult{
+				Status: status,
+				Msg:    msg,
+				PoolId: poolID,
+			}
+			results = append(results, res)
+			resultRanks[res] = ranklist.NewRankSet()
+		}
+		resultRanks[res].Add(r)
+	}
+
+	for _, res := range results {
+		res.Ranks = resultRanks[res].String()
+	}
+
+	return results
+}
+
 // ClusterEvent management service gRPC handler receives ClusterEvent requests
 // from control-plane instances attempting to notify the MS of a cluster event
 // in the DAOS system (this handler should only get called on the MS leader).
@@ -1018,11 +1153,29 @@ func (svc *mgmtSvc) updatePoolPropsWithSysProps(ctx context.Context, systemPrope
 		}
 	}
 
+	var mgmtProps []*mgmtpb.PoolProperty
+	pspr.Properties, mgmtProps = splitMgmtPoolProps(pspr.Properties)
+
 	pools, err := svc.sysdb.PoolServiceList(false)
 	if err != nil {
 		return nil, err
 	}
 	for _, ps := range pools {
+		if len(mgmtProps) > 0 {
+			lock, err := svc.sysdb.TakePoolLock(ctx, ps.PoolUUID)
+			if err != nil {
+				return nil, err
+			}
+			err = svc.updatePoolMgmtProps(lock.InContext(ctx), ps.PoolUUID, mgmtProps)
+			lock.Release()
+			if err != nil {
+				return nil, err
+			}
+		}
+		if len(pspr.Properties) == 0 {
+			continue
+		}
+
 		pspr.Id = ps.PoolUUID.String()
 		pspr.SvcRanks = ranklist.RanksToUint32(ps.Replicas)
 		dResp, err := svc.makePoolServiceCall(ctx, drpc.MethodPoolSetProp, pspr)
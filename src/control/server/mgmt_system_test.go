@@ -31,6 +31,7 @@ import (
 	"github.com/daos-stack/daos/src/control/drpc"
 	"github.com/daos-stack/daos/src/control/events"
 	"github.com/daos-stack/daos/src/control/lib/control"
+	"github.com/daos-stack/daos/src/control/lib/daos"
 	"github.com/daos-stack/daos/src/control/lib/hardware"
 	"github.com/daos-stack/daos/src/control/lib/ranklist"
 	"github.com/daos-stack/daos/src/control/logging"
@@ -124,6 +125,7 @@ func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 				MsRanks:     []uint32{0},
 				DataVersion: 2,
 				Sys:         build.DefaultSystemName,
+				MsReplicas:  []string{msReplica.Addr.String()},
 			},
 		},
 		"Server uses TCP sockets + Ethernet": {
@@ -157,6 +159,7 @@ func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 				MsRanks:     []uint32{0},
 				DataVersion: 2,
 				Sys:         build.DefaultSystemName,
+				MsReplicas:  []string{msReplica.Addr.String()},
 			},
 		},
 		"older client (AllRanks: false)": {
@@ -186,6 +189,7 @@ func TestServer_MgmtSvc_GetAttachInfo(t *testing.T) {
 				MsRanks:     []uint32{0},
 				DataVersion: 2,
 				Sys:         build.DefaultSystemName,
+				MsReplicas:  []string{msReplica.Addr.String()},
 			},
 		},
 	} {
@@ -1742,6 +1746,208 @@ func TestServer_MgmtSvc_SystemExclude(t *testing.T) {
 	}
 }
 
+func TestServer_MgmtSvc_SystemDrain(t *testing.T) {
+	mockPool := func(idx int32, label string, ranks ...ranklist.Rank) *system.PoolService {
+		ps := system.NewPoolService(test.MockPoolUUID(idx), []uint64{1, 1}, ranks)
+		ps.PoolLabel = label
+		ps.State = system.PoolServiceStateReady
+		ps.Replicas = []ranklist.Rank{0}
+		return ps
+	}
+	drpcSuccess := func(msg proto.Message) *mockDrpcResponse {
+		return &mockDrpcResponse{Status: drpc.Status_SUCCESS, Message: msg}
+	}
+	// Pool membership is taken from the pool map rather than the ranks
+	// recorded at creation.
+	queryResp := func(enabled, disabled string) *mockDrpcResponse {
+		return drpcSuccess(&mgmtpb.PoolQueryResp{
+			EnabledRanks:  enabled,
+			DisabledRanks: disabled,
+		})
+	}
+	poolAQuery := queryResp("0-2", "3")
+	poolBQuery := queryResp("2-3", "")
+
+	for name, tc := range map[string]struct {
+		req        *mgmtpb.SystemDrainReq
+		drpcResps  []*mockDrpcResponse
+		expMethods []drpc.Method
+		expResp    *mgmtpb.SystemDrainResp
+		expErr     error
+	}{
+		"nil req": {
+			req:    (*mgmtpb.SystemDrainReq)(nil),
+			expErr: errors.New("nil request"),
+		},
+		"not system leader": {
+			req:    &mgmtpb.SystemDrainReq{Sys: "quack"},
+			expErr: FaultWrongSystem("quack", build.DefaultSystemName),
+		},
+		"no hosts or ranks": {
+			req:    &mgmtpb.SystemDrainReq{},
+			expErr: errors.New("no hosts or ranks"),
+		},
+		"hosts and ranks": {
+			req: &mgmtpb.SystemDrainReq{
+				Hosts: "host1,host2",
+				Ranks: "0,1",
+			},
+			expErr: errors.New("ranklist and hostlist"),
+		},
+		"invalid ranks": {
+			req:    &mgmtpb.SystemDrainReq{Ranks: "41,42"},
+			expErr: errors.New("invalid rank(s): 41-42"),
+		},
+		"no pools on ranks": {
+			req:        &mgmtpb.SystemDrainReq{Ranks: "4"},
+			drpcResps:  []*mockDrpcResponse{poolAQuery, poolBQuery},
+			expMethods: []drpc.Method{drpc.MethodPoolQuery, drpc.MethodPoolQuery},
+			expResp:    &mgmtpb.SystemDrainResp{},
+		},
+		"pool extended onto ranks": {
+			req: &mgmtpb.SystemDrainReq{Ranks: "4"},
+			drpcResps: []*mockDrpcResponse{
+				poolAQuery,
+				queryResp("2-4", ""),
+				drpcSuccess(&mgmtpb.PoolDrainResp{}),
+			},
+			expMethods: []drpc.Method{
+				drpc.MethodPoolQuery, drpc.MethodPoolQuery, drpc.MethodPoolDrain,
+			},
+			expResp: &mgmtpb.SystemDrainResp{
+				Results: []*mgmtpb.PoolRankResult{
+					{PoolId: "pool-b", Ranks: "4"},
+				},
+			},
+		},
+		"pool query fails": {
+			req: &mgmtpb.SystemDrainReq{Ranks: "2"},
+			drpcResps: []*mockDrpcResponse{
+				drpcSuccess(&mgmtpb.PoolQueryResp{Status: daos.Busy.Int32()}),
+				poolBQuery,
+				drpcSuccess(&mgmtpb.PoolDrainResp{}),
+			},
+			expMethods: []drpc.Method{
+				drpc.MethodPoolQuery, drpc.MethodPoolQuery, drpc.MethodPoolDrain,
+			},
+			expResp: &mgmtpb.SystemDrainResp{
+				Results: []*mgmtpb.PoolRankResult{
+					{
+						Status: daos.MiscError.Int32(),
+						Msg:    "query pool " + test.MockPoolUUID(1).String() + ": " + daos.Busy.Error(),
+						PoolId: "pool-a",
+						Ranks:  "2",
+					},
+					{PoolId: "pool-b", Ranks: "2"},
+				},
+			},
+		},
+		"drain ranks": {
+			req: &mgmtpb.SystemDrainReq{Ranks: "1-2"},
+			drpcResps: []*mockDrpcResponse{
+				poolAQuery,
+				drpcSuccess(&mgmtpb.PoolDrainResp{}),
+				drpcSuccess(&mgmtpb.PoolDrainResp{}),
+				poolBQuery,
+				drpcSuccess(&mgmtpb.PoolDrainResp{}),
+			},
+			expMethods: []drpc.Method{
+				drpc.MethodPoolQuery, drpc.MethodPoolDrain, drpc.MethodPoolDrain,
+				drpc.MethodPoolQuery, drpc.MethodPoolDrain,
+			},
+			expResp: &mgmtpb.SystemDrainResp{
+				Results: []*mgmtpb.PoolRankResult{
+					{PoolId: "pool-a", Ranks: "1-2"},
+					{PoolId: "pool-b", Ranks: "2"},
+				},
+			},
+		},
+		"drain fails on one rank": {
+			req: &mgmtpb.SystemDrainReq{Ranks: "0-2"},
+			drpcResps: []*mockDrpcResponse{
+				poolAQuery,
+				drpcSuccess(&mgmtpb.PoolDrainResp{}),
+				drpcSuccess(&mgmtpb.PoolDrainResp{Status: daos.Nonexistent.Int32()}),
+				drpcSuccess(&mgmtpb.PoolDrainResp{}),
+				poolBQuery,
+				drpcSuccess(&mgmtpb.PoolDrainResp{}),
+			},
+			expMethods: []drpc.Method{
+				drpc.MethodPoolQuery, drpc.MethodPoolDrain, drpc.MethodPoolDrain,
+				drpc.MethodPoolDrain, drpc.MethodPoolQuery, drpc.MethodPoolDrain,
+			},
+			expResp: &mgmtpb.SystemDrainResp{
+				Results: []*mgmtpb.PoolRankResult{
+					{PoolId: "pool-a", Ranks: "0,2"},
+					{
+						Status: daos.Nonexistent.Int32(),
+						Msg:    daos.Nonexistent.Error(),
+						PoolId: "pool-a",
+						Ranks:  "1",
+					},
+					{PoolId: "pool-b", Ranks: "2"},
+				},
+			},
+		},
+		"reintegrate ranks": {
+			req: &mgmtpb.SystemDrainReq{Ranks: "3", Reint: true},
+			drpcResps: []*mockDrpcResponse{
+				poolAQuery,
+				drpcSuccess(&mgmtpb.PoolReintegrateResp{}),
+				poolBQuery,
+				drpcSuccess(&mgmtpb.PoolReintegrateResp{}),
+			},
+			expMethods: []drpc.Method{
+				drpc.MethodPoolQuery, drpc.MethodPoolReintegrate,
+				drpc.MethodPoolQuery, drpc.MethodPoolReintegrate,
+			},
+			expResp: &mgmtpb.SystemDrainResp{
+				Reint: true,
+				Results: []*mgmtpb.PoolRankResult{
+					{PoolId: "pool-a", Ranks: "3"},
+					{PoolId: "pool-b", Ranks: "3"},
+				},
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			svc := newTestMgmtSvc(t, log)
+			for i := uint32(0); i < 5; i++ {
+				if err := svc.sysdb.AddMember(system.MockMember(t, i, system.MemberStateJoined)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			// Add pools in reverse order to check that results are sorted.
+			addTestPoolService(t, svc.sysdb, mockPool(2, "pool-b", 2, 3))
+			addTestPoolService(t, svc.sysdb, mockPool(1, "pool-a", 0, 1, 2, 3))
+
+			cfg := new(mockDrpcClientConfig)
+			cfg.setSendMsgResponseList(t, tc.drpcResps...)
+			mdc := newMockDrpcClient(cfg)
+			svc.harness.instances[0].(*EngineInstance).setDrpcClient(mdc)
+
+			if tc.req != nil && tc.req.Sys == "" {
+				tc.req.Sys = build.DefaultSystemName
+			}
+			gotResp, gotErr := svc.SystemDrain(test.Context(t), tc.req)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expResp, gotResp, protocmp.Transform()); diff != "" {
+				t.Fatalf("unexpected response (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expMethods, mdc.CalledMethods()); diff != "" {
+				t.Fatalf("unexpected dRPC calls (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
 func TestServer_MgmtSvc_SystemErase(t *testing.T) {
 	hr := func(a int32, rrs ...*sharedpb.RankResult) *control.HostResponse {
 		return &control.HostResponse{
@@ -105,6 +105,9 @@ type server struct {
 	pubSub       *events.PubSub
 	evtForwarder *control.EventForwarder
 	evtLogger    *control.EventLogger
+	evtJournal   *events.Journal
+	evtSinks     []*events.WebhookSink
+	auditor      *auditor
 	ctlSvc       *ControlService
 	mgmtSvc      *mgmtSvc
 	grpcServer   *grpc.Server
@@ -157,11 +160,19 @@ func CreateDatabaseConfig(cfg *config.Server) (*raft.DatabaseConfig, error) {
 		return nil, errors.New("raft directory not available (missing SCM or control metadata in config?)")
 	}
 
-	return &raft.DatabaseConfig{
+	dbCfg := &raft.DatabaseConfig{
 		Replicas:   dbReplicas,
 		RaftDir:    raftDir,
 		SystemName: cfg.SystemName,
-	}, nil
+	}
+
+	// A replica set persisted after a runtime change overrides
+	// the configured access points.
+	if _, err := dbCfg.LoadReplicas(); err != nil {
+		return nil, errors.Wrap(err, "unable to load persisted replicas")
+	}
+
+	return dbCfg, nil
 }
 
 // newManagementDatabase creates a new instance of the raft-backed management database.
@@ -197,10 +208,38 @@ func (srv *server) createServices(ctx context.Context) (err error) {
 	srv.OnShutdown(srv.pubSub.Close)
 	srv.evtForwarder = control.NewEventForwarder(rpcClient, srv.cfg.AccessPoints)
 	srv.evtLogger = control.NewEventLogger(srv.log)
+	srv.evtJournal = newEventJournal(srv.log, srv.cfg)
+	if srv.evtJournal != nil {
+		srv.OnShutdown(func() {
+			if err := srv.evtJournal.Close(); err != nil {
+				srv.log.Errorf("failed to close RAS event journal: %s", err)
+			}
+		})
+	}
+
+	srv.auditor = newAuditor(srv.log, srv.hostname, configureAuditLog(srv.log, srv.cfg), srv.sysdb)
+	srv.auditor.start(ctx)
+	if srv.auditor.auditLog != nil {
+		srv.OnShutdown(func() {
+			if err := srv.auditor.auditLog.Close(); err != nil {
+				srv.log.Errorf("failed to close audit log: %s", err)
+			}
+		})
+	}
+
+	srv.evtSinks, err = newEventSinks(srv.log, srv.cfg)
+	if err != nil {
+		return
+	}
+	for _, sink := range srv.evtSinks {
+		sink.Start(ctx)
+	}
 
 	srv.ctlSvc = NewControlService(srv.log, srv.harness, srv.cfg, srv.pubSub,
 		hwprov.DefaultFabricScanner(srv.log))
 	srv.mgmtSvc = newMgmtSvc(srv.harness, srv.membership, srv.sysdb, rpcClient, srv.pubSub)
+	srv.mgmtSvc.evtJournal = srv.evtJournal
+	srv.mgmtSvc.backupCfg = srv.cfg.MSBackup
 
 	if err := srv.mgmtSvc.systemProps.UpdateCompPropVal(daos.SystemPropertyDaosSystem, func() string {
 		return srv.cfg.SystemName
@@ -360,7 +399,7 @@ func (srv *server) addEngines(ctx context.Context) error {
 
 // setupGrpc creates a new grpc server and registers services.
 func (srv *server) setupGrpc() error {
-	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, srv.sysdb.IsLeader)
+	srvOpts, err := getGrpcOpts(srv.log, srv.cfg.TransportConfig, srv.sysdb.IsLeader, srv.auditor)
 	if err != nil {
 		return err
 	}
@@ -400,7 +439,13 @@ func (srv *server) registerEvents() {
 		func(ctx context.Context) error {
 			srv.log.Infof("MS leader running on %s", srv.hostname)
 			srv.mgmtSvc.startLeaderLoops(ctx)
+			if srv.evtJournal != nil {
+				if err := srv.evtJournal.Open(); err != nil {
+					srv.log.Errorf("RAS event journal unavailable: %s", err)
+				}
+			}
 			registerLeaderSubscriptions(srv)
+			srv.mgmtSvc.notifyLeadershipChange()
 			srv.log.Debugf("requesting immediate GroupUpdate after leader change")
 			go func() {
 				for {
@@ -430,6 +475,12 @@ func (srv *server) registerEvents() {
 	srv.sysdb.OnLeadershipLost(func() error {
 		srv.log.Infof("MS leader no longer running on %s", srv.hostname)
 		registerFollowerSubscriptions(srv)
+		srv.mgmtSvc.notifyLeadershipChange()
+		if srv.evtJournal != nil {
+			if err := srv.evtJournal.Close(); err != nil {
+				srv.log.Errorf("failed to close RAS event journal: %s", err)
+			}
+		}
 		return nil
 	})
 }
@@ -49,6 +49,10 @@ const (
 	// scanMinHugepageCount is the minimum number of hugepages to allocate in order to satisfy
 	// SPDK memory requirements when performing a NVMe device scan.
 	scanMinHugepageCount = 128
+
+	// evtJournalFile is the name of the RAS event journal file created in the
+	// control plane metadata directory.
+	evtJournalFile = "daos_ras_journal.db"
 )
 
 // netListenerFn is a type alias for the net.Listener function signature.
@@ -120,6 +124,98 @@ func cfgGetReplicas(cfg *config.Server, lookup ipLookupFn) ([]*net.TCPAddr, erro
 	return dbReplicas, nil
 }
 
+// newEventJournal returns a RAS event journal stored in the control plane
+// metadata directory, or nil if the journal is disabled or cannot be located.
+func newEventJournal(log logging.Logger, cfg *config.Server) *events.Journal {
+	if cfg.EventJournal.Disabled {
+		log.Debug("RAS event journal disabled")
+		return nil
+	}
+
+	raftDir := cfgGetRaftDir(cfg)
+	if raftDir == "" {
+		log.Debug("RAS event journal disabled; no control metadata directory")
+		return nil
+	}
+
+	return events.NewJournal(log, events.JournalConfig{
+		Path:    filepath.Join(raftDir, evtJournalFile),
+		MaxAge:  cfg.EventJournal.MaxAge,
+		MaxSize: cfg.EventJournal.MaxSizeBytes(),
+	})
+}
+
+// configureAuditLog returns the local audit log, or nil if the audit log is
+// disabled or no location for it is available.
+func configureAuditLog(log logging.Logger, cfg *config.Server) *auditLog {
+	alc := cfg.AuditLog
+	if alc.Disabled {
+		log.Debug("audit log disabled")
+		return nil
+	}
+
+	path := alc.Path
+	switch {
+	case path != "":
+	case cfg.Metadata.Path != "":
+		path = filepath.Join(cfg.Metadata.Directory(), auditLogFile)
+	case cfg.ControlLogFile != "":
+		path = filepath.Join(filepath.Dir(cfg.ControlLogFile), auditLogFile)
+	default:
+		log.Notice("audit log disabled; no control metadata directory or log file")
+		return nil
+	}
+
+	return newAuditLog(path, alc.MaxSizeBytes(), alc.MaxFiles)
+}
+
+// newEventSinks returns a webhook sink for each of the event sinks defined in
+// the server config.
+func newEventSinks(log logging.Logger, cfg *config.Server) ([]*events.WebhookSink, error) {
+	sinks := make([]*events.WebhookSink, 0, len(cfg.EventSinks))
+	for idx, esc := range cfg.EventSinks {
+		name := esc.Name
+		if name == "" {
+			name = strconv.Itoa(idx)
+		}
+
+		filter := new(events.Filter)
+		for _, evtName := range esc.Events {
+			id, err := events.RASIDFromString(evtName)
+			if err != nil {
+				return nil, errors.Wrapf(err, "event sink %s", name)
+			}
+			filter.IDs = append(filter.IDs, id)
+		}
+		if esc.MinSeverity != "" {
+			sev, err := events.RASSeverityFromString(esc.MinSeverity)
+			if err != nil {
+				return nil, errors.Wrapf(err, "event sink %s", name)
+			}
+			filter.MinSeverity = sev
+		}
+
+		sink, err := events.NewWebhookSink(log, events.WebhookConfig{
+			URLs:          esc.URLs,
+			Headers:       esc.Headers,
+			Filter:        filter,
+			BatchSize:     esc.BatchSize,
+			BatchInterval: esc.BatchInterval,
+			Timeout:       esc.Timeout,
+			MaxRetries:    esc.MaxRetries,
+			RetryInterval: esc.RetryInterval,
+			SpoolSize:     esc.SpoolSize,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "event sink %s", name)
+		}
+		log.Debugf("created RAS event sink %s (%d urls)", name, len(esc.URLs))
+		sinks = append(sinks, sink)
+	}
+
+	return sinks, nil
+}
+
 func cfgGetRaftDir(cfg *config.Server) string {
 	raftDirName := "control_raft"
 	if cfg.Metadata.Path != "" {
@@ -570,6 +666,9 @@ func registerEngineEventCallbacks(srv *server, engine *EngineInstance, allStarte
 	// Register callback to publish engine process exit events.
 	engine.OnInstanceExit(createPublishInstanceExitFunc(srv.pubSub.Publish, srv.hostname))
 
+	// Register callback to publish abandoned automatic restart events.
+	engine.OnRestartAbandoned(createPublishRestartAbandonedFunc(srv.pubSub.Publish, srv.hostname))
+
 	engine.OnInstanceExit(func(_ context.Context, _ uint32, _ ranklist.Rank, _ error, _ int) error {
 		if engine.storage.BdevRoleMetaConfigured() {
 			return engine.storage.UnmountTmpfs()
@@ -620,14 +719,21 @@ func registerEngineEventCallbacks(srv *server, engine *EngineInstance, allStarte
 }
 
 func configureFirstEngine(ctx context.Context, engine *EngineInstance, sysdb *raft.Database, join systemJoinFn) {
-	if !sysdb.IsReplica() {
-		return
-	}
-
 	// Start the system db after instance 0's SCM is ready.
 	var onceStorageReady sync.Once
 	engine.OnStorageReady(func(_ context.Context) (err error) {
 		onceStorageReady.Do(func() {
+			// The replica set may have been changed at runtime and
+			// persisted in a location that was not accessible
+			// until now.
+			if err = sysdb.LoadReplicas(); err != nil {
+				err = errors.Wrap(err, "failed to load system db replicas")
+				return
+			}
+			if !sysdb.IsReplica() {
+				return
+			}
+
 			// NB: We use the outer context rather than
 			// the closure context in order to avoid
 			// tying the db to the instance.
@@ -668,7 +774,7 @@ func registerTelemetryCallbacks(ctx context.Context, srv *server) {
 
 	srv.OnEnginesStarted(func(ctxIn context.Context) error {
 		srv.log.Debug("starting Prometheus exporter")
-		cleanup, err := startPrometheusExporter(ctxIn, srv.log, telemPort, srv.harness.Instances())
+		cleanup, err := startPrometheusExporter(ctxIn, srv.log, telemPort, srv.harness.Instances(), srv.sysdb)
 		if err != nil {
 			return err
 		}
@@ -679,11 +785,15 @@ func registerTelemetryCallbacks(ctx context.Context, srv *server) {
 
 // registerFollowerSubscriptions stops handling received forwarded (in addition
 // to local) events and starts forwarding events to the new MS leader.
-// Log events on the host that they were raised (and first published) on.
+// Log events on the host that they were raised (and first published) on and
+// deliver them from there to any configured event sinks.
 // This is the initial behavior before leadership has been determined.
 func registerFollowerSubscriptions(srv *server) {
 	srv.pubSub.Reset()
 	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
+	for _, sink := range srv.evtSinks {
+		srv.pubSub.Subscribe(events.RASTypeAny, sink)
+	}
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.evtForwarder)
 }
 
@@ -692,6 +802,12 @@ func registerFollowerSubscriptions(srv *server) {
 func registerLeaderSubscriptions(srv *server) {
 	srv.pubSub.Reset()
 	srv.pubSub.Subscribe(events.RASTypeAny, srv.evtLogger)
+	for _, sink := range srv.evtSinks {
+		srv.pubSub.Subscribe(events.RASTypeAny, sink)
+	}
+	if srv.evtJournal != nil && srv.evtJournal.IsOpen() {
+		srv.pubSub.Subscribe(events.RASTypeAny, srv.evtJournal)
+	}
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.membership)
 	srv.pubSub.Subscribe(events.RASTypeStateChange, srv.sysdb)
 	srv.pubSub.Subscribe(events.RASTypeStateChange,
@@ -728,13 +844,20 @@ func registerLeaderSubscriptions(srv *server) {
 }
 
 // getGrpcOpts generates a set of gRPC options for the server based on the supplied configuration.
-func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldrChk func() bool) ([]grpc.ServerOption, error) {
+func getGrpcOpts(log logging.Logger, cfgTransport *security.TransportConfig, ldrChk func() bool, aud *auditor) ([]grpc.ServerOption, error) {
 	unaryInterceptors := []grpc.UnaryServerInterceptor{
 		unaryLoggingInterceptor(log, ldrChk), // must be first in order to properly log errors
+	}
+	if aud != nil {
+		// Audit before the remaining interceptors so that rejected
+		// requests are also recorded.
+		unaryInterceptors = append(unaryInterceptors, unaryAuditInterceptor(aud))
+	}
+	unaryInterceptors = append(unaryInterceptors,
 		unaryErrorInterceptor,
 		unaryStatusInterceptor,
 		unaryVersionInterceptor(log),
-	}
+	)
 	streamInterceptors := []grpc.StreamServerInterceptor{
 		streamErrorInterceptor,
 	}
@@ -1316,3 +1316,67 @@ func TestServer_processFabricProvider(t *testing.T) {
 		})
 	}
 }
+
+func TestServerUtils_newEventSinks(t *testing.T) {
+	for name, tc := range map[string]struct {
+		sinks    []config.EventSinkConfig
+		expCount int
+		expErr   error
+	}{
+		"no sinks": {},
+		"unknown event": {
+			sinks: []config.EventSinkConfig{
+				{
+					Name:   "foo",
+					URLs:   []string{"http://localhost/hook"},
+					Events: []string{"quack"},
+				},
+			},
+			expErr: errors.New("event sink foo"),
+		},
+		"unknown severity": {
+			sinks: []config.EventSinkConfig{
+				{
+					URLs:        []string{"http://localhost/hook"},
+					MinSeverity: "dire",
+				},
+			},
+			expErr: errors.New("event sink 0"),
+		},
+		"bad url": {
+			sinks: []config.EventSinkConfig{
+				{URLs: []string{"localhost/hook"}},
+			},
+			expErr: errors.New("scheme must be http or https"),
+		},
+		"multiple sinks": {
+			sinks: []config.EventSinkConfig{
+				{
+					Name:        "foo",
+					URLs:        []string{"http://localhost/hook"},
+					Events:      []string{"engine_died"},
+					MinSeverity: "warning",
+				},
+				{
+					URLs: []string{"https://host1/hook", "https://host2/hook"},
+				},
+			},
+			expCount: 2,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			cfg := config.DefaultServer().WithEventSinks(tc.sinks...)
+
+			sinks, err := newEventSinks(log, cfg)
+			test.CmpErr(t, tc.expErr, err)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expCount, len(sinks), "unexpected number of sinks")
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2018-2022 Intel Corporation.
+// (C) Copyright 2018-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -72,10 +72,30 @@ func regPromEngineSources(ctx context.Context, log logging.Logger, engines []Eng
 	return nil
 }
 
-func startPrometheusExporter(ctx context.Context, log logging.Logger, port int, engines []Engine) (func(), error) {
+// regPromSysdbSource registers the system database metrics, which are
+// exported by all MS replicas.
+func regPromSysdbSource(log logging.Logger, sysdb prometheus.Collector) error {
+	if sysdb == nil {
+		return nil
+	}
+
+	log.Debug("Setting up metrics collection for the system database")
+	if err := prometheus.Register(sysdb); err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+			return errors.Wrap(err, "failed to register system database metrics")
+		}
+	}
+
+	return nil
+}
+
+func startPrometheusExporter(ctx context.Context, log logging.Logger, port int, engines []Engine, sysdb prometheus.Collector) (func(), error) {
 	if err := regPromEngineSources(ctx, log, engines); err != nil {
 		return nil, err
 	}
+	if err := regPromSysdbSource(log, sysdb); err != nil {
+		return nil, err
+	}
 
 	listenAddress := fmt.Sprintf("0.0.0.0:%d", port)
 
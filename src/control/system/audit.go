@@ -0,0 +1,92 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"net"
+	"strings"
+	"time"
+)
+
+// AuditStatusOK is the status recorded for a request that completed
+// without error.
+const AuditStatusOK = "OK"
+
+// AuditRecord describes a single mutating control plane request, as recorded
+// by the server which handled it.
+type AuditRecord struct {
+	Sequence  uint64        `json:"sequence,omitempty"`
+	Timestamp time.Time     `json:"timestamp"`
+	Host      string        `json:"host"`
+	Method    string        `json:"method"`
+	Caller    string        `json:"caller"`
+	Peer      string        `json:"peer"`
+	Request   string        `json:"request,omitempty"`
+	Status    string        `json:"status"`
+	Error     string        `json:"error,omitempty"`
+	Duration  time.Duration `json:"duration"`
+}
+
+// ShortMethod returns the method name without the gRPC service prefix,
+// e.g. "PoolDestroy" for "/mgmt.MgmtSvc/PoolDestroy".
+func (ar *AuditRecord) ShortMethod() string {
+	if ar == nil {
+		return ""
+	}
+	return ar.Method[strings.LastIndex(ar.Method, "/")+1:]
+}
+
+// Failed returns true if the audited request returned an error.
+func (ar *AuditRecord) Failed() bool {
+	return ar != nil && ar.Status != AuditStatusOK
+}
+
+// AuditFilter defines criteria for matching audit records. Unset fields
+// match all records.
+type AuditFilter struct {
+	Method     string
+	Caller     string
+	Peer       string
+	Since      time.Time
+	Until      time.Time
+	FailedOnly bool
+}
+
+// Matches returns true if the supplied audit record matches the filter.
+func (af *AuditFilter) Matches(ar *AuditRecord) bool {
+	if ar == nil {
+		return false
+	}
+	if af == nil {
+		return true
+	}
+
+	if af.Method != "" && !strings.EqualFold(af.Method, ar.Method) &&
+		!strings.EqualFold(af.Method, ar.ShortMethod()) {
+		return false
+	}
+	if af.Caller != "" && !strings.EqualFold(af.Caller, ar.Caller) {
+		return false
+	}
+	if af.Peer != "" && af.Peer != ar.Peer {
+		// Allow the peer to be matched by host alone.
+		if host, _, err := net.SplitHostPort(ar.Peer); err != nil || host != af.Peer {
+			return false
+		}
+	}
+	if !af.Since.IsZero() && ar.Timestamp.Before(af.Since) {
+		return false
+	}
+	if !af.Until.IsZero() && ar.Timestamp.After(af.Until) {
+		return false
+	}
+	if af.FailedOnly && !ar.Failed() {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,115 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+	"time"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSystem_AuditFilter_Matches(t *testing.T) {
+	now := time.Now()
+	rec := &AuditRecord{
+		Timestamp: now,
+		Method:    "/mgmt.MgmtSvc/PoolDestroy",
+		Caller:    "admin",
+		Peer:      "10.0.0.1:45678",
+		Status:    AuditStatusOK,
+	}
+	failed := &AuditRecord{
+		Timestamp: now,
+		Method:    "/mgmt.MgmtSvc/SystemExclude",
+		Caller:    "admin",
+		Peer:      "10.0.0.1:45678",
+		Status:    "Unknown",
+		Error:     "oops",
+	}
+
+	for name, tc := range map[string]struct {
+		filter   *AuditFilter
+		rec      *AuditRecord
+		expMatch bool
+	}{
+		"nil record": {
+			filter: &AuditFilter{},
+		},
+		"nil filter": {
+			rec:      rec,
+			expMatch: true,
+		},
+		"empty filter": {
+			filter:   &AuditFilter{},
+			rec:      rec,
+			expMatch: true,
+		},
+		"full method": {
+			filter:   &AuditFilter{Method: "/mgmt.MgmtSvc/PoolDestroy"},
+			rec:      rec,
+			expMatch: true,
+		},
+		"short method": {
+			filter:   &AuditFilter{Method: "pooldestroy"},
+			rec:      rec,
+			expMatch: true,
+		},
+		"method mismatch": {
+			filter: &AuditFilter{Method: "PoolCreate"},
+			rec:    rec,
+		},
+		"caller": {
+			filter:   &AuditFilter{Caller: "ADMIN"},
+			rec:      rec,
+			expMatch: true,
+		},
+		"caller mismatch": {
+			filter: &AuditFilter{Caller: "agent"},
+			rec:    rec,
+		},
+		"peer host": {
+			filter:   &AuditFilter{Peer: "10.0.0.1"},
+			rec:      rec,
+			expMatch: true,
+		},
+		"peer address": {
+			filter:   &AuditFilter{Peer: "10.0.0.1:45678"},
+			rec:      rec,
+			expMatch: true,
+		},
+		"peer mismatch": {
+			filter: &AuditFilter{Peer: "10.0.0.2"},
+			rec:    rec,
+		},
+		"since": {
+			filter:   &AuditFilter{Since: now.Add(-time.Minute)},
+			rec:      rec,
+			expMatch: true,
+		},
+		"before since": {
+			filter: &AuditFilter{Since: now.Add(time.Minute)},
+			rec:    rec,
+		},
+		"after until": {
+			filter: &AuditFilter{Until: now.Add(-time.Minute)},
+			rec:    rec,
+		},
+		"failed only; success": {
+			filter: &AuditFilter{FailedOnly: true},
+			rec:    rec,
+		},
+		"failed only; failure": {
+			filter:   &AuditFilter{FailedOnly: true},
+			rec:      failed,
+			expMatch: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expMatch, tc.filter.Matches(tc.rec), "unexpected match result")
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -313,3 +313,37 @@ func IsErrSystemAttrNotFound(err error) bool {
 	_, ok := errors.Cause(err).(*errSystemAttrNotFound)
 	return ok
 }
+
+type errPoolProfileNotFound struct {
+	name string
+}
+
+func (err *errPoolProfileNotFound) Error() string {
+	return fmt.Sprintf("unable to find pool profile %q", err.name)
+}
+
+func ErrPoolProfileNotFound(name string) *errPoolProfileNotFound {
+	return &errPoolProfileNotFound{name: name}
+}
+
+func IsPoolProfileNotFound(err error) bool {
+	_, ok := errors.Cause(err).(*errPoolProfileNotFound)
+	return ok
+}
+
+type errPoolProfileExists struct {
+	name string
+}
+
+func (err *errPoolProfileExists) Error() string {
+	return fmt.Sprintf("pool profile %q already exists", err.name)
+}
+
+func ErrPoolProfileExists(name string) *errPoolProfileExists {
+	return &errPoolProfileExists{name: name}
+}
+
+func IsPoolProfileExists(err error) bool {
+	_, ok := errors.Cause(err).(*errPoolProfileExists)
+	return ok
+}
@@ -0,0 +1,154 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+)
+
+// MaintenancePhase indicates the progress of a member through the
+// maintenance workflow.
+type MaintenancePhase int
+
+const (
+	// MaintenancePhaseNone indicates the member is not in maintenance.
+	MaintenancePhaseNone MaintenancePhase = iota
+	// MaintenancePhaseDraining indicates the member is being drained
+	// from the pools that have targets on it.
+	MaintenancePhaseDraining
+	// MaintenancePhaseRebuilding indicates the member has been drained
+	// and the resulting pool rebuilds are in progress.
+	MaintenancePhaseRebuilding
+	// MaintenancePhaseStopping indicates the member is being stopped.
+	MaintenancePhaseStopping
+	// MaintenancePhaseServiceable indicates the member has been drained
+	// and stopped and may now be serviced.
+	MaintenancePhaseServiceable
+	// MaintenancePhaseStarting indicates the member is being restarted.
+	MaintenancePhaseStarting
+	// MaintenancePhaseReintegrating indicates the member is being
+	// reintegrated into the pools it was drained from.
+	MaintenancePhaseReintegrating
+	// MaintenancePhaseVerifying indicates the workflow is waiting for the
+	// reintegration rebuilds to complete.
+	MaintenancePhaseVerifying
+)
+
+var maintenancePhaseStrings = map[MaintenancePhase]string{
+	MaintenancePhaseNone:          "none",
+	MaintenancePhaseDraining:      "draining",
+	MaintenancePhaseRebuilding:    "rebuilding",
+	MaintenancePhaseStopping:      "stopping",
+	MaintenancePhaseServiceable:   "serviceable",
+	MaintenancePhaseStarting:      "starting",
+	MaintenancePhaseReintegrating: "reintegrating",
+	MaintenancePhaseVerifying:     "verifying",
+}
+
+func (mp MaintenancePhase) String() string {
+	if s, found := maintenancePhaseStrings[mp]; found {
+		return s
+	}
+	return "unknown"
+}
+
+// MaintenancePhaseFromString returns the phase matching the supplied string.
+func MaintenancePhaseFromString(in string) (MaintenancePhase, error) {
+	for mp, s := range maintenancePhaseStrings {
+		if s == strings.ToLower(in) {
+			return mp, nil
+		}
+	}
+	return MaintenancePhaseNone, errors.Errorf("unknown maintenance phase %q", in)
+}
+
+// MarshalJSON marshals the phase as a string.
+func (mp MaintenancePhase) MarshalJSON() ([]byte, error) {
+	return json.Marshal(mp.String())
+}
+
+// UnmarshalJSON unmarshals the phase from a string.
+func (mp *MaintenancePhase) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+
+	phase, err := MaintenancePhaseFromString(s)
+	if err != nil {
+		return err
+	}
+	*mp = phase
+
+	return nil
+}
+
+// IsFinishing returns true if the phase is part of the second half of the
+// workflow, i.e. the member is being returned to service.
+func (mp MaintenancePhase) IsFinishing() bool {
+	return mp >= MaintenancePhaseStarting
+}
+
+// MemberMaintenance records the state of the maintenance workflow for a
+// member. It is stored with the member so that the workflow can be resumed
+// by a new MS leader.
+type MemberMaintenance struct {
+	Phase MaintenancePhase `json:"phase"`
+	// Pools lists the pools that the member has been drained from and
+	// must be reintegrated into when maintenance is finished.
+	Pools []uuid.UUID `json:"pools"`
+	// Error describes the most recent failure of the current phase.
+	Error      string    `json:"error"`
+	LastUpdate time.Time `json:"last_update"`
+}
+
+// Copy returns a deep copy of the maintenance record.
+func (mm *MemberMaintenance) Copy() *MemberMaintenance {
+	if mm == nil {
+		return nil
+	}
+
+	out := new(MemberMaintenance)
+	*out = *mm
+	if mm.Pools != nil {
+		out.Pools = make([]uuid.UUID, len(mm.Pools))
+		copy(out.Pools, mm.Pools)
+	}
+
+	return out
+}
+
+// HasPool returns true if the pool is recorded in the maintenance record.
+func (mm *MemberMaintenance) HasPool(poolUUID uuid.UUID) bool {
+	if mm == nil {
+		return false
+	}
+	for _, p := range mm.Pools {
+		if p == poolUUID {
+			return true
+		}
+	}
+	return false
+}
+
+// InMaintenance returns true if the member is undergoing maintenance.
+func (sm *Member) InMaintenance() bool {
+	return sm.Maintenance != nil && sm.Maintenance.Phase != MaintenancePhaseNone
+}
+
+// MaintenancePhase returns the maintenance phase of the member.
+func (sm *Member) MaintenancePhase() MaintenancePhase {
+	if sm.Maintenance == nil {
+		return MaintenancePhaseNone
+	}
+	return sm.Maintenance.Phase
+}
@@ -0,0 +1,161 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	. "github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystem_MaintenancePhase_FromString(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in       string
+		expPhase MaintenancePhase
+		expErr   error
+	}{
+		"none": {
+			in:       "none",
+			expPhase: MaintenancePhaseNone,
+		},
+		"mixed case": {
+			in:       "Serviceable",
+			expPhase: MaintenancePhaseServiceable,
+		},
+		"verifying": {
+			in:       "verifying",
+			expPhase: MaintenancePhaseVerifying,
+		},
+		"unknown": {
+			in:     "resting",
+			expErr: errors.New("unknown maintenance phase"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotPhase, gotErr := MaintenancePhaseFromString(tc.in)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			test.AssertEqual(t, tc.expPhase, gotPhase, "unexpected phase")
+			test.AssertEqual(t, strings.ToLower(tc.in), gotPhase.String(), "unexpected string")
+		})
+	}
+}
+
+func TestSystem_MaintenancePhase_IsFinishing(t *testing.T) {
+	for phase, exp := range map[MaintenancePhase]bool{
+		MaintenancePhaseDraining:      false,
+		MaintenancePhaseRebuilding:    false,
+		MaintenancePhaseStopping:      false,
+		MaintenancePhaseServiceable:   false,
+		MaintenancePhaseStarting:      true,
+		MaintenancePhaseReintegrating: true,
+		MaintenancePhaseVerifying:     true,
+	} {
+		test.AssertEqual(t, exp, phase.IsFinishing(), phase.String())
+	}
+}
+
+func TestSystem_MemberMaintenance_Copy(t *testing.T) {
+	var nilMM *MemberMaintenance
+	if nilMM.Copy() != nil {
+		t.Fatal("expected nil copy of nil record")
+	}
+
+	orig := &MemberMaintenance{
+		Phase: MaintenancePhaseDraining,
+		Pools: []uuid.UUID{test.MockPoolUUID(1)},
+	}
+	cp := orig.Copy()
+	if diff := cmp.Diff(orig, cp); diff != "" {
+		t.Fatalf("unexpected copy (-want, +got):\n%s\n", diff)
+	}
+
+	cp.Pools[0] = test.MockPoolUUID(2)
+	if !orig.HasPool(test.MockPoolUUID(1)) || orig.HasPool(test.MockPoolUUID(2)) {
+		t.Fatal("modifying copy changed original pools")
+	}
+}
+
+func TestSystem_Membership_UpdateMemberMaintenance(t *testing.T) {
+	for name, tc := range map[string]struct {
+		rank     uint32
+		update   func(*Member) (*MemberMaintenance, error)
+		expPhase MaintenancePhase
+		expErr   error
+	}{
+		"unknown rank": {
+			rank: 3,
+			update: func(*Member) (*MemberMaintenance, error) {
+				return nil, nil
+			},
+			expErr: errors.New("unable to find"),
+		},
+		"update fails": {
+			rank: 1,
+			update: func(*Member) (*MemberMaintenance, error) {
+				return nil, errors.New("nope")
+			},
+			expErr:   errors.New("nope"),
+			expPhase: MaintenancePhaseStopping,
+		},
+		"update phase": {
+			rank: 1,
+			update: func(m *Member) (*MemberMaintenance, error) {
+				if m.MaintenancePhase() != MaintenancePhaseStopping {
+					return nil, errors.New("unexpected phase")
+				}
+				mm := m.Maintenance.Copy()
+				mm.Phase = MaintenancePhaseServiceable
+				return mm, nil
+			},
+			expPhase: MaintenancePhaseServiceable,
+		},
+		"clear": {
+			rank: 1,
+			update: func(*Member) (*MemberMaintenance, error) {
+				return nil, nil
+			},
+			expPhase: MaintenancePhaseNone,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			member := MockMember(t, 1, MemberStateJoined)
+			member.Maintenance = &MemberMaintenance{Phase: MaintenancePhaseStopping}
+			ms := populateMembership(t, log, MockMember(t, 0, MemberStateJoined), member)
+
+			_, gotErr := ms.UpdateMemberMaintenance(ranklist.Rank(tc.rank), tc.update)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.rank != 1 {
+				return
+			}
+
+			got, err := ms.Get(ranklist.Rank(tc.rank))
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.expPhase, got.MaintenancePhase(), "unexpected phase")
+			test.AssertEqual(t, tc.expPhase != MaintenancePhaseNone, got.InMaintenance(), "unexpected maintenance state")
+			if got.InMaintenance() && got.Maintenance.LastUpdate.IsZero() && tc.expErr == nil {
+				t.Fatal("expected last update time to be set")
+			}
+		})
+	}
+}
@@ -187,6 +187,8 @@ type Member struct {
 	Info           string        `json:"info"`
 	FaultDomain    *FaultDomain  `json:"fault_domain"`
 	LastUpdate     time.Time     `json:"last_update"`
+	// Maintenance is set while the member is undergoing maintenance.
+	Maintenance *MemberMaintenance `json:"maintenance,omitempty"`
 }
 
 // MarshalJSON marshals system.Member to JSON.
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
 	"github.com/pkg/errors"
 
 	"github.com/daos-stack/daos/src/control/common/proto/convert"
@@ -74,6 +75,17 @@ func TestSystem_Member_MarshalUnmarshalJSON(t *testing.T) {
 			member: MockMember(t, 3, MemberStateStopped, "info").
 				WithFaultDomain(MustCreateFaultDomainFromString("/test/fault/domain")),
 		},
+		"with maintenance": {
+			member: func() *Member {
+				m := MockMember(t, 4, MemberStateStopped)
+				m.Maintenance = &MemberMaintenance{
+					Phase: MaintenancePhaseServiceable,
+					Pools: []uuid.UUID{test.MockPoolUUID(1)},
+					Error: "oops",
+				}
+				return m
+			}(),
+		},
 	} {
 		t.Run(name, func(t *testing.T) {
 			marshaled, err := tc.member.MarshalJSON()
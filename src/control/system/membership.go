@@ -14,6 +14,7 @@ import (
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
@@ -539,6 +540,35 @@ func (m *Membership) MarkRankDead(rank Rank, incarnation uint64) error {
 	return m.db.UpdateMember(member)
 }
 
+// UpdateMemberMaintenance calls the supplied function with the member of the
+// given rank and stores the maintenance record that it returns. A nil record
+// removes the member from maintenance. The update is serialized with other
+// membership updates so that concurrent changes to the member are not lost.
+func (m *Membership) UpdateMemberMaintenance(rank Rank, update func(*Member) (*MemberMaintenance, error)) (*Member, error) {
+	m.Lock()
+	defer m.Unlock()
+
+	member, err := m.db.FindMemberByRank(rank)
+	if err != nil {
+		return nil, err
+	}
+
+	mm, err := update(member)
+	if err != nil {
+		return nil, err
+	}
+	if mm != nil {
+		mm.LastUpdate = time.Now()
+	}
+	member.Maintenance = mm
+
+	if err := m.db.UpdateMember(member); err != nil {
+		return nil, err
+	}
+
+	return member, nil
+}
+
 func (m *Membership) handleEngineFailure(evt *events.RASEvent) {
 	m.Lock()
 	defer m.Unlock()
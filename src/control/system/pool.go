@@ -57,6 +57,11 @@ type (
 		Replicas   []Rank
 		Storage    *PoolServiceStorage
 		LastUpdate time.Time
+		// MgmtProperties holds the values of pool properties that are
+		// stored by the MS rather than the engine, keyed by number.
+		MgmtProperties map[uint32]uint64
+		// Tags holds operator-defined key/value pairs.
+		Tags PoolTags
 	}
 )
 
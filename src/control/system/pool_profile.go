@@ -0,0 +1,167 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/daos"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+// PoolProfile is a named set of pool creation defaults stored in the
+// system database. Any value supplied explicitly when creating a pool
+// overrides the corresponding profile value.
+type PoolProfile struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	ACL         []string          `json:"acl,omitempty"`
+	TotalBytes  uint64            `json:"total_bytes,omitempty"`
+	SizeRatio   uint32            `json:"size_ratio,omitempty"`
+	TierRatio   []float64         `json:"tier_ratio,omitempty"`
+	NumRanks    uint32            `json:"num_ranks,omitempty"`
+	NumSvcReps  uint32            `json:"num_svc_reps,omitempty"`
+	Ranks       string            `json:"ranks,omitempty"`
+}
+
+// PropertyNames returns the names of the properties set by the profile
+// in sorted order.
+func (pp *PoolProfile) PropertyNames() []string {
+	if pp == nil {
+		return nil
+	}
+
+	names := make([]string, 0, len(pp.Properties))
+	for name := range pp.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+// AllowedRanks returns the set of ranks that pools created with the
+// profile may use, or nil if the profile does not restrict ranks.
+func (pp *PoolProfile) AllowedRanks() (*ranklist.RankSet, error) {
+	if pp == nil || pp.Ranks == "" {
+		return nil, nil
+	}
+
+	return ranklist.CreateRankSet(pp.Ranks)
+}
+
+// CheckRanks returns an error if a pool using the profile would be created
+// on ranks which the profile does not allow. A rank count may not be used
+// with a profile which restricts ranks, as the ranks would then be chosen
+// without regard for the restriction.
+func (pp *PoolProfile) CheckRanks(numRanks uint32, ranks []ranklist.Rank) error {
+	allowed, err := pp.AllowedRanks()
+	if err != nil {
+		return errors.Wrapf(err, "pool profile %q", pp.Name)
+	}
+	if allowed == nil {
+		return nil
+	}
+
+	if numRanks > 0 && len(ranks) == 0 {
+		return errors.Errorf("a rank count may not be used with pool profile %q which "+
+			"restricts ranks to %s", pp.Name, allowed)
+	}
+	for _, rank := range ranks {
+		if !rank.InList(allowed.Ranks()) {
+			return errors.Errorf("rank %d is not allowed by pool profile %q (ranks %s)",
+				rank, pp.Name, allowed)
+		}
+	}
+
+	return nil
+}
+
+// Validate checks that the profile is well-formed. Pool properties are
+// checked against the set of known properties but ACL entries are only
+// checked for content, as their format is validated by the client.
+func (pp *PoolProfile) Validate() error {
+	if pp == nil {
+		return errors.New("nil pool profile")
+	}
+
+	if pp.Name == "" {
+		return errors.New("pool profile name must not be empty")
+	}
+	if !daos.LabelIsValid(pp.Name) {
+		return errors.Errorf("invalid pool profile name %q", pp.Name)
+	}
+
+	propHdlrs := daos.PoolProperties()
+	for _, name := range pp.PropertyNames() {
+		if name == "label" {
+			return errors.New("pool profile may not set the label property")
+		}
+		prop, err := propHdlrs.GetProperty(name)
+		if err != nil {
+			return err
+		}
+		if err := prop.SetValue(pp.Properties[name]); err != nil {
+			return errors.Wrapf(err, "pool profile property %q", name)
+		}
+	}
+
+	for _, ace := range pp.ACL {
+		if strings.TrimSpace(ace) == "" {
+			return errors.New("pool profile ACL entries must not be empty")
+		}
+	}
+
+	if pp.TotalBytes > 0 && pp.SizeRatio > 0 {
+		return errors.New("pool profile may not set both a total size and a size ratio")
+	}
+	if pp.SizeRatio > 100 {
+		return errors.Errorf("invalid pool profile size ratio %d%%: allowed range 0 < ratio <= 100",
+			pp.SizeRatio)
+	}
+
+	if len(pp.TierRatio) > 0 {
+		if pp.SizeRatio > 0 {
+			return errors.New("pool profile may not set both a size ratio and a tier ratio")
+		}
+		if len(pp.TierRatio) != 2 {
+			return errors.Errorf("pool profile tier ratio must have 2 values (got %d)",
+				len(pp.TierRatio))
+		}
+		var total float64
+		for _, ratio := range pp.TierRatio {
+			if ratio < 0 || ratio > 1 {
+				return errors.Errorf("pool profile tier ratio values must be between 0-100%% (got %.2f%%)",
+					ratio*100)
+			}
+			total += ratio
+		}
+		if math.Abs(total-1) > 0.01 {
+			return errors.Errorf("pool profile tier ratios must add up to 100%% (got %.2f%%)", total*100)
+		}
+	}
+
+	if pp.Ranks != "" {
+		if pp.NumRanks > 0 {
+			return errors.New("pool profile may not set both a rank count and a rank list")
+		}
+		rs, err := pp.AllowedRanks()
+		if err != nil {
+			return errors.Wrap(err, "invalid pool profile rank list")
+		}
+		if rs.Count() == 0 {
+			return errors.New("pool profile rank list must not be empty")
+		}
+	}
+
+	return nil
+}
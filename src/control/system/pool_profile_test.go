@@ -0,0 +1,195 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"testing"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+)
+
+func TestSystem_PoolProfile_Validate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		profile *PoolProfile
+		expErr  error
+	}{
+		"nil profile": {
+			expErr: errors.New("nil pool profile"),
+		},
+		"empty name": {
+			profile: &PoolProfile{},
+			expErr:  errors.New("name must not be empty"),
+		},
+		"invalid name": {
+			profile: &PoolProfile{Name: "bad name!"},
+			expErr:  errors.New("invalid pool profile name"),
+		},
+		"label property": {
+			profile: &PoolProfile{
+				Name:       "scratch",
+				Properties: map[string]string{"label": "foo"},
+			},
+			expErr: errors.New("label property"),
+		},
+		"unknown property": {
+			profile: &PoolProfile{
+				Name:       "scratch",
+				Properties: map[string]string{"whizbang": "on"},
+			},
+			expErr: errors.New("unknown property"),
+		},
+		"invalid property value": {
+			profile: &PoolProfile{
+				Name:       "scratch",
+				Properties: map[string]string{"reclaim": "sometimes"},
+			},
+			expErr: errors.New("reclaim"),
+		},
+		"empty ACE": {
+			profile: &PoolProfile{
+				Name: "scratch",
+				ACL:  []string{"A::OWNER@:rw", " "},
+			},
+			expErr: errors.New("must not be empty"),
+		},
+		"size and ratio": {
+			profile: &PoolProfile{
+				Name:       "scratch",
+				TotalBytes: 1 << 40,
+				SizeRatio:  50,
+			},
+			expErr: errors.New("both a total size and a size ratio"),
+		},
+		"ratio too large": {
+			profile: &PoolProfile{
+				Name:      "scratch",
+				SizeRatio: 101,
+			},
+			expErr: errors.New("invalid pool profile size ratio"),
+		},
+		"size ratio and tier ratio": {
+			profile: &PoolProfile{
+				Name:      "scratch",
+				SizeRatio: 50,
+				TierRatio: []float64{0.1, 0.9},
+			},
+			expErr: errors.New("both a size ratio and a tier ratio"),
+		},
+		"bad tier ratio count": {
+			profile: &PoolProfile{
+				Name:      "scratch",
+				TierRatio: []float64{1},
+			},
+			expErr: errors.New("must have 2 values"),
+		},
+		"tier ratio out of range": {
+			profile: &PoolProfile{
+				Name:      "scratch",
+				TierRatio: []float64{-0.5, 1.5},
+			},
+			expErr: errors.New("must be between 0-100% (got -50.00%)"),
+		},
+		"bad tier ratio total": {
+			profile: &PoolProfile{
+				Name:      "scratch",
+				TierRatio: []float64{0.5, 0.6},
+			},
+			expErr: errors.New("must add up to 100"),
+		},
+		"ranks and rank count": {
+			profile: &PoolProfile{
+				Name:     "scratch",
+				NumRanks: 2,
+				Ranks:    "0-3",
+			},
+			expErr: errors.New("both a rank count and a rank list"),
+		},
+		"invalid ranks": {
+			profile: &PoolProfile{
+				Name:  "scratch",
+				Ranks: "0-a",
+			},
+			expErr: errors.New("invalid pool profile rank list"),
+		},
+		"valid": {
+			profile: &PoolProfile{
+				Name:        "scratch",
+				Description: "short-lived scratch pools",
+				Properties: map[string]string{
+					"reclaim": "lazy",
+					"rd_fac":  "1",
+				},
+				ACL:        []string{"A::OWNER@:rw", "A:G:GROUP@:r"},
+				TotalBytes: 1 << 40,
+				TierRatio:  []float64{0.1, 0.9},
+				NumSvcReps: 3,
+				Ranks:      "0-3,8",
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.profile.Validate())
+		})
+	}
+}
+
+func TestSystem_PoolProfile_AllowedRanks(t *testing.T) {
+	pp := &PoolProfile{Name: "scratch"}
+	rs, err := pp.AllowedRanks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rs != nil {
+		t.Fatalf("expected nil rank set, got %s", rs)
+	}
+
+	pp.Ranks = "0,1,2,8"
+	rs, err = pp.AllowedRanks()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, "0-2,8", rs.String(), "unexpected rank set")
+}
+
+func TestSystem_PoolProfile_CheckRanks(t *testing.T) {
+	for name, tc := range map[string]struct {
+		profile  *PoolProfile
+		numRanks uint32
+		ranks    []ranklist.Rank
+		expErr   error
+	}{
+		"unrestricted": {
+			profile:  &PoolProfile{Name: "scratch"},
+			numRanks: 4,
+		},
+		"allowed ranks": {
+			profile: &PoolProfile{Name: "scratch", Ranks: "0-3"},
+			ranks:   []ranklist.Rank{1, 3},
+		},
+		"rank count": {
+			profile:  &PoolProfile{Name: "scratch", Ranks: "0-3"},
+			numRanks: 2,
+			expErr:   errors.New("rank count may not be used"),
+		},
+		"rank not allowed": {
+			profile: &PoolProfile{Name: "scratch", Ranks: "0-3"},
+			ranks:   []ranklist.Rank{1, 5},
+			expErr:  errors.New("rank 5 is not allowed by pool profile"),
+		},
+		"invalid ranks": {
+			profile: &PoolProfile{Name: "scratch", Ranks: "0-a"},
+			expErr:  errors.New("pool profile \"scratch\""),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.profile.CheckRanks(tc.numRanks, tc.ranks))
+		})
+	}
+}
@@ -0,0 +1,188 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// MaxPoolTags is the maximum number of tags that may be set on a pool.
+	MaxPoolTags = 64
+	// MaxPoolTagKeyLen is the maximum length of a pool tag key.
+	MaxPoolTagKeyLen = 64
+	// MaxPoolTagValueLen is the maximum length of a pool tag value.
+	MaxPoolTagValueLen = 256
+)
+
+// PoolTags is a set of operator-defined key/value pairs recorded against a
+// pool service in the system database. Tags are not interpreted by DAOS and
+// are intended to record information such as the owning project or cost
+// center.
+type PoolTags map[string]string
+
+// ValidatePoolTagKey checks that the supplied string is a valid pool tag key.
+// Keys may contain only letters, digits, '.', '_' and '-'.
+func ValidatePoolTagKey(key string) error {
+	if key == "" {
+		return errors.New("pool tag key must not be empty")
+	}
+	if len(key) > MaxPoolTagKeyLen {
+		return errors.Errorf("pool tag key %q exceeds maximum length of %d", key, MaxPoolTagKeyLen)
+	}
+	for _, c := range key {
+		if c > unicode.MaxASCII || !(unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune("._-", c)) {
+			return errors.Errorf("invalid character %q in pool tag key %q", c, key)
+		}
+	}
+
+	return nil
+}
+
+func validatePoolTagValue(key, value string) error {
+	if value == "" {
+		return errors.Errorf("pool tag %q value must not be empty", key)
+	}
+	if len(value) > MaxPoolTagValueLen {
+		return errors.Errorf("pool tag %q value exceeds maximum length of %d", key, MaxPoolTagValueLen)
+	}
+	for _, c := range value {
+		if !unicode.IsPrint(c) {
+			return errors.Errorf("invalid character %q in pool tag %q value", c, key)
+		}
+	}
+
+	return nil
+}
+
+// ParsePoolTags converts a list of "key=value" strings into a set of pool
+// tags. Each key may only be specified once.
+func ParsePoolTags(in []string) (PoolTags, error) {
+	if len(in) == 0 {
+		return nil, nil
+	}
+
+	tags := make(PoolTags, len(in))
+	for _, kv := range in {
+		idx := strings.Index(kv, "=")
+		if idx < 0 {
+			return nil, errors.Errorf("invalid pool tag %q (expected key=value)", kv)
+		}
+		key, value := strings.TrimSpace(kv[:idx]), strings.TrimSpace(kv[idx+1:])
+		if _, found := tags[key]; found {
+			return nil, errors.Errorf("duplicate pool tag %q", key)
+		}
+		tags[key] = value
+	}
+
+	if err := tags.Validate(); err != nil {
+		return nil, err
+	}
+
+	return tags, nil
+}
+
+// Validate checks that all of the tag keys and values are well-formed.
+func (pt PoolTags) Validate() error {
+	if len(pt) > MaxPoolTags {
+		return errors.Errorf("number of pool tags (%d) exceeds maximum of %d", len(pt), MaxPoolTags)
+	}
+
+	for _, key := range pt.Keys() {
+		if err := ValidatePoolTagKey(key); err != nil {
+			return err
+		}
+		if err := validatePoolTagValue(key, pt[key]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Keys returns the tag keys in sorted order.
+func (pt PoolTags) Keys() []string {
+	keys := make([]string, 0, len(pt))
+	for key := range pt {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return keys
+}
+
+// Strings returns the tags as a sorted list of "key=value" strings.
+func (pt PoolTags) Strings() []string {
+	if len(pt) == 0 {
+		return nil
+	}
+
+	out := make([]string, 0, len(pt))
+	for _, key := range pt.Keys() {
+		out = append(out, key+"="+pt[key])
+	}
+
+	return out
+}
+
+func (pt PoolTags) String() string {
+	return strings.Join(pt.Strings(), ",")
+}
+
+// Matches returns true if all of the tags in the filter are set to the
+// same values in the set of tags.
+func (pt PoolTags) Matches(filter PoolTags) bool {
+	for key, value := range filter {
+		if got, found := pt[key]; !found || got != value {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Copy returns a copy of the set of tags.
+func (pt PoolTags) Copy() PoolTags {
+	if pt == nil {
+		return nil
+	}
+
+	out := make(PoolTags, len(pt))
+	for key, value := range pt {
+		out[key] = value
+	}
+
+	return out
+}
+
+// UnmarshalJSON decodes a set of tags from either a JSON object or a list of
+// "key=value" strings, to handle the conversion from protobuf message using
+// convert.Types().
+func (pt *PoolTags) UnmarshalJSON(data []byte) error {
+	var list []string
+	if err := json.Unmarshal(data, &list); err == nil {
+		tags, err := ParsePoolTags(list)
+		if err != nil {
+			return err
+		}
+		*pt = tags
+		return nil
+	}
+
+	var tags map[string]string
+	if err := json.Unmarshal(data, &tags); err != nil {
+		return errors.Wrap(err, "invalid pool tags")
+	}
+	*pt = tags
+
+	return nil
+}
@@ -0,0 +1,199 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package system
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+)
+
+func TestSystem_ParsePoolTags(t *testing.T) {
+	for name, tc := range map[string]struct {
+		in      []string
+		expTags PoolTags
+		expErr  error
+	}{
+		"nil": {},
+		"valid": {
+			in: []string{"project=climate", "cost-center = 1234", "note=a=b c"},
+			expTags: PoolTags{
+				"project":     "climate",
+				"cost-center": "1234",
+				"note":        "a=b c",
+			},
+		},
+		"missing separator": {
+			in:     []string{"project"},
+			expErr: errors.New("expected key=value"),
+		},
+		"duplicate key": {
+			in:     []string{"project=a", "project=b"},
+			expErr: errors.New("duplicate pool tag"),
+		},
+		"empty key": {
+			in:     []string{"=climate"},
+			expErr: errors.New("key must not be empty"),
+		},
+		"invalid key": {
+			in:     []string{"my project=climate"},
+			expErr: errors.New("invalid character ' '"),
+		},
+		"non-ascii key": {
+			in:     []string{"projét=climate"},
+			expErr: errors.New("invalid character"),
+		},
+		"key too long": {
+			in:     []string{strings.Repeat("k", MaxPoolTagKeyLen+1) + "=v"},
+			expErr: errors.New("exceeds maximum length"),
+		},
+		"empty value": {
+			in:     []string{"project="},
+			expErr: errors.New("value must not be empty"),
+		},
+		"value too long": {
+			in:     []string{"project=" + strings.Repeat("v", MaxPoolTagValueLen+1)},
+			expErr: errors.New("exceeds maximum length"),
+		},
+		"unprintable value": {
+			in:     []string{"project=a\tb"},
+			expErr: errors.New("invalid character"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			gotTags, gotErr := ParsePoolTags(tc.in)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expTags, gotTags); diff != "" {
+				t.Fatalf("unexpected tags (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_PoolTags_Validate(t *testing.T) {
+	tooMany := make(PoolTags)
+	for i := 0; i <= MaxPoolTags; i++ {
+		tooMany[strings.Repeat("k", i+1)] = "v"
+	}
+
+	for name, tc := range map[string]struct {
+		tags   PoolTags
+		expErr error
+	}{
+		"nil": {},
+		"valid": {
+			tags: PoolTags{"project": "climate"},
+		},
+		"too many": {
+			tags:   tooMany,
+			expErr: errors.New("exceeds maximum"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.CmpErr(t, tc.expErr, tc.tags.Validate())
+		})
+	}
+}
+
+func TestSystem_PoolTags_Matches(t *testing.T) {
+	tags := PoolTags{"project": "climate", "tier": "gold"}
+
+	for name, tc := range map[string]struct {
+		tags      PoolTags
+		filter    PoolTags
+		expResult bool
+	}{
+		"empty filter": {
+			tags:      tags,
+			expResult: true,
+		},
+		"no tags": {
+			filter: PoolTags{"project": "climate"},
+		},
+		"single match": {
+			tags:      tags,
+			filter:    PoolTags{"project": "climate"},
+			expResult: true,
+		},
+		"all match": {
+			tags:      tags,
+			filter:    PoolTags{"project": "climate", "tier": "gold"},
+			expResult: true,
+		},
+		"value mismatch": {
+			tags:   tags,
+			filter: PoolTags{"project": "fusion"},
+		},
+		"partial match": {
+			tags:   tags,
+			filter: PoolTags{"project": "climate", "owner": "bob"},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			test.AssertEqual(t, tc.expResult, tc.tags.Matches(tc.filter), "unexpected result")
+		})
+	}
+}
+
+func TestSystem_PoolTags_JSON(t *testing.T) {
+	tags := PoolTags{"project": "climate", "tier": "gold"}
+
+	for name, tc := range map[string]struct {
+		in      string
+		expTags PoolTags
+		expErr  error
+	}{
+		"null": {
+			in: "null",
+		},
+		"object": {
+			in:      `{"project":"climate","tier":"gold"}`,
+			expTags: tags,
+		},
+		"list": {
+			in:      `["project=climate","tier=gold"]`,
+			expTags: tags,
+		},
+		"invalid list entry": {
+			in:     `["project"]`,
+			expErr: errors.New("expected key=value"),
+		},
+		"invalid type": {
+			in:     `42`,
+			expErr: errors.New("invalid pool tags"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var gotTags PoolTags
+			gotErr := json.Unmarshal([]byte(tc.in), &gotTags)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expTags, gotTags); diff != "" {
+				t.Fatalf("unexpected tags (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+
+	data, err := json.Marshal(tags)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, `{"project":"climate","tier":"gold"}`, string(data), "unexpected JSON")
+	test.AssertEqual(t, "project=climate,tier=gold", tags.String(), "unexpected string")
+}
@@ -29,8 +29,9 @@ import (
 )
 
 const (
-	// CurrentSchemaVersion indicates the current db schema version.
-	CurrentSchemaVersion = 0
+	// CurrentSchemaVersion indicates the current db schema version. It is
+	// the version reached by applying all of the registered migrations.
+	CurrentSchemaVersion = uint(len(dbMigrationSteps))
 )
 
 var (
@@ -50,9 +51,12 @@ type (
 		Leader() raft.ServerAddress
 		LeaderCh() <-chan bool
 		LeadershipTransfer() raft.Future
+		LeadershipTransferToServer(raft.ServerID, raft.ServerAddress) raft.Future
 		Barrier(time.Duration) raft.Future
+		Snapshot() raft.SnapshotFuture
 		Shutdown() raft.Future
 		State() raft.RaftState
+		Stats() map[string]string
 	}
 
 	// syncRaft provides a wrapper for synchronized access to the
@@ -68,7 +72,8 @@ type (
 	// all participating replicas.
 	dbData struct {
 		sync.RWMutex
-		log logging.Logger
+		log        logging.Logger
+		migrations schemaMigrations
 
 		Version       uint64
 		NextRank      ranklist.Rank
@@ -77,6 +82,9 @@ type (
 		Pools         *PoolDatabase
 		System        *SystemDatabase
 		SchemaVersion uint
+		Replicas      []string
+		Audit         *AuditDatabase
+		PoolProfiles  PoolProfileMap
 	}
 
 	// Database provides high-level access methods for the
@@ -87,6 +95,7 @@ type (
 		log                logging.Logger
 		cfg                *DatabaseConfig
 		initialized        atm.Bool
+		replicaLock        sync.RWMutex // protects replicaAddr and cfg.Replicas
 		replicaAddr        *net.TCPAddr
 		raftTransport      raft.Transport
 		raft               syncRaft
@@ -97,6 +106,7 @@ type (
 		shutdownCb         context.CancelFunc
 		shutdownErrCh      chan error
 		poolLocks          poolLockMap
+		metrics            *dbMetrics
 
 		data *dbData // raft-backed system data
 	}
@@ -244,9 +254,11 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 		replicaAddr:        repAddr,
 		shutdownErrCh:      make(chan error),
 		raftLeaderNotifyCh: make(chan bool),
+		metrics:            newDBMetrics(),
 
 		data: &dbData{
-			log: log,
+			log:        log,
+			migrations: dbMigrations,
 
 			Members: &MemberDatabase{
 				Ranks:        make(MemberRankMap),
@@ -262,11 +274,14 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 			System: &SystemDatabase{
 				Attributes: make(map[string]string),
 			},
+			Audit:         newAuditDatabase(),
+			PoolProfiles:  make(PoolProfileMap),
 			SchemaVersion: CurrentSchemaVersion,
 		},
 	}
 	// NB: We may remove this once the locking stuff is solid.
 	db.poolLocks.log = log
+	db.poolLocks.metrics = db.metrics
 
 	return db, nil
 }
@@ -274,6 +289,9 @@ func NewDatabase(log logging.Logger, cfg *DatabaseConfig) (*Database, error) {
 // isReplica returns true if the supplied address matches
 // a known replica address.
 func (db *Database) isReplica(ctrlAddr *net.TCPAddr) bool {
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
 	for _, candidate := range db.cfg.Replicas {
 		if common.CmpTCPAddr(ctrlAddr, candidate) {
 			return true
@@ -288,22 +306,40 @@ func (db *Database) SystemName() string {
 	return db.cfg.SystemName
 }
 
+// stringReplicas returns the string representations of the current replica
+// addresses, excluding any supplied addresses.
+func (db *Database) stringReplicas(excludeAddrs ...*net.TCPAddr) []string {
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
+	return db.cfg.stringReplicas(excludeAddrs...)
+}
+
+// getReplicaAddr returns the local replica address, or nil if the
+// system is not configured as a replica.
+func (db *Database) getReplicaAddr() *net.TCPAddr {
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
+	return db.replicaAddr
+}
+
 // LeaderQuery returns the system leader, if known.
 func (db *Database) LeaderQuery() (leader string, replicas []string, err error) {
 	if !db.IsReplica() {
-		return "", nil, &system.ErrNotReplica{db.cfg.stringReplicas()}
+		return "", nil, &system.ErrNotReplica{db.stringReplicas()}
 	}
 
-	return db.leaderHint(), db.cfg.stringReplicas(), nil
+	return db.leaderHint(), db.stringReplicas(), nil
 }
 
 // ReplicaAddr returns the system's replica address if
 // the system is configured as a MS replica.
 func (db *Database) ReplicaAddr() (*net.TCPAddr, error) {
 	if !db.IsReplica() {
-		return nil, &system.ErrNotReplica{db.cfg.stringReplicas()}
+		return nil, &system.ErrNotReplica{db.stringReplicas()}
 	}
-	return db.replicaAddr, nil
+	return db.getReplicaAddr(), nil
 }
 
 // PeerAddrs returns the addresses of this system's replication peers.
@@ -313,6 +349,9 @@ func (db *Database) PeerAddrs() ([]*net.TCPAddr, error) {
 		return nil, err
 	}
 
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
 	var peers []*net.TCPAddr
 	for _, rep := range db.cfg.Replicas {
 		if !common.CmpTCPAddr(myAddr, rep) {
@@ -324,7 +363,7 @@ func (db *Database) PeerAddrs() ([]*net.TCPAddr, error) {
 
 // IsReplica returns true if the system is configured as a replica.
 func (db *Database) IsReplica() bool {
-	return db != nil && db.replicaAddr != nil
+	return db != nil && db.getReplicaAddr() != nil
 }
 
 // IsBootstrap returns true if the system is a replica and meets the
@@ -334,6 +373,9 @@ func (db *Database) IsBootstrap() bool {
 	if !db.IsReplica() {
 		return false
 	}
+	db.replicaLock.RLock()
+	defer db.replicaLock.RUnlock()
+
 	// Only the first replica should bootstrap. All the others
 	// should be added as voters.
 	return common.CmpTCPAddr(db.cfg.Replicas[0], db.replicaAddr)
@@ -343,7 +385,7 @@ func (db *Database) IsBootstrap() bool {
 // replica or the service is not running.
 func (db *Database) CheckReplica() error {
 	if !db.IsReplica() {
-		return &system.ErrNotReplica{db.cfg.stringReplicas()}
+		return &system.ErrNotReplica{db.stringReplicas()}
 	}
 
 	if db.initialized.IsFalse() {
@@ -358,7 +400,7 @@ func (db *Database) CheckReplica() error {
 func errNotSysLeader(svc raftService, db *Database) error {
 	return &system.ErrNotLeader{
 		LeaderHint: string(svc.Leader()),
-		Replicas:   db.cfg.stringReplicas(db.replicaAddr),
+		Replicas:   db.stringReplicas(db.getReplicaAddr()),
 	}
 }
 
@@ -424,9 +466,10 @@ func (db *Database) OnRaftShutdown(fns ...onRaftShutdownFn) {
 // Start checks to see if the system is configured as a MS replica. If
 // not, it returns early without an error. If it is, the persistent storage
 // is initialized if necessary, and the replica is started to begin the
-// process of choosing a MS leader.
+// process of choosing a MS leader. It is a no-op if the replica has
+// already been started.
 func (db *Database) Start(parent context.Context) error {
-	if !db.IsReplica() {
+	if !db.IsReplica() || db.initialized.IsTrue() {
 		return nil
 	}
 
@@ -506,6 +549,7 @@ func (db *Database) monitorLeadershipState(parent context.Context) {
 		case isLeader := <-db.raftLeaderNotifyCh:
 			if !isLeader {
 				db.log.Debugf("node %s lost MS leader state", db.replicaAddr)
+				db.metrics.leadershipChanges.WithLabelValues("lost").Inc()
 				if cancelGainedCtx != nil {
 					cancelGainedCtx()
 				}
@@ -514,6 +558,7 @@ func (db *Database) monitorLeadershipState(parent context.Context) {
 			}
 
 			db.log.Debugf("node %s gained MS leader state", db.replicaAddr)
+			db.metrics.leadershipChanges.WithLabelValues("gained").Inc()
 			if err := db.Barrier(); err != nil {
 				db.log.Errorf("raft Barrier() failed: %s", err)
 				if err = db.ResignLeadership(err); err != nil {
@@ -522,6 +567,14 @@ func (db *Database) monitorLeadershipState(parent context.Context) {
 				continue // restart the monitoring loop
 			}
 
+			if err := db.migrateSchema(); err != nil {
+				db.log.Errorf("system database schema migration failed: %s", err)
+				if err = db.ResignLeadership(err); err != nil {
+					db.log.Errorf("raft ResignLeadership() failed: %s", err)
+				}
+				continue // restart the monitoring loop
+			}
+
 			var gainedCtx context.Context
 			gainedCtx, cancelGainedCtx = context.WithCancel(parent)
 			for _, fn := range db.onLeadershipGained {
@@ -595,6 +648,7 @@ func (db *Database) GroupMap() (*GroupMap, error) {
 func copyMember(in *system.Member) *system.Member {
 	out := new(system.Member)
 	*out = *in
+	out.Maintenance = in.Maintenance.Copy()
 	return out
 }
 
@@ -709,7 +763,7 @@ func (db *Database) RemoveMember(m *system.Member) error {
 
 func (db *Database) manageVoter(vc *system.Member, op raftOp) error {
 	// Ignore self as a voter candidate.
-	if common.CmpTCPAddr(db.replicaAddr, vc.Addr) {
+	if common.CmpTCPAddr(db.getReplicaAddr(), vc.Addr) {
 		return nil
 	}
 
@@ -860,6 +914,13 @@ func (db *Database) FaultDomainTree() *system.FaultDomainTree {
 func copyPoolService(in *system.PoolService) *system.PoolService {
 	out := new(system.PoolService)
 	*out = *in
+	if in.MgmtProperties != nil {
+		out.MgmtProperties = make(map[uint32]uint64, len(in.MgmtProperties))
+		for k, v := range in.MgmtProperties {
+			out.MgmtProperties[k] = v
+		}
+	}
+	out.Tags = in.Tags.Copy()
 	return out
 }
 
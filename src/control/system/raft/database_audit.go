@@ -0,0 +1,123 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// maxAuditRecords is the number of audit record summaries retained in the
+// system database. Older records are discarded as new ones are added; the
+// complete history is kept in the audit log of the server that handled
+// each request.
+const maxAuditRecords = 1024
+
+// AuditDatabase contains summaries of the most recent mutating management
+// service requests.
+type AuditDatabase struct {
+	NextSequence uint64
+	Records      []*system.AuditRecord
+}
+
+func newAuditDatabase() *AuditDatabase {
+	return &AuditDatabase{
+		NextSequence: 1,
+	}
+}
+
+// add appends the supplied record, assigning it the next sequence number
+// and discarding the oldest records if the retention limit is exceeded.
+func (adb *AuditDatabase) add(rec *system.AuditRecord) {
+	rec.Sequence = adb.NextSequence
+	adb.NextSequence++
+
+	adb.Records = append(adb.Records, rec)
+	if excess := len(adb.Records) - maxAuditRecords; excess > 0 {
+		adb.Records = append([]*system.AuditRecord{}, adb.Records[excess:]...)
+	}
+}
+
+// AddAuditRecords adds summaries of mutating requests to the system database.
+// The summaries are added in a single update, so callers should batch them in
+// order to avoid a raft update for each request.
+func (db *Database) AddAuditRecords(recs []*system.AuditRecord) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	if len(recs) == 0 {
+		return errors.New("no audit records")
+	}
+	for _, rec := range recs {
+		if rec == nil {
+			return errors.New("nil audit record")
+		}
+	}
+
+	data, err := createRaftUpdate(raftOpAddAuditRecords, recs)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// AuditRecords returns the audit record summaries matching the supplied
+// filter, oldest first. If limit is greater than zero, only the most recent
+// limit matching records are returned.
+func (db *Database) AuditRecords(filter *system.AuditFilter, limit int) ([]*system.AuditRecord, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	out := []*system.AuditRecord{}
+	if db.data.Audit == nil {
+		return out, nil
+	}
+	for _, rec := range db.data.Audit.Records {
+		if filter.Matches(rec) {
+			cpy := *rec
+			out = append(out, &cpy)
+		}
+	}
+	if limit > 0 && len(out) > limit {
+		out = out[len(out)-limit:]
+	}
+
+	return out, nil
+}
+
+// applyAuditUpdate is responsible for applying the audit record update
+// operation to the database.
+func (d *dbData) applyAuditUpdate(op raftOp, data []byte, panicFn func(error)) {
+	var recs []*system.AuditRecord
+	if err := json.Unmarshal(data, &recs); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode audit record update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpAddAuditRecords:
+		if d.Audit == nil {
+			d.Audit = newAuditDatabase()
+		}
+		for _, rec := range recs {
+			d.Audit.add(rec)
+		}
+	default:
+		panicFn(errors.Errorf("unhandled Audit Apply operation: %d", op))
+		return
+	}
+}
@@ -0,0 +1,208 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockAuditRecord(idx int, status string) *system.AuditRecord {
+	return &system.AuditRecord{
+		Timestamp: time.Unix(int64(idx), 0).UTC(),
+		Host:      "host1",
+		Method:    fmt.Sprintf("/mgmt.MgmtSvc/Method%d", idx%2),
+		Caller:    "admin",
+		Peer:      "127.0.0.1:12345",
+		Status:    status,
+	}
+}
+
+func TestSystem_Database_AuditRecords(t *testing.T) {
+	for name, tc := range map[string]struct {
+		notLeader bool
+		numRecs   int
+		filter    *system.AuditFilter
+		limit     int
+		expSeqs   []uint64
+		expErr    error
+	}{
+		"not leader": {
+			notLeader: true,
+			expErr:    errors.New("leader"),
+		},
+		"no records": {
+			expSeqs: []uint64{},
+		},
+		"all records": {
+			numRecs: 4,
+			expSeqs: []uint64{1, 2, 3, 4},
+		},
+		"filtered": {
+			numRecs: 4,
+			filter:  &system.AuditFilter{Method: "Method1"},
+			expSeqs: []uint64{2, 4},
+		},
+		"limited": {
+			numRecs: 4,
+			limit:   3,
+			expSeqs: []uint64{2, 3, 4},
+		},
+		"filtered and limited": {
+			numRecs: 6,
+			filter:  &system.AuditFilter{Method: "Method0"},
+			limit:   2,
+			expSeqs: []uint64{3, 5},
+		},
+		"retention limit": {
+			numRecs: maxAuditRecords + 2,
+			limit:   2,
+			expSeqs: []uint64{maxAuditRecords + 1, maxAuditRecords + 2},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			var batch []*system.AuditRecord
+			for i := 0; i < tc.numRecs; i++ {
+				batch = append(batch, mockAuditRecord(i, system.AuditStatusOK))
+			}
+			if len(batch) > 0 {
+				if err := db.AddAuditRecords(batch); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.numRecs > maxAuditRecords {
+				test.AssertEqual(t, maxAuditRecords, len(db.data.Audit.Records), "retention limit not applied")
+			}
+			if tc.notLeader {
+				db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+					State: raft.Follower,
+				}, (*fsm)(db)))
+			}
+
+			recs, gotErr := db.AuditRecords(tc.filter, tc.limit)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotSeqs := []uint64{}
+			for _, rec := range recs {
+				gotSeqs = append(gotSeqs, rec.Sequence)
+			}
+			if diff := cmp.Diff(tc.expSeqs, gotSeqs); diff != "" {
+				t.Fatalf("unexpected records (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_AddAuditRecords(t *testing.T) {
+	for name, tc := range map[string]struct {
+		notLeader bool
+		recs      []*system.AuditRecord
+		expSeqs   []uint64
+		expErr    error
+	}{
+		"not leader": {
+			notLeader: true,
+			recs:      []*system.AuditRecord{mockAuditRecord(0, system.AuditStatusOK)},
+			expErr:    errors.New("leader"),
+		},
+		"no records": {
+			expErr: errors.New("no audit records"),
+		},
+		"nil record": {
+			recs:   []*system.AuditRecord{mockAuditRecord(0, system.AuditStatusOK), nil},
+			expErr: errors.New("nil audit record"),
+		},
+		"batch": {
+			recs: []*system.AuditRecord{
+				mockAuditRecord(0, system.AuditStatusOK),
+				mockAuditRecord(1, system.AuditStatusOK),
+				mockAuditRecord(2, system.AuditStatusOK),
+			},
+			expSeqs: []uint64{1, 2, 3},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			if tc.notLeader {
+				db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+					State: raft.Follower,
+				}, (*fsm)(db)))
+			}
+
+			gotErr := db.AddAuditRecords(tc.recs)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotSeqs := []uint64{}
+			for _, rec := range db.data.Audit.Records {
+				gotSeqs = append(gotSeqs, rec.Sequence)
+			}
+			if diff := cmp.Diff(tc.expSeqs, gotSeqs); diff != "" {
+				t.Fatalf("unexpected records (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_AuditRecords_Snapshot(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	for i := 0; i < 3; i++ {
+		if err := db.AddAuditRecords([]*system.AuditRecord{mockAuditRecord(i, system.AuditStatusOK)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	snap, err := (*fsm)(db).Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored := MockDatabase(t, log)
+	rc := ioutil.NopCloser(bytes.NewReader(snap.(*fsmSnapshot).data))
+	if err := (*fsm)(restored).Restore(rc); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(db.data.Audit, restored.data.Audit); diff != "" {
+		t.Fatalf("unexpected audit records after restore (-want, +got):\n%s\n", diff)
+	}
+
+	// Sequence numbers continue from the restored state.
+	if err := restored.AddAuditRecords([]*system.AuditRecord{mockAuditRecord(3, system.AuditStatusOK)}); err != nil {
+		t.Fatal(err)
+	}
+	recs, err := restored.AuditRecords(nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint64(4), recs[0].Sequence, "unexpected sequence")
+}
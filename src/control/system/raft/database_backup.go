@@ -0,0 +1,349 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+)
+
+const (
+	backupPrefix   = "backup-"
+	backupTimeFmt  = "20060102T150405Z"
+	backupSumsFile = "SHA256SUMS"
+)
+
+// BackupDetails describes a backup of the system database.
+type BackupDetails struct {
+	Name     string    `json:"name"`
+	Path     string    `json:"path"`
+	Time     time.Time `json:"time"`
+	Index    uint64    `json:"index"`
+	Term     uint64    `json:"term"`
+	Size     int64     `json:"size"`
+	Checksum string    `json:"checksum"`
+	Error    string    `json:"error,omitempty"`
+}
+
+// Valid returns true if the backup passed validation.
+func (bd *BackupDetails) Valid() bool {
+	return bd != nil && bd.Error == ""
+}
+
+// openSnapshot returns a consistent snapshot of the current database state.
+// If there have been no updates since the last snapshot was taken, the latest
+// snapshot in the local snapshot store is used.
+func (db *Database) openSnapshot() (*raft.SnapshotMeta, io.ReadCloser, error) {
+	svc, unlock, err := db.raft.getSvc()
+	if err != nil {
+		return nil, nil, err
+	}
+	defer unlock()
+
+	f := svc.Snapshot()
+	if err := f.Error(); err != nil {
+		if err != raft.ErrNothingNewToSnapshot {
+			return nil, nil, errors.Wrap(err, "failed to create snapshot")
+		}
+
+		sInfo, err := GetLatestSnapshot(db.log, db.cfg)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "failed to find latest snapshot")
+		}
+		data, err := readSnapshotData(sInfo.Path)
+		if err != nil {
+			return nil, nil, err
+		}
+		return sInfo.Metadata, ioutil.NopCloser(bytes.NewReader(data)), nil
+	}
+
+	return f.Open()
+}
+
+// Backup writes a consistent backup of the system database to a new directory
+// under the supplied path. The backup is in raft snapshot format and may be
+// restored with RestoreLocalReplica.
+func (db *Database) Backup(dir string) (*BackupDetails, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+
+	meta, rc, err := db.openSnapshot()
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+
+	return writeBackup(dir, time.Now(), meta, rc)
+}
+
+func backupName(ts time.Time, meta *raft.SnapshotMeta) string {
+	return fmt.Sprintf("%s%s-%d-%d", backupPrefix, ts.UTC().Format(backupTimeFmt),
+		meta.Term, meta.Index)
+}
+
+func writeBackupFile(path string, r io.Reader) (string, int64, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "failed to create %q", path)
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	n, err := io.Copy(io.MultiWriter(f, h), r)
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "failed to write %q", path)
+	}
+	if err := f.Sync(); err != nil {
+		return "", 0, errors.Wrapf(err, "failed to sync %q", path)
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), n, nil
+}
+
+func writeBackup(dir string, ts time.Time, meta *raft.SnapshotMeta, r io.Reader) (*BackupDetails, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create backup directory %q", dir)
+	}
+
+	name := backupName(ts, meta)
+	path := filepath.Join(dir, name)
+	if _, err := os.Stat(path); err == nil {
+		return nil, errors.Errorf("backup %q already exists", path)
+	}
+
+	tmpPath := filepath.Join(dir, "."+name+".tmp")
+	if err := os.Mkdir(tmpPath, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create %q", tmpPath)
+	}
+	defer os.RemoveAll(tmpPath)
+
+	dataSum, size, err := writeBackupFile(filepath.Join(tmpPath, snapshotDataFile), r)
+	if err != nil {
+		return nil, err
+	}
+
+	bMeta := *meta
+	bMeta.Size = size
+	metaBuf, err := json.Marshal(&bMeta)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode snapshot metadata")
+	}
+	metaSum, _, err := writeBackupFile(filepath.Join(tmpPath, snapshotMetaFile), bytes.NewReader(metaBuf))
+	if err != nil {
+		return nil, err
+	}
+
+	var sums bytes.Buffer
+	fmt.Fprintf(&sums, "%s  %s\n", dataSum, snapshotDataFile)
+	fmt.Fprintf(&sums, "%s  %s\n", metaSum, snapshotMetaFile)
+	if _, _, err := writeBackupFile(filepath.Join(tmpPath, backupSumsFile), &sums); err != nil {
+		return nil, err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return nil, errors.Wrapf(err, "failed to rename %q to %q", tmpPath, path)
+	}
+
+	bd := readBackupDetails(path)
+	if !bd.Valid() {
+		return nil, errors.Errorf("backup %q failed validation: %s", path, bd.Error)
+	}
+
+	return bd, nil
+}
+
+// readChecksums reads the checksums file in the supplied directory. If there
+// is no checksums file, a nil map is returned.
+func readChecksums(path string) (map[string]string, error) {
+	f, err := os.Open(filepath.Join(path, backupSumsFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "failed to open checksums")
+	}
+	defer f.Close()
+
+	sums := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			return nil, errors.Errorf("malformed checksum line %q", scanner.Text())
+		}
+		sums[fields[1]] = fields[0]
+	}
+
+	return sums, errors.Wrap(scanner.Err(), "failed to read checksums")
+}
+
+func fileChecksum(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// verifySnapshotChecksums verifies the snapshot files in the supplied directory
+// against its checksums file, if present. Snapshots taken directly from the
+// raft snapshot store do not have a checksums file.
+func verifySnapshotChecksums(path string) error {
+	sums, err := readChecksums(path)
+	if err != nil || sums == nil {
+		return err
+	}
+
+	for _, name := range []string{snapshotMetaFile, snapshotDataFile} {
+		expSum, found := sums[name]
+		if !found {
+			return errors.Errorf("no checksum for %s", name)
+		}
+		gotSum, err := fileChecksum(filepath.Join(path, name))
+		if err != nil {
+			return errors.Wrapf(err, "failed to checksum %s", name)
+		}
+		if gotSum != expSum {
+			return errors.Errorf("checksum mismatch for %s", name)
+		}
+	}
+
+	return nil
+}
+
+// readBackupDetails reads and validates the backup at the supplied path. Any
+// validation failure is recorded in the returned details.
+func readBackupDetails(path string) *BackupDetails {
+	bd := &BackupDetails{
+		Name: filepath.Base(path),
+		Path: path,
+	}
+
+	// Name format: backup-<time>-<term>-<index>
+	tsStr := strings.SplitN(strings.TrimPrefix(bd.Name, backupPrefix), "-", 2)[0]
+	if ts, err := time.Parse(backupTimeFmt, tsStr); err == nil {
+		bd.Time = ts
+	} else if st, err := os.Stat(path); err == nil {
+		bd.Time = st.ModTime().UTC()
+	}
+
+	// A backup without checksums can't be verified, so it isn't valid even
+	// if the snapshot itself can be decoded.
+	sums, err := readChecksums(path)
+	switch {
+	case err != nil:
+		bd.Error = err.Error()
+		return bd
+	case sums == nil:
+		bd.Error = "missing " + backupSumsFile
+		return bd
+	}
+	if err := verifySnapshotChecksums(path); err != nil {
+		bd.Error = err.Error()
+		return bd
+	}
+	bd.Checksum = sums[snapshotDataFile]
+
+	sInfo, err := ReadSnapshotInfo(path)
+	if err != nil {
+		bd.Error = err.Error()
+		return bd
+	}
+	bd.Index = sInfo.Metadata.Index
+	bd.Term = sInfo.Metadata.Term
+	bd.Size = sInfo.Metadata.Size
+
+	return bd
+}
+
+// ListBackups returns details of the backups in the supplied directory, ordered
+// from oldest to newest. Backups which fail validation are included, with the
+// reason for the failure recorded in the details.
+func ListBackups(dir string) ([]*BackupDetails, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to read backup directory %q", dir)
+	}
+
+	var backups []*BackupDetails
+	for _, entry := range entries {
+		if !entry.IsDir() || !strings.HasPrefix(entry.Name(), backupPrefix) {
+			continue
+		}
+		backups = append(backups, readBackupDetails(filepath.Join(dir, entry.Name())))
+	}
+
+	sort.SliceStable(backups, func(i, j int) bool {
+		if backups[i].Time.Equal(backups[j].Time) {
+			return backups[i].Index < backups[j].Index
+		}
+		return backups[i].Time.Before(backups[j].Time)
+	})
+
+	return backups, nil
+}
+
+// PruneBackups removes the oldest backups in the supplied directory so that no
+// more than the specified number of valid backups remain. Only valid backups
+// count towards the retained number, so corrupt backups never displace good
+// ones; invalid backups older than the oldest retained valid backup are also
+// removed. The paths of removed backups are returned.
+func PruneBackups(dir string, retain int) ([]string, error) {
+	backups, err := ListBackups(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	// Walk from newest to oldest, everything older than the retained valid
+	// backups is removed.
+	nrValid := 0
+	cut := 0
+	for i := len(backups) - 1; i >= 0; i-- {
+		if nrValid == retain {
+			cut = i + 1
+			break
+		}
+		if backups[i].Valid() {
+			nrValid++
+		}
+	}
+
+	var removed []string
+	for _, bd := range backups[:cut] {
+		if err := os.RemoveAll(bd.Path); err != nil {
+			return removed, errors.Wrapf(err, "failed to remove backup %q", bd.Path)
+		}
+		removed = append(removed, bd.Path)
+	}
+
+	return removed, nil
+}
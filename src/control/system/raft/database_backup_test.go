@@ -0,0 +1,193 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/raft"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func TestSystem_Database_Backup(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+	backupDir := filepath.Join(testDir, "backups")
+
+	db := MockDatabase(t, log)
+	for i := 2; i < 5; i++ {
+		if err := db.AddMember(system.MockMember(t, uint32(i), system.MemberStateJoined)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	bd, err := db.Backup(backupDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, bd.Valid(), "expected valid backup")
+	test.AssertTrue(t, strings.HasPrefix(bd.Name, backupPrefix), "unexpected backup name "+bd.Name)
+	test.AssertEqual(t, filepath.Join(backupDir, bd.Name), bd.Path, "unexpected backup path")
+	test.AssertEqual(t, uint64(1), bd.Index, "unexpected backup index")
+	if bd.Checksum == "" {
+		t.Fatal("expected backup checksum")
+	}
+
+	// The backup should be restorable as a raft snapshot.
+	sInfo, err := ReadSnapshotInfo(bd.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff("[2-4]", sInfo.MemberRanks.RangedString()); diff != "" {
+		t.Fatalf("unexpected member ranks (-want, +got):\n%s\n", diff)
+	}
+
+	backups, err := ListBackups(backupDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := cmp.Diff([]*BackupDetails{bd}, backups); diff != "" {
+		t.Fatalf("unexpected backups (-want, +got):\n%s\n", diff)
+	}
+
+	// Corrupt the backup and verify that it is no longer considered valid.
+	dataPath := filepath.Join(bd.Path, snapshotDataFile)
+	if err := ioutil.WriteFile(dataPath, []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := ReadSnapshotInfo(bd.Path); err == nil {
+		t.Fatal("expected corrupted backup to fail validation")
+	}
+	backups, err = ListBackups(backupDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, 1, len(backups), "unexpected number of backups")
+	test.AssertFalse(t, backups[0].Valid(), "expected corrupted backup to be invalid")
+	if !strings.Contains(backups[0].Error, "checksum mismatch") {
+		t.Fatalf("unexpected validation error: %s", backups[0].Error)
+	}
+}
+
+func TestSystem_Database_Backup_NotLeader(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	db := MockDatabase(t, log)
+	db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+		State: raft.Follower,
+	}, (*fsm)(db)))
+
+	_, err := db.Backup(testDir)
+	if !system.IsNotLeader(err) {
+		t.Fatalf("expected not-leader error, got %v", err)
+	}
+}
+
+func TestSystem_PruneBackups(t *testing.T) {
+	for name, tc := range map[string]struct {
+		nrBackups    int
+		corrupt      []int
+		retain       int
+		expRemaining []int
+	}{
+		"fewer than retained": {
+			nrBackups:    2,
+			retain:       3,
+			expRemaining: []int{0, 1},
+		},
+		"all valid": {
+			nrBackups:    5,
+			retain:       3,
+			expRemaining: []int{2, 3, 4},
+		},
+		"invalid backups don't count towards retained": {
+			nrBackups:    5,
+			corrupt:      []int{0, 3},
+			retain:       3,
+			expRemaining: []int{1, 2, 3, 4},
+		},
+		"all invalid": {
+			nrBackups:    3,
+			corrupt:      []int{0, 1, 2},
+			retain:       1,
+			expRemaining: []int{0, 1, 2},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			testDir, cleanup := test.CreateTestDir(t)
+			defer cleanup()
+
+			db := MockDatabase(t, log)
+			meta, _, err := db.openSnapshot()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			start := time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+			var names []string
+			for i := 0; i < tc.nrBackups; i++ {
+				_, data, err := db.openSnapshot()
+				if err != nil {
+					t.Fatal(err)
+				}
+				bd, err := writeBackup(testDir, start.Add(time.Duration(i)*time.Hour), meta, data)
+				data.Close()
+				if err != nil {
+					t.Fatal(err)
+				}
+				names = append(names, bd.Name)
+			}
+			for _, i := range tc.corrupt {
+				dataPath := filepath.Join(testDir, names[i], snapshotDataFile)
+				if err := ioutil.WriteFile(dataPath, []byte("{}"), 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			removed, err := PruneBackups(testDir, tc.retain)
+			if err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, tc.nrBackups-len(tc.expRemaining), len(removed),
+				"unexpected number of removed backups")
+
+			var expRemaining []string
+			for _, i := range tc.expRemaining {
+				expRemaining = append(expRemaining, names[i])
+			}
+			backups, err := ListBackups(testDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var gotRemaining []string
+			for _, bd := range backups {
+				gotRemaining = append(gotRemaining, bd.Name)
+			}
+			if diff := cmp.Diff(expRemaining, gotRemaining); diff != "" {
+				t.Fatalf("unexpected remaining backups (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
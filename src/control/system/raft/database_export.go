@@ -0,0 +1,339 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/hashicorp/raft"
+	boltdb "github.com/hashicorp/raft-boltdb/v2"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// DatabaseExport is a human-readable representation of the system database,
+// suitable for inspection, comparison and repair. Unlike the internal format,
+// each member and pool service appears exactly once.
+type DatabaseExport struct {
+	SchemaVersion uint                  `json:"schema_version"`
+	Version       uint64                `json:"version"`
+	MapVersion    uint32                `json:"map_version"`
+	NextRank      ranklist.Rank         `json:"next_rank"`
+	Replicas      []string              `json:"replicas,omitempty"`
+	Members       []*system.Member      `json:"members"`
+	Pools         []*system.PoolService `json:"pools"`
+	SystemAttrs   map[string]string     `json:"system_attrs"`
+	PoolProfiles  []*system.PoolProfile `json:"pool_profiles,omitempty"`
+	AuditNextSeq  uint64                `json:"audit_next_sequence"`
+	AuditRecords  []*system.AuditRecord `json:"audit_records,omitempty"`
+}
+
+// newDatabaseExport creates an export document from the supplied data.
+func newDatabaseExport(d *dbData) *DatabaseExport {
+	d.RLock()
+	defer d.RUnlock()
+
+	de := &DatabaseExport{
+		SchemaVersion: d.SchemaVersion,
+		Version:       d.Version,
+		MapVersion:    d.MapVersion,
+		NextRank:      d.NextRank,
+		Replicas:      d.Replicas,
+		Members:       []*system.Member{},
+		Pools:         []*system.PoolService{},
+		SystemAttrs:   d.System.Attributes,
+	}
+
+	for _, m := range d.Members.Uuids {
+		de.Members = append(de.Members, m)
+	}
+	sort.Slice(de.Members, func(i, j int) bool {
+		return de.Members[i].Rank < de.Members[j].Rank
+	})
+
+	for _, ps := range d.Pools.Uuids {
+		de.Pools = append(de.Pools, ps)
+	}
+	sort.Slice(de.Pools, func(i, j int) bool {
+		if de.Pools[i].PoolLabel == de.Pools[j].PoolLabel {
+			return de.Pools[i].PoolUUID.String() < de.Pools[j].PoolUUID.String()
+		}
+		return de.Pools[i].PoolLabel < de.Pools[j].PoolLabel
+	})
+
+	for _, pp := range d.PoolProfiles {
+		de.PoolProfiles = append(de.PoolProfiles, pp)
+	}
+	sort.Slice(de.PoolProfiles, func(i, j int) bool {
+		return de.PoolProfiles[i].Name < de.PoolProfiles[j].Name
+	})
+
+	if d.Audit != nil {
+		de.AuditNextSeq = d.Audit.NextSequence
+		de.AuditRecords = d.Audit.Records
+	}
+
+	return de
+}
+
+// toData validates the export document and converts it into the internal
+// database format.
+func (de *DatabaseExport) toData(log logging.Logger) (*dbData, error) {
+	if de.SchemaVersion != CurrentSchemaVersion {
+		return nil, errors.Errorf("schema version %d != %d", de.SchemaVersion, CurrentSchemaVersion)
+	}
+
+	db, err := NewDatabase(log, nil)
+	if err != nil {
+		return nil, err
+	}
+	data := db.data
+	data.Version = de.Version
+	data.MapVersion = de.MapVersion
+	data.NextRank = de.NextRank
+	data.Replicas = de.Replicas
+	if de.SystemAttrs != nil {
+		data.System.Attributes = de.SystemAttrs
+	}
+
+	for _, m := range de.Members {
+		switch {
+		case m == nil:
+			return nil, errors.New("null member entry")
+		case m.Addr == nil:
+			return nil, errors.Errorf("member rank %d: missing address", m.Rank)
+		case m.Rank >= de.NextRank:
+			return nil, errors.Errorf("member rank %d: next_rank (%d) must be greater than all member ranks",
+				m.Rank, de.NextRank)
+		}
+		if _, exists := data.Members.Ranks[m.Rank]; exists {
+			return nil, errors.Errorf("duplicate member rank %d", m.Rank)
+		}
+		if _, exists := data.Members.Uuids[m.UUID]; exists {
+			return nil, errors.Errorf("member rank %d: duplicate member UUID %s", m.Rank, m.UUID)
+		}
+		if err := data.Members.FaultDomains.AddDomain(system.MemberFaultDomain(m)); err != nil {
+			return nil, errors.Wrapf(err, "member rank %d: invalid fault domain", m.Rank)
+		}
+		data.Members.Ranks[m.Rank] = m
+		data.Members.Uuids[m.UUID] = m
+		data.Members.Addrs.addMember(m.Addr, m)
+	}
+
+	for _, ps := range de.Pools {
+		if ps == nil {
+			return nil, errors.New("null pool entry")
+		}
+		if _, exists := data.Pools.Uuids[ps.PoolUUID]; exists {
+			return nil, errors.Errorf("duplicate pool UUID %s", ps.PoolUUID)
+		}
+		if _, exists := data.Pools.Labels[ps.PoolLabel]; ps.PoolLabel != "" && exists {
+			return nil, errors.Errorf("duplicate pool label %q", ps.PoolLabel)
+		}
+		for _, rank := range ps.Replicas {
+			if _, exists := data.Members.Ranks[rank]; !exists {
+				return nil, errors.Errorf("pool %s: service replica rank %d is not a system member",
+					ps.PoolUUID, rank)
+			}
+		}
+		data.Pools.addService(ps)
+	}
+
+	for _, pp := range de.PoolProfiles {
+		if err := pp.Validate(); err != nil {
+			return nil, errors.Wrap(err, "invalid pool profile")
+		}
+		if _, exists := data.PoolProfiles[pp.Name]; exists {
+			return nil, errors.Errorf("duplicate pool profile %q", pp.Name)
+		}
+		data.PoolProfiles[pp.Name] = pp
+	}
+
+	var lastSeq uint64
+	for _, rec := range de.AuditRecords {
+		switch {
+		case rec == nil:
+			return nil, errors.New("null audit record entry")
+		case rec.Sequence <= lastSeq:
+			return nil, errors.Errorf("audit record %d: sequence numbers must be increasing", rec.Sequence)
+		}
+		lastSeq = rec.Sequence
+	}
+	if len(de.AuditRecords) > maxAuditRecords {
+		return nil, errors.Errorf("%d audit records exceeds maximum of %d", len(de.AuditRecords), maxAuditRecords)
+	}
+	if de.AuditNextSeq <= lastSeq {
+		return nil, errors.Errorf("audit_next_sequence (%d) must be greater than all audit record sequence numbers",
+			de.AuditNextSeq)
+	}
+	data.Audit.NextSequence = de.AuditNextSeq
+	data.Audit.Records = de.AuditRecords
+
+	return data, nil
+}
+
+// loadLocalData reconstructs the system database from the local on-disk
+// replica state by loading the latest snapshot and replaying any subsequent
+// log entries. The raft service is not started.
+func loadLocalData(log logging.Logger, cfg *DatabaseConfig) (*dbData, error) {
+	roCfg := *cfg
+	roCfg.ReadOnly = true
+	cmps, err := ConfigureComponents(log, &roCfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to configure raft components")
+	}
+	defer func() {
+		if boltDB, ok := cmps.LogStore.(*boltdb.BoltStore); ok {
+			boltDB.Close()
+		}
+	}()
+
+	db, err := NewDatabase(log, nil)
+	if err != nil {
+		return nil, err
+	}
+	data := db.data
+
+	snaps, err := cmps.SnapshotStore.List()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list snapshots")
+	}
+	var lastIndex uint64
+	if len(snaps) > 0 {
+		// Snapshots are listed newest first.
+		_, rc, err := cmps.SnapshotStore.Open(snaps[0].ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to open snapshot %s", snaps[0].ID)
+		}
+		err = json.NewDecoder(rc).Decode(data)
+		rc.Close()
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to decode snapshot %s", snaps[0].ID)
+		}
+		lastIndex = snaps[0].Index
+	}
+
+	first, err := cmps.LogStore.FirstIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get first log index")
+	}
+	last, err := cmps.LogStore.LastIndex()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get last log index")
+	}
+	if first <= lastIndex {
+		first = lastIndex + 1
+	}
+
+	var applyErr error
+	setErr := func(err error) {
+		applyErr = err
+	}
+	for idx := first; idx <= last && idx > 0; idx++ {
+		var entry raft.Log
+		if err := cmps.LogStore.GetLog(idx, &entry); err != nil {
+			return nil, errors.Wrapf(err, "failed to read log entry %d", idx)
+		}
+		if entry.Type != raft.LogCommand {
+			continue
+		}
+
+		c := new(raftUpdate)
+		if err := json.Unmarshal(entry.Data, c); err != nil {
+			return nil, errors.Wrapf(err, "failed to decode log entry %d", idx)
+		}
+		data.applyUpdate(c, setErr)
+		if applyErr != nil {
+			return nil, errors.Wrapf(applyErr, "failed to apply log entry %d", idx)
+		}
+	}
+
+	if data.SchemaVersion > CurrentSchemaVersion {
+		return nil, errors.Errorf("local schema version %d is newer than supported version %d",
+			data.SchemaVersion, CurrentSchemaVersion)
+	}
+
+	return data, nil
+}
+
+// ExportLocalReplica exports the system database from the local on-disk
+// replica state. The control plane server must not be running.
+func ExportLocalReplica(log logging.Logger, cfg *DatabaseConfig) (*DatabaseExport, error) {
+	data, err := loadLocalData(log, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	// Export documents are always in the current schema, so any pending
+	// migrations are applied to the in-memory copy.
+	if data.SchemaVersion < CurrentSchemaVersion {
+		log.Noticef("migrating exported schema from version %d to %d", data.SchemaVersion, CurrentSchemaVersion)
+		if err := data.migrate(dbMigrations, CurrentSchemaVersion); err != nil {
+			return nil, err
+		}
+	}
+
+	return newDatabaseExport(data), nil
+}
+
+// ImportLocalReplica replaces the local replica state with the contents of the
+// supplied export document, which is validated before any changes are made.
+// As with RestoreLocalReplica, the local replica is re-bootstrapped in
+// single-node mode and the control plane server must not be running.
+func ImportLocalReplica(log logging.Logger, cfg *DatabaseConfig, de *DatabaseExport) error {
+	if de == nil {
+		return errors.Errorf("nil %T", de)
+	}
+
+	data, err := de.toData(log)
+	if err != nil {
+		return errors.Wrap(err, "invalid database export")
+	}
+
+	return restoreLocalData(log, cfg, data)
+}
+
+// restoreLocalData replaces the local replica state with the supplied data
+// by writing it to a temporary snapshot and restoring from that snapshot.
+func restoreLocalData(log logging.Logger, cfg *DatabaseConfig, data *dbData) error {
+	buf, err := json.Marshal(data)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode database")
+	}
+
+	tmpDir, err := ioutil.TempDir("", "daos-raft-import")
+	if err != nil {
+		return errors.Wrap(err, "failed to create temporary directory")
+	}
+	defer os.RemoveAll(tmpDir)
+
+	meta, err := json.Marshal(&raft.SnapshotMeta{
+		Version: raft.SnapshotVersionMax,
+		ID:      "import",
+		Index:   1,
+		Term:    1,
+		Size:    int64(len(buf)),
+	})
+	if err != nil {
+		return errors.Wrap(err, "failed to encode snapshot metadata")
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, snapshotMetaFile), meta, 0600); err != nil {
+		return errors.Wrap(err, "failed to write snapshot metadata")
+	}
+	if err := ioutil.WriteFile(filepath.Join(tmpDir, snapshotDataFile), buf, 0600); err != nil {
+		return errors.Wrap(err, "failed to write snapshot data")
+	}
+
+	return RestoreLocalReplica(log, cfg, tmpDir)
+}
@@ -0,0 +1,220 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/lib/ranklist"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockExportDatabase(t *testing.T, log logging.Logger) *Database {
+	t.Helper()
+
+	ctx := test.Context(t)
+	db := MockDatabase(t, log)
+	for i := 0; i < 3; i++ {
+		m := system.MockMember(t, uint32(i+2), system.MemberStateJoined)
+		m.Rank = ranklist.NilRank
+		if err := db.AddMember(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i, label := range []string{"pool2", "pool1"} {
+		ps := system.NewPoolService(uuid.New(), []uint64{1, 2}, []ranklist.Rank{ranklist.Rank(i)})
+		ps.PoolLabel = label
+		ps.State = system.PoolServiceStateReady
+
+		lock, err := db.TakePoolLock(ctx, ps.PoolUUID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.AddPoolService(lock.InContext(ctx), ps); err != nil {
+			t.Fatal(err)
+		}
+		lock.Release()
+	}
+	if err := db.SetSystemAttrs(map[string]string{"foo": "bar"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"scratch", "archive"} {
+		if err := db.AddPoolProfile(mockPoolProfile(name), false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := db.AddAuditRecords([]*system.AuditRecord{
+		{Host: "host1", Method: "PoolCreate", Status: "OK"},
+		{Host: "host1", Method: "PoolDestroy", Status: "OK"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	return db
+}
+
+func TestSystem_DatabaseExport_RoundTrip(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := mockExportDatabase(t, log)
+	de := newDatabaseExport(db.data)
+
+	test.AssertEqual(t, 3, len(de.Members), "unexpected number of members")
+	for i, m := range de.Members {
+		test.AssertEqual(t, ranklist.Rank(i), m.Rank, "members not sorted by rank")
+	}
+	test.AssertEqual(t, "pool1", de.Pools[0].PoolLabel, "pools not sorted by label")
+	test.AssertEqual(t, "archive", de.PoolProfiles[0].Name, "pool profiles not sorted by name")
+	test.AssertEqual(t, 2, len(de.AuditRecords), "unexpected number of audit records")
+	test.AssertEqual(t, uint64(3), de.AuditNextSeq, "unexpected audit next sequence")
+
+	// Verify that the document survives serialization and can be used to
+	// reconstruct an equivalent database.
+	jsonDoc, err := json.MarshalIndent(de, "", "  ")
+	if err != nil {
+		t.Fatal(err)
+	}
+	fromDoc := new(DatabaseExport)
+	if err := json.Unmarshal(jsonDoc, fromDoc); err != nil {
+		t.Fatal(err)
+	}
+
+	data, err := fromDoc.toData(log)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cmpOpts := append(test.DefaultCmpOpts(),
+		cmpopts.IgnoreUnexported(system.Member{}, system.PoolServiceStorage{}),
+	)
+	if diff := cmp.Diff(de, newDatabaseExport(data), cmpOpts...); diff != "" {
+		t.Fatalf("unexpected export (-want, +got):\n%s\n", diff)
+	}
+	test.AssertEqual(t, len(db.data.Members.Addrs), len(data.Members.Addrs), "unexpected member address map")
+	test.AssertEqual(t, len(db.data.Pools.Labels), len(data.Pools.Labels), "unexpected pool label map")
+	test.AssertEqual(t, len(db.data.Pools.Ranks), len(data.Pools.Ranks), "unexpected pool rank map")
+	if diff := cmp.Diff(db.data.Audit, data.Audit, cmpOpts...); diff != "" {
+		t.Fatalf("unexpected audit database (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestSystem_DatabaseExport_toData(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	for name, tc := range map[string]struct {
+		modify func(*DatabaseExport)
+		expErr error
+	}{
+		"valid": {
+			modify: func(*DatabaseExport) {},
+		},
+		"bad schema version": {
+			modify: func(de *DatabaseExport) {
+				de.SchemaVersion = CurrentSchemaVersion + 1
+			},
+			expErr: errors.New("schema version"),
+		},
+		"duplicate rank": {
+			modify: func(de *DatabaseExport) {
+				dupe := *de.Members[1]
+				dupe.UUID = uuid.New()
+				dupe.Rank = de.Members[0].Rank
+				de.Members = append(de.Members, &dupe)
+			},
+			expErr: errors.New("duplicate member rank"),
+		},
+		"duplicate member uuid": {
+			modify: func(de *DatabaseExport) {
+				de.Members[1].UUID = de.Members[0].UUID
+			},
+			expErr: errors.New("duplicate member UUID"),
+		},
+		"rank beyond next rank": {
+			modify: func(de *DatabaseExport) {
+				de.NextRank = 1
+			},
+			expErr: errors.New("next_rank"),
+		},
+		"missing member address": {
+			modify: func(de *DatabaseExport) {
+				de.Members[0].Addr = nil
+			},
+			expErr: errors.New("missing address"),
+		},
+		"duplicate pool label": {
+			modify: func(de *DatabaseExport) {
+				de.Pools[1].PoolLabel = de.Pools[0].PoolLabel
+			},
+			expErr: errors.New("duplicate pool label"),
+		},
+		"pool replica not a member": {
+			modify: func(de *DatabaseExport) {
+				de.Pools[0].Replicas = []ranklist.Rank{42}
+			},
+			expErr: errors.New("not a system member"),
+		},
+		"duplicate pool profile": {
+			modify: func(de *DatabaseExport) {
+				de.PoolProfiles[1].Name = de.PoolProfiles[0].Name
+			},
+			expErr: errors.New("duplicate pool profile"),
+		},
+		"null audit record": {
+			modify: func(de *DatabaseExport) {
+				de.AuditRecords = append(de.AuditRecords, nil)
+			},
+			expErr: errors.New("null audit record"),
+		},
+		"audit records out of order": {
+			modify: func(de *DatabaseExport) {
+				de.AuditRecords[0], de.AuditRecords[1] = de.AuditRecords[1], de.AuditRecords[0]
+			},
+			expErr: errors.New("sequence numbers must be increasing"),
+		},
+		"audit next sequence too low": {
+			modify: func(de *DatabaseExport) {
+				de.AuditNextSeq = de.AuditRecords[1].Sequence
+			},
+			expErr: errors.New("audit_next_sequence"),
+		},
+		"invalid pool profile": {
+			modify: func(de *DatabaseExport) {
+				de.PoolProfiles[0].SizeRatio = 200
+			},
+			expErr: errors.New("invalid pool profile"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			db := mockExportDatabase(t, log)
+
+			// Work on a deserialized copy so that the source database
+			// is not modified.
+			jsonDoc, err := json.Marshal(newDatabaseExport(db.data))
+			if err != nil {
+				t.Fatal(err)
+			}
+			de := new(DatabaseExport)
+			if err := json.Unmarshal(jsonDoc, de); err != nil {
+				t.Fatal(err)
+			}
+			tc.modify(de)
+
+			_, gotErr := de.toData(log)
+			test.CmpErr(t, tc.expErr, gotErr)
+		})
+	}
+}
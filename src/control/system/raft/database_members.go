@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -162,6 +162,7 @@ func (mdb *MemberDatabase) updateMember(m *system.Member) {
 	cur.Info = m.Info
 	cur.LastUpdate = m.LastUpdate
 	cur.Incarnation = m.Incarnation
+	cur.Maintenance = m.Maintenance
 
 	mdb.removeFromFaultDomainTree(cur)
 	cur.FaultDomain = m.FaultDomain
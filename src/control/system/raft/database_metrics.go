@@ -0,0 +1,261 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/hashicorp/raft"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// This file contains the Prometheus instrumentation for the system database
+// and the raft service that replicates it. The Database implements the
+// prometheus.Collector interface so that it can be registered with the
+// control plane's metrics exporter.
+
+const (
+	msMetricsPrefix = "ms_"
+
+	snapshotPhaseCreate  = "create"
+	snapshotPhasePersist = "persist"
+	snapshotPhaseRestore = "restore"
+)
+
+var (
+	raftStateDesc = prometheus.NewDesc(msMetricsPrefix+"raft_state",
+		"Raft state of this replica (0=follower, 1=candidate, 2=leader, 3=shutdown)", nil, nil)
+	raftLeaderDesc = prometheus.NewDesc(msMetricsPrefix+"raft_leader",
+		"Set to 1 if this replica is the raft leader", nil, nil)
+	raftFollowerContactDesc = prometheus.NewDesc(msMetricsPrefix+"raft_follower_last_contact_seconds",
+		"Time since the leader last had successful contact with each follower", []string{"replica"}, nil)
+	membersDesc = prometheus.NewDesc(msMetricsPrefix+"members",
+		"Number of system members in each state (leader only)", []string{"state"}, nil)
+
+	// raftStatsDescs maps raft.Raft.Stats() keys to metrics.
+	raftStatsDescs = map[string]*prometheus.Desc{
+		"term": prometheus.NewDesc(msMetricsPrefix+"raft_term",
+			"Current raft term", nil, nil),
+		"commit_index": prometheus.NewDesc(msMetricsPrefix+"raft_commit_index",
+			"Index of the latest committed raft log entry", nil, nil),
+		"applied_index": prometheus.NewDesc(msMetricsPrefix+"raft_applied_index",
+			"Index of the latest raft log entry applied to the database", nil, nil),
+		"last_log_index": prometheus.NewDesc(msMetricsPrefix+"raft_last_log_index",
+			"Index of the latest raft log entry", nil, nil),
+		"last_snapshot_index": prometheus.NewDesc(msMetricsPrefix+"raft_last_snapshot_index",
+			"Index of the latest raft snapshot", nil, nil),
+	}
+)
+
+// dbMetrics contains the metrics which are updated as events occur, as
+// opposed to those which are sampled when metrics are collected.
+type dbMetrics struct {
+	applyDuration     prometheus.Histogram
+	snapshotDuration  *prometheus.HistogramVec
+	leadershipChanges *prometheus.CounterVec
+	poolLockWait      prometheus.Histogram
+	poolLockHold      prometheus.Histogram
+}
+
+func newDBMetrics() *dbMetrics {
+	return &dbMetrics{
+		applyDuration: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    msMetricsPrefix + "raft_apply_duration_seconds",
+			Help:    "Time taken for database updates to be committed and applied by the leader",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}),
+		snapshotDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    msMetricsPrefix + "raft_snapshot_duration_seconds",
+			Help:    "Time taken to create, persist or restore database snapshots",
+			Buckets: prometheus.ExponentialBuckets(0.0005, 2, 14),
+		}, []string{"phase"}),
+		leadershipChanges: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: msMetricsPrefix + "raft_leadership_changes_total",
+			Help: "Number of times this replica has gained or lost raft leadership",
+		}, []string{"event"}),
+		poolLockWait: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    msMetricsPrefix + "pool_lock_wait_seconds",
+			Help:    "Time from the first contended attempt to take a pool lock until the lock was released",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 16),
+		}),
+		poolLockHold: prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:    msMetricsPrefix + "pool_lock_hold_seconds",
+			Help:    "Time for which pool locks were held",
+			Buckets: prometheus.ExponentialBuckets(0.001, 2, 16),
+		}),
+	}
+}
+
+func (m *dbMetrics) collectors() []prometheus.Collector {
+	return []prometheus.Collector{
+		m.applyDuration,
+		m.snapshotDuration,
+		m.leadershipChanges,
+		m.poolLockWait,
+		m.poolLockHold,
+	}
+}
+
+// observeSnapshot records the time taken for a snapshot operation that
+// started at the supplied time.
+func (m *dbMetrics) observeSnapshot(phase string, start time.Time) {
+	if m == nil {
+		return
+	}
+	m.snapshotDuration.WithLabelValues(phase).Observe(time.Since(start).Seconds())
+}
+
+// raftContactSink is a go-metrics sink which records the time of the last
+// successful replication RPC from the leader to each follower, as the raft
+// library does not otherwise make this available.
+type raftContactSink struct {
+	metrics.BlackholeSink
+	sync.RWMutex
+	lastContact map[string]time.Time
+}
+
+// AddSampleWithLabels implements part of the metrics.MetricSink interface.
+func (s *raftContactSink) AddSampleWithLabels(key []string, _ float32, labels []metrics.Label) {
+	if !strings.HasPrefix(strings.Join(key, "."), "raft.replication.") {
+		return
+	}
+
+	for _, label := range labels {
+		if label.Name != "peer_id" {
+			continue
+		}
+		s.Lock()
+		s.lastContact[label.Value] = time.Now()
+		s.Unlock()
+	}
+}
+
+// getLastContact returns the time of the last contact with the supplied peer.
+func (s *raftContactSink) getLastContact(peerID string) (time.Time, bool) {
+	s.RLock()
+	defer s.RUnlock()
+
+	t, found := s.lastContact[peerID]
+	return t, found
+}
+
+var (
+	contactSink = &raftContactSink{
+		lastContact: make(map[string]time.Time),
+	}
+	contactSinkOnce sync.Once
+)
+
+// installContactSink sets the global go-metrics sink used by the raft
+// library to the follower contact sink.
+func installContactSink() error {
+	var err error
+	contactSinkOnce.Do(func() {
+		cfg := metrics.DefaultConfig("")
+		cfg.EnableHostname = false
+		cfg.EnableRuntimeMetrics = false
+		_, err = metrics.NewGlobal(cfg, contactSink)
+	})
+	return err
+}
+
+// Describe implements part of the prometheus.Collector interface.
+func (db *Database) Describe(ch chan<- *prometheus.Desc) {
+	ch <- raftStateDesc
+	ch <- raftLeaderDesc
+	ch <- raftFollowerContactDesc
+	ch <- membersDesc
+	for _, desc := range raftStatsDescs {
+		ch <- desc
+	}
+	for _, c := range db.metrics.collectors() {
+		c.Describe(ch)
+	}
+}
+
+// Collect implements part of the prometheus.Collector interface.
+func (db *Database) Collect(ch chan<- prometheus.Metric) {
+	if !db.IsReplica() {
+		return
+	}
+
+	for _, c := range db.metrics.collectors() {
+		c.Collect(ch)
+	}
+
+	var isLeader bool
+	_ = db.raft.withReadLock(func(svc raftService) error {
+		state := svc.State()
+		isLeader = state == raft.Leader
+		ch <- prometheus.MustNewConstMetric(raftStateDesc, prometheus.GaugeValue, float64(state))
+		ch <- prometheus.MustNewConstMetric(raftLeaderDesc, prometheus.GaugeValue, boolToFloat(isLeader))
+
+		stats := svc.Stats()
+		for key, desc := range raftStatsDescs {
+			val, err := strconv.ParseUint(stats[key], 10, 64)
+			if err != nil {
+				continue
+			}
+			ch <- prometheus.MustNewConstMetric(desc, prometheus.GaugeValue, float64(val))
+		}
+
+		return nil
+	})
+
+	// Only the leader has meaningful follower contact information, and
+	// reporting membership from the leader alone avoids multiple counts
+	// of the same members when metrics are aggregated across replicas.
+	if !isLeader {
+		return
+	}
+
+	peers, _ := db.PeerAddrs()
+	for _, peer := range peers {
+		last, found := contactSink.getLastContact(peer.String())
+		if !found {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(raftFollowerContactDesc, prometheus.GaugeValue,
+			time.Since(last).Seconds(), peer.String())
+	}
+
+	for state, count := range db.memberStateCounts() {
+		ch <- prometheus.MustNewConstMetric(membersDesc, prometheus.GaugeValue,
+			float64(count), state.String())
+	}
+}
+
+// memberStateCounts returns the number of members in each state. All known
+// states are included, so that a state with no members reports zero.
+func (db *Database) memberStateCounts() map[system.MemberState]int {
+	counts := make(map[system.MemberState]int)
+	for state := system.MemberStateAwaitFormat; state < system.MemberStateMax; state <<= 1 {
+		counts[state] = 0
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	for _, m := range db.data.Members.Uuids {
+		counts[m.State]++
+	}
+
+	return counts
+}
+
+func boolToFloat(b bool) float64 {
+	if b {
+		return 1
+	}
+	return 0
+}
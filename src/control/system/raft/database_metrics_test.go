@@ -0,0 +1,226 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	metrics "github.com/armon/go-metrics"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/uuid"
+	"github.com/hashicorp/raft"
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// gatherMetrics registers the database with a new registry and returns the
+// gathered gauge and counter values, along with histogram sample counts,
+// keyed by metric name and labels.
+func gatherMetrics(t *testing.T, db *Database) map[string]float64 {
+	t.Helper()
+
+	reg := prometheus.NewRegistry()
+	if err := reg.Register(db); err != nil {
+		t.Fatal(err)
+	}
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	values := make(map[string]float64)
+	for _, mf := range families {
+		for _, m := range mf.GetMetric() {
+			key := mf.GetName()
+			for _, lp := range m.GetLabel() {
+				key += fmt.Sprintf("{%s=%s}", lp.GetName(), lp.GetValue())
+			}
+
+			switch {
+			case m.GetGauge() != nil:
+				values[key] = m.GetGauge().GetValue()
+			case m.GetCounter() != nil:
+				values[key] = m.GetCounter().GetValue()
+			case m.GetHistogram() != nil:
+				values[key+"_count"] = float64(m.GetHistogram().GetSampleCount())
+			}
+		}
+	}
+
+	return values
+}
+
+func TestSystem_Database_Collect(t *testing.T) {
+	for name, tc := range map[string]struct {
+		notReplica bool
+		state      raft.RaftState
+		members    []system.MemberState
+		expValues  map[string]float64
+		expMissing []string
+	}{
+		"not a replica": {
+			notReplica: true,
+			expMissing: []string{
+				"ms_raft_state",
+				"ms_raft_apply_duration_seconds_count",
+			},
+		},
+		"follower": {
+			state:   raft.Follower,
+			members: []system.MemberState{system.MemberStateJoined},
+			expValues: map[string]float64{
+				"ms_raft_state":                        0,
+				"ms_raft_leader":                       0,
+				"ms_raft_term":                         1,
+				"ms_raft_commit_index":                 1,
+				"ms_raft_applied_index":                1,
+				"ms_raft_last_log_index":               1,
+				"ms_raft_apply_duration_seconds_count": 1,
+			},
+			expMissing: []string{
+				"ms_members{state=Joined}",
+			},
+		},
+		"leader": {
+			state: raft.Leader,
+			members: []system.MemberState{
+				system.MemberStateJoined,
+				system.MemberStateJoined,
+				system.MemberStateExcluded,
+			},
+			expValues: map[string]float64{
+				"ms_raft_state":                        2,
+				"ms_raft_leader":                       1,
+				"ms_raft_term":                         1,
+				"ms_members{state=Joined}":             2,
+				"ms_members{state=Excluded}":           1,
+				"ms_members{state=Stopped}":            0,
+				"ms_raft_apply_duration_seconds_count": 3,
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for i, ms := range tc.members {
+				if err := db.AddMember(system.MockMember(t, uint32(i+1), ms)); err != nil {
+					t.Fatal(err)
+				}
+			}
+			db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+				State: tc.state,
+			}, (*fsm)(db)))
+			if tc.notReplica {
+				db.replicaAddr = nil
+			}
+
+			values := gatherMetrics(t, db)
+			for key, expVal := range tc.expValues {
+				gotVal, found := values[key]
+				if !found {
+					t.Fatalf("metric %q not found in %+v", key, values)
+				}
+				test.AssertEqual(t, expVal, gotVal, key)
+			}
+			for _, key := range tc.expMissing {
+				if _, found := values[key]; found {
+					t.Fatalf("unexpected metric %q", key)
+				}
+			}
+		})
+	}
+}
+
+func TestSystem_Database_Collect_FollowerContact(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	local := system.MockMember(t, 1, system.MemberStateJoined).Addr
+	peer := system.MockMember(t, 2, system.MemberStateJoined).Addr
+	other := system.MockMember(t, 3, system.MemberStateJoined).Addr
+
+	db := MockDatabaseWithCfg(t, log, &DatabaseConfig{
+		Replicas: []*net.TCPAddr{local, peer, other},
+	})
+	db.replicaAddr = local
+
+	contactSink.AddSampleWithLabels([]string{"raft", "replication", "heartbeat"}, 1,
+		[]metrics.Label{{Name: "peer_id", Value: peer.String()}})
+
+	values := gatherMetrics(t, db)
+	key := fmt.Sprintf("ms_raft_follower_last_contact_seconds{replica=%s}", peer)
+	if _, found := values[key]; !found {
+		t.Fatalf("metric %q not found in %+v", key, values)
+	}
+	key = fmt.Sprintf("ms_raft_follower_last_contact_seconds{replica=%s}", other)
+	if _, found := values[key]; found {
+		t.Fatalf("unexpected metric %q", key)
+	}
+}
+
+func TestSystem_raftContactSink(t *testing.T) {
+	sink := &raftContactSink{lastContact: make(map[string]time.Time)}
+
+	before := time.Now()
+	sink.AddSampleWithLabels([]string{"raft", "replication", "appendEntries", "rpc"}, 1,
+		[]metrics.Label{{Name: "peer_id", Value: "a"}})
+	sink.AddSampleWithLabels([]string{"raft", "rpc", "appendEntries"}, 1,
+		[]metrics.Label{{Name: "peer_id", Value: "b"}})
+	sink.AddSampleWithLabels([]string{"raft", "replication", "heartbeat"}, 1,
+		[]metrics.Label{{Name: "other", Value: "c"}})
+
+	var gotPeers []string
+	for _, peer := range []string{"a", "b", "c"} {
+		if last, found := sink.getLastContact(peer); found {
+			if last.Before(before) {
+				t.Fatalf("unexpected contact time for %s", peer)
+			}
+			gotPeers = append(gotPeers, peer)
+		}
+	}
+
+	if diff := cmp.Diff([]string{"a"}, gotPeers); diff != "" {
+		t.Fatalf("unexpected peers (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestSystem_poolLockMap_Metrics(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	poolUUID := uuid.New()
+
+	uncontended, err := db.poolLocks.take(poolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uncontended.Release()
+
+	contended, err := db.poolLocks.take(poolUUID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err := db.poolLocks.take(poolUUID); err == nil {
+			t.Fatal("expected lock contention")
+		}
+	}
+	contended.Release()
+
+	values := gatherMetrics(t, db)
+	test.AssertEqual(t, float64(2), values["ms_pool_lock_hold_seconds_count"], "unexpected hold count")
+	test.AssertEqual(t, float64(1), values["ms_pool_lock_wait_seconds_count"], "unexpected wait count")
+}
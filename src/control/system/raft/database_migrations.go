@@ -0,0 +1,259 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"encoding/json"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+type (
+	// schemaMigration upgrades the system database from one schema
+	// version to the next. Migrations are applied on every replica as
+	// part of the raft log, so they must be deterministic and must not
+	// depend on any state outside of the database.
+	schemaMigration struct {
+		From        uint
+		Description string
+		Apply       func(*dbData) error
+	}
+
+	// schemaMigrations is an ordered set of schema migrations, where the
+	// entry at index N upgrades the schema from version N to N+1.
+	schemaMigrations []*schemaMigration
+
+	// schemaUpdate is the payload of a schema migration raft update.
+	schemaUpdate struct {
+		From uint
+		To   uint
+	}
+
+	// MigrationDetails describes a single schema migration step.
+	MigrationDetails struct {
+		From        uint   `json:"from"`
+		To          uint   `json:"to"`
+		Description string `json:"description"`
+	}
+)
+
+// dbMigrationSteps is the registry of system database schema migrations. When
+// the schema is changed, a migration from the previous version must be appended
+// here. CurrentSchemaVersion is derived from the number of registered steps.
+var dbMigrationSteps = [...]*schemaMigration{
+	{
+		From:        0,
+		Description: "initialize audit log, pool profiles and pool service tags and properties",
+		Apply:       migrateSchemaV0,
+	},
+}
+
+// dbMigrations is the set of registered system database schema migrations.
+var dbMigrations = schemaMigrations(dbMigrationSteps[:])
+
+// migrateSchemaV0 upgrades a version 0 database, as written before the audit
+// log, pool profiles, pool service tags and MS-managed pool properties were
+// added, by initializing the new fields so that an upgraded database is
+// indistinguishable from one created by the current version.
+func migrateSchemaV0(d *dbData) error {
+	if d.Audit == nil {
+		d.Audit = newAuditDatabase()
+	}
+	if d.Audit.NextSequence == 0 {
+		d.Audit.NextSequence = 1
+		if n := len(d.Audit.Records); n > 0 {
+			d.Audit.NextSequence = d.Audit.Records[n-1].Sequence + 1
+		}
+	}
+	if d.PoolProfiles == nil {
+		d.PoolProfiles = make(PoolProfileMap)
+	}
+
+	if d.Pools == nil {
+		return errors.New("nil pool database")
+	}
+	for _, ps := range d.Pools.Uuids {
+		if ps.Tags == nil {
+			ps.Tags = make(system.PoolTags)
+		}
+		if ps.MgmtProperties == nil {
+			ps.MgmtProperties = make(map[uint32]uint64)
+		}
+	}
+
+	return nil
+}
+
+// version returns the schema version reached by applying all of the
+// migrations in the set.
+func (sm schemaMigrations) version() uint {
+	return uint(len(sm))
+}
+
+// validate checks that the set of migrations is ordered and contiguous.
+func (sm schemaMigrations) validate() error {
+	for i, m := range sm {
+		if m == nil || m.Apply == nil {
+			return errors.Errorf("migration %d is incomplete", i)
+		}
+		if m.From != uint(i) {
+			return errors.Errorf("migration %d has unexpected source version %d", i, m.From)
+		}
+	}
+
+	return nil
+}
+
+// pending returns the migrations required to upgrade the schema from the
+// supplied version to the version of the set.
+func (sm schemaMigrations) pending(from uint) ([]*schemaMigration, error) {
+	if err := sm.validate(); err != nil {
+		return nil, err
+	}
+	if from > sm.version() {
+		return nil, errors.Errorf("schema version %d is newer than supported version %d",
+			from, sm.version())
+	}
+
+	return sm[from:], nil
+}
+
+// migrationDetails returns descriptions of the supplied migration steps.
+func migrationDetails(steps []*schemaMigration) []*MigrationDetails {
+	details := make([]*MigrationDetails, 0, len(steps))
+	for _, step := range steps {
+		details = append(details, &MigrationDetails{
+			From:        step.From,
+			To:          step.From + 1,
+			Description: step.Description,
+		})
+	}
+
+	return details
+}
+
+// migrate applies the supplied migrations in order, updating the schema
+// version after each successful step. The caller must hold the write lock.
+func (d *dbData) migrate(sm schemaMigrations, to uint) error {
+	steps, err := sm.pending(d.SchemaVersion)
+	if err != nil {
+		return err
+	}
+
+	for _, step := range steps {
+		if step.From >= to {
+			break
+		}
+		if err := step.Apply(d); err != nil {
+			return errors.Wrapf(err, "schema migration %d -> %d failed", step.From, step.From+1)
+		}
+		d.SchemaVersion = step.From + 1
+	}
+
+	if d.SchemaVersion != to {
+		return errors.Errorf("schema version %d != %d after migration", d.SchemaVersion, to)
+	}
+
+	return nil
+}
+
+// applySchemaUpdate is responsible for applying the schema migration
+// operation to the database.
+func (d *dbData) applySchemaUpdate(op raftOp, data []byte, panicFn func(error)) {
+	su := new(schemaUpdate)
+	if err := json.Unmarshal(data, su); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode schema update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpMigrateSchema:
+		if d.SchemaVersion >= su.To {
+			// Already migrated, e.g. by an update from a previous leader.
+			return
+		}
+		if d.SchemaVersion != su.From {
+			panicFn(errors.Errorf("schema update from version %d does not match current version %d",
+				su.From, d.SchemaVersion))
+			return
+		}
+		if err := d.migrate(d.migrations, su.To); err != nil {
+			panicFn(err)
+			return
+		}
+		d.log.Noticef("system database schema migrated from version %d to %d", su.From, su.To)
+	default:
+		panicFn(errors.Errorf("unhandled Schema Apply operation: %d", op))
+		return
+	}
+}
+
+// migrateSchema submits a schema migration via the raft log if the stored
+// schema version is older than the version supported by this binary. It is
+// run on the leader after leadership is gained and before any other leader
+// callbacks.
+func (db *Database) migrateSchema() error {
+	db.data.RLock()
+	from := db.data.SchemaVersion
+	sm := db.data.migrations
+	db.data.RUnlock()
+
+	steps, err := sm.pending(from)
+	if err != nil {
+		return err
+	}
+	if len(steps) == 0 {
+		return nil
+	}
+
+	for _, md := range migrationDetails(steps) {
+		db.log.Noticef("migrating system database schema from version %d to %d: %s",
+			md.From, md.To, md.Description)
+	}
+
+	data, err := createRaftUpdate(raftOpMigrateSchema, &schemaUpdate{
+		From: from,
+		To:   sm.version(),
+	})
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// MigrateLocalReplica upgrades the schema of the local on-disk replica state
+// to the current version. If dryRun is true, the migrations that would be run
+// are returned without making any changes. As with RestoreLocalReplica, the
+// local replica is re-bootstrapped in single-node mode after a migration and
+// the control plane server must not be running.
+func MigrateLocalReplica(log logging.Logger, cfg *DatabaseConfig, dryRun bool) ([]*MigrationDetails, error) {
+	data, err := loadLocalData(log, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	steps, err := dbMigrations.pending(data.SchemaVersion)
+	if err != nil {
+		return nil, err
+	}
+	details := migrationDetails(steps)
+	if dryRun || len(steps) == 0 {
+		return details, nil
+	}
+
+	if err := data.migrate(dbMigrations, CurrentSchemaVersion); err != nil {
+		return nil, err
+	}
+
+	return details, restoreLocalData(log, cfg, data)
+}
@@ -0,0 +1,408 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+)
+
+// testMigrations is a set of migrations used to exercise the framework
+// independently of the registered set.
+func testMigrations() schemaMigrations {
+	return schemaMigrations{
+		{
+			From:        0,
+			Description: "add migrated system attribute",
+			Apply: func(d *dbData) error {
+				d.System.Attributes["migrated"] = "v1"
+				return nil
+			},
+		},
+		{
+			From:        1,
+			Description: "set member info",
+			Apply: func(d *dbData) error {
+				for _, m := range d.Members.Uuids {
+					m.Info = "v2"
+				}
+				return nil
+			},
+		},
+	}
+}
+
+func loadTestSnapshots(t *testing.T, log logging.Logger) map[string]func() *dbData {
+	t.Helper()
+
+	paths, err := filepath.Glob("testdata/raft_recovery/snapshots/*/state.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no test snapshots found")
+	}
+
+	snaps := make(map[string]func() *dbData)
+	for _, path := range paths {
+		buf, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		snaps[filepath.Base(filepath.Dir(path))] = func() *dbData {
+			db, _ := NewDatabase(log, nil)
+			if err := json.Unmarshal(buf, db.data); err != nil {
+				t.Fatal(err)
+			}
+			return db.data
+		}
+	}
+
+	return snaps
+}
+
+func TestSystem_dbMigrations(t *testing.T) {
+	if err := dbMigrations.validate(); err != nil {
+		t.Fatal(err)
+	}
+	test.AssertEqual(t, uint(CurrentSchemaVersion), dbMigrations.version(),
+		"registered migrations do not match the current schema version")
+}
+
+func TestSystem_schemaMigrations_pending(t *testing.T) {
+	for name, tc := range map[string]struct {
+		migrations schemaMigrations
+		from       uint
+		expSteps   []*MigrationDetails
+		expErr     error
+	}{
+		"no migrations": {
+			migrations: schemaMigrations{},
+			expSteps:   []*MigrationDetails{},
+		},
+		"all steps": {
+			migrations: testMigrations(),
+			expSteps: []*MigrationDetails{
+				{From: 0, To: 1, Description: "add migrated system attribute"},
+				{From: 1, To: 2, Description: "set member info"},
+			},
+		},
+		"partial": {
+			migrations: testMigrations(),
+			from:       1,
+			expSteps: []*MigrationDetails{
+				{From: 1, To: 2, Description: "set member info"},
+			},
+		},
+		"up to date": {
+			migrations: testMigrations(),
+			from:       2,
+			expSteps:   []*MigrationDetails{},
+		},
+		"newer than known": {
+			migrations: testMigrations(),
+			from:       3,
+			expErr:     errors.New("newer than supported"),
+		},
+		"out of order": {
+			migrations: func() schemaMigrations {
+				sm := testMigrations()
+				sm[0], sm[1] = sm[1], sm[0]
+				return sm
+			}(),
+			expErr: errors.New("unexpected source version"),
+		},
+		"missing apply": {
+			migrations: schemaMigrations{{From: 0}},
+			expErr:     errors.New("incomplete"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			steps, gotErr := tc.migrations.pending(tc.from)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expSteps, migrationDetails(steps)); diff != "" {
+				t.Fatalf("unexpected steps (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+// TestSystem_dbData_migrate_Snapshots replays old snapshots through each
+// migration step in turn.
+func TestSystem_dbData_migrate_Snapshots(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	checkStep := map[uint]func(*testing.T, *dbData){
+		1: func(t *testing.T, d *dbData) {
+			test.AssertEqual(t, "v1", d.System.Attributes["migrated"], "step 1 not applied")
+		},
+		2: func(t *testing.T, d *dbData) {
+			for _, m := range d.Members.Uuids {
+				test.AssertEqual(t, "v2", m.Info, "step 2 not applied")
+			}
+		},
+	}
+
+	for name, load := range loadTestSnapshots(t, log) {
+		t.Run(name, func(t *testing.T) {
+			orig := load()
+			test.AssertEqual(t, uint(0), orig.SchemaVersion, "unexpected snapshot schema version")
+
+			sm := testMigrations()
+			for to := uint(1); to <= sm.version(); to++ {
+				d := load()
+				if err := d.migrate(sm, to); err != nil {
+					t.Fatal(err)
+				}
+				test.AssertEqual(t, to, d.SchemaVersion, "unexpected schema version")
+				for step := uint(1); step <= to; step++ {
+					checkStep[step](t, d)
+				}
+				test.AssertEqual(t, len(orig.Members.Uuids), len(d.Members.Uuids), "members lost")
+				test.AssertEqual(t, len(orig.Pools.Uuids), len(d.Pools.Uuids), "pools lost")
+			}
+
+			d := load()
+			if err := d.migrate(dbMigrations, CurrentSchemaVersion); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, uint(CurrentSchemaVersion), d.SchemaVersion, "unexpected schema version")
+		})
+	}
+}
+
+// TestSystem_migrateSchemaV0 applies the registered version 0 migration to
+// snapshots written before any of the fields it initializes existed.
+func TestSystem_migrateSchemaV0(t *testing.T) {
+	paths, err := filepath.Glob("testdata/raft_recovery/snapshots/*/state.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range paths {
+		t.Run(filepath.Base(filepath.Dir(path)), func(t *testing.T) {
+			buf, err := ioutil.ReadFile(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			// Clear the fields added in version 1 so that they are not
+			// pre-initialized, as they would not be by an older release.
+			db, _ := NewDatabase(nil, nil)
+			d := db.data
+			d.Audit = nil
+			d.PoolProfiles = nil
+			if err := json.Unmarshal(buf, d); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, uint(0), d.SchemaVersion, "unexpected snapshot schema version")
+			if d.Audit != nil || d.PoolProfiles != nil {
+				t.Fatal("snapshot unexpectedly contains new fields")
+			}
+			poolCount := len(d.Pools.Uuids)
+			memberCount := len(d.Members.Uuids)
+
+			if err := d.migrate(dbMigrations, 1); err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, uint(1), d.SchemaVersion, "unexpected schema version")
+			if diff := cmp.Diff(newAuditDatabase(), d.Audit); diff != "" {
+				t.Fatalf("unexpected audit database (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(make(PoolProfileMap), d.PoolProfiles); diff != "" {
+				t.Fatalf("unexpected pool profiles (-want, +got):\n%s\n", diff)
+			}
+			for _, ps := range d.Pools.Uuids {
+				if ps.Tags == nil || ps.MgmtProperties == nil {
+					t.Fatalf("pool %s not migrated", ps.PoolUUID)
+				}
+			}
+			test.AssertEqual(t, poolCount, len(d.Pools.Uuids), "pools lost")
+			test.AssertEqual(t, memberCount, len(d.Members.Uuids), "members lost")
+
+			// The migrated data must survive a snapshot round trip.
+			data, err := json.Marshal(d)
+			if err != nil {
+				t.Fatal(err)
+			}
+			db, _ = NewDatabase(nil, nil)
+			restored := db.data
+			if err := json.Unmarshal(data, restored); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, uint(1), restored.SchemaVersion, "schema version not persisted")
+			test.AssertEqual(t, uint64(1), restored.Audit.NextSequence, "audit sequence not persisted")
+		})
+	}
+}
+
+func TestSystem_Database_migrateSchema(t *testing.T) {
+	for name, tc := range map[string]struct {
+		startVer   uint
+		expVer     uint
+		expApplied bool
+		expErr     error
+	}{
+		"old schema": {
+			expVer:     2,
+			expApplied: true,
+		},
+		"partially migrated": {
+			startVer: 1,
+			expVer:   2,
+		},
+		"up to date": {
+			startVer: 2,
+			expVer:   2,
+		},
+		"newer than known": {
+			startVer: 3,
+			expVer:   3,
+			expErr:   errors.New("newer than supported"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			db.data.migrations = testMigrations()
+			db.data.SchemaVersion = tc.startVer
+			startDataVer := db.data.Version
+
+			gotErr := db.migrateSchema()
+			test.CmpErr(t, tc.expErr, gotErr)
+
+			test.AssertEqual(t, tc.expVer, db.data.SchemaVersion, "unexpected schema version")
+			_, applied := db.data.System.Attributes["migrated"]
+			test.AssertEqual(t, tc.expApplied, applied, "unexpected migration state")
+			if tc.startVer == tc.expVer {
+				test.AssertEqual(t, startDataVer, db.data.Version, "unexpected raft update")
+			}
+		})
+	}
+}
+
+func TestSystem_Database_applySchemaUpdate(t *testing.T) {
+	for name, tc := range map[string]struct {
+		startVer uint
+		update   *schemaUpdate
+		expVer   uint
+		expPanic bool
+	}{
+		"apply": {
+			update: &schemaUpdate{From: 0, To: 2},
+			expVer: 2,
+		},
+		"already applied": {
+			startVer: 2,
+			update:   &schemaUpdate{From: 0, To: 2},
+			expVer:   2,
+		},
+		"version mismatch": {
+			startVer: 1,
+			update:   &schemaUpdate{From: 0, To: 2},
+			expVer:   1,
+			expPanic: true,
+		},
+		"unknown target version": {
+			update:   &schemaUpdate{From: 0, To: 3},
+			expVer:   2,
+			expPanic: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			db.data.migrations = testMigrations()
+			db.data.SchemaVersion = tc.startVer
+
+			data, err := json.Marshal(tc.update)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			var panicked bool
+			db.data.applySchemaUpdate(raftOpMigrateSchema, data, func(error) {
+				panicked = true
+			})
+
+			test.AssertEqual(t, tc.expPanic, panicked, "unexpected panic state")
+			test.AssertEqual(t, tc.expVer, db.data.SchemaVersion, "unexpected schema version")
+		})
+	}
+}
+
+func TestSystem_fsm_Restore_NewerSchema(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	db.data.SchemaVersion = CurrentSchemaVersion + 1
+	snap, err := (*fsm)(db).Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored := MockDatabase(t, log)
+	rc := ioutil.NopCloser(bytes.NewReader(snap.(*fsmSnapshot).data))
+	test.CmpErr(t, errors.New("newer than supported"), (*fsm)(restored).Restore(rc))
+}
+
+// TestSystem_fsm_Restore_OlderSchema establishes that an old snapshot is
+// restored as-is and then upgraded when the leader migrates the schema.
+func TestSystem_fsm_Restore_OlderSchema(t *testing.T) {
+	for name, load := range loadTestSnapshots(t, nil) {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			orig := load()
+			snapData, err := json.Marshal(orig)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			db := MockDatabase(t, log)
+			db.data.migrations = testMigrations()
+			rc := ioutil.NopCloser(bytes.NewReader(snapData))
+			if err := (*fsm)(db).Restore(rc); err != nil {
+				t.Fatal(err)
+			}
+			test.AssertEqual(t, uint(0), db.data.SchemaVersion, "unexpected schema version after restore")
+
+			if err := db.migrateSchema(); err != nil {
+				t.Fatal(err)
+			}
+
+			test.AssertEqual(t, uint(2), db.data.SchemaVersion, "unexpected schema version after migration")
+			test.AssertEqual(t, "v1", db.data.System.Attributes["migrated"], "step 1 not applied")
+			for _, m := range db.data.Members.Uuids {
+				test.AssertEqual(t, "v2", m.Info, "step 2 not applied")
+			}
+			test.AssertEqual(t, len(orig.Members.Uuids), len(db.data.Members.Uuids), "members lost")
+			test.AssertEqual(t, len(orig.Pools.Uuids), len(db.data.Pools.Uuids), "pools lost")
+		})
+	}
+}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -134,6 +134,8 @@ func (pdb *PoolDatabase) updateService(cur, new *system.PoolService) {
 	}
 	cur.State = new.State
 	cur.LastUpdate = new.LastUpdate
+	cur.MgmtProperties = new.MgmtProperties
+	cur.Tags = new.Tags
 
 	// TODO: Update svc rank map
 	cur.Replicas = new.Replicas
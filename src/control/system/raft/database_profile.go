@@ -0,0 +1,135 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"encoding/json"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// PoolProfileMap provides a map of Name->*PoolProfile.
+type PoolProfileMap map[string]*system.PoolProfile
+
+func copyPoolProfile(in *system.PoolProfile) *system.PoolProfile {
+	out := *in
+	if in.Properties != nil {
+		out.Properties = make(map[string]string, len(in.Properties))
+		for k, v := range in.Properties {
+			out.Properties[k] = v
+		}
+	}
+	out.ACL = append([]string(nil), in.ACL...)
+	out.TierRatio = append([]float64(nil), in.TierRatio...)
+
+	return &out
+}
+
+// AddPoolProfile adds the supplied pool profile to the system database. If
+// replace is true, any existing profile with the same name is replaced,
+// otherwise an error is returned if the name is already in use.
+func (db *Database) AddPoolProfile(pp *system.PoolProfile, replace bool) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	if err := pp.Validate(); err != nil {
+		return err
+	}
+
+	if !replace {
+		db.data.RLock()
+		_, exists := db.data.PoolProfiles[pp.Name]
+		db.data.RUnlock()
+		if exists {
+			return system.ErrPoolProfileExists(pp.Name)
+		}
+	}
+
+	data, err := createRaftUpdate(raftOpUpdatePoolProfile, pp)
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// RemovePoolProfile removes the named pool profile from the system database.
+func (db *Database) RemovePoolProfile(name string) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+
+	db.data.RLock()
+	_, exists := db.data.PoolProfiles[name]
+	db.data.RUnlock()
+	if !exists {
+		return system.ErrPoolProfileNotFound(name)
+	}
+
+	data, err := createRaftUpdate(raftOpRemovePoolProfile, &system.PoolProfile{Name: name})
+	if err != nil {
+		return err
+	}
+	return db.submitRaftUpdate(data)
+}
+
+// PoolProfiles returns copies of the named pool profiles, or all profiles
+// if no names are supplied, sorted by name.
+func (db *Database) PoolProfiles(names ...string) ([]*system.PoolProfile, error) {
+	if err := db.CheckLeader(); err != nil {
+		return nil, err
+	}
+
+	db.data.RLock()
+	defer db.data.RUnlock()
+
+	out := []*system.PoolProfile{}
+	if len(names) == 0 {
+		for _, pp := range db.data.PoolProfiles {
+			out = append(out, copyPoolProfile(pp))
+		}
+	}
+	for _, name := range names {
+		pp, found := db.data.PoolProfiles[name]
+		if !found {
+			return nil, system.ErrPoolProfileNotFound(name)
+		}
+		out = append(out, copyPoolProfile(pp))
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+
+	return out, nil
+}
+
+// applyPoolProfileUpdate is responsible for applying the pool profile
+// update operation to the database.
+func (d *dbData) applyPoolProfileUpdate(op raftOp, data []byte, panicFn func(error)) {
+	pp := new(system.PoolProfile)
+	if err := json.Unmarshal(data, pp); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode pool profile update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	if d.PoolProfiles == nil {
+		d.PoolProfiles = make(PoolProfileMap)
+	}
+
+	switch op {
+	case raftOpUpdatePoolProfile:
+		d.PoolProfiles[pp.Name] = pp
+	case raftOpRemovePoolProfile:
+		delete(d.PoolProfiles, pp.Name)
+	default:
+		panicFn(errors.Errorf("unhandled Pool Profile Apply operation: %d", op))
+		return
+	}
+}
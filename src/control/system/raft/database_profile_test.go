@@ -0,0 +1,182 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockPoolProfile(name string) *system.PoolProfile {
+	return &system.PoolProfile{
+		Name:       name,
+		Properties: map[string]string{"reclaim": "lazy"},
+		ACL:        []string{"A::OWNER@:rw"},
+		TierRatio:  []float64{0.1, 0.9},
+	}
+}
+
+func TestSystem_Database_PoolProfiles(t *testing.T) {
+	for name, tc := range map[string]struct {
+		existing  []string
+		notLeader bool
+		add       *system.PoolProfile
+		replace   bool
+		remove    string
+		get       []string
+		expNames  []string
+		expErr    error
+	}{
+		"not leader": {
+			notLeader: true,
+			expErr:    errors.New("leader"),
+		},
+		"no profiles": {
+			expNames: []string{},
+		},
+		"add invalid": {
+			add:    &system.PoolProfile{},
+			expErr: errors.New("name must not be empty"),
+		},
+		"add": {
+			existing: []string{"b"},
+			add:      mockPoolProfile("a"),
+			expNames: []string{"a", "b"},
+		},
+		"add existing": {
+			existing: []string{"a"},
+			add:      mockPoolProfile("a"),
+			expErr:   errors.New("already exists"),
+		},
+		"replace existing": {
+			existing: []string{"a"},
+			add:      mockPoolProfile("a"),
+			replace:  true,
+			expNames: []string{"a"},
+		},
+		"remove": {
+			existing: []string{"a", "b", "c"},
+			remove:   "b",
+			expNames: []string{"a", "c"},
+		},
+		"remove missing": {
+			existing: []string{"a"},
+			remove:   "b",
+			expErr:   errors.New("unable to find pool profile"),
+		},
+		"get by name": {
+			existing: []string{"a", "b", "c"},
+			get:      []string{"c", "a"},
+			expNames: []string{"a", "c"},
+		},
+		"get missing": {
+			existing: []string{"a"},
+			get:      []string{"b"},
+			expErr:   errors.New("unable to find pool profile"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := MockDatabase(t, log)
+			for _, name := range tc.existing {
+				if err := db.AddPoolProfile(mockPoolProfile(name), false); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if tc.notLeader {
+				db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+					State: raft.Follower,
+				}, (*fsm)(db)))
+			}
+
+			if tc.add != nil || tc.remove != "" {
+				var gotErr error
+				if tc.add != nil {
+					gotErr = db.AddPoolProfile(tc.add, tc.replace)
+				} else {
+					gotErr = db.RemovePoolProfile(tc.remove)
+				}
+				test.CmpErr(t, tc.expErr, gotErr)
+				if tc.expErr != nil {
+					return
+				}
+			}
+
+			profiles, gotErr := db.PoolProfiles(tc.get...)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			gotNames := []string{}
+			for _, pp := range profiles {
+				gotNames = append(gotNames, pp.Name)
+			}
+			if diff := cmp.Diff(tc.expNames, gotNames); diff != "" {
+				t.Fatalf("unexpected profiles (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_PoolProfiles_Copy(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	if err := db.AddPoolProfile(mockPoolProfile("a"), false); err != nil {
+		t.Fatal(err)
+	}
+
+	profiles, err := db.PoolProfiles("a")
+	if err != nil {
+		t.Fatal(err)
+	}
+	profiles[0].Properties["reclaim"] = "disabled"
+	profiles[0].ACL[0] = "A::EVERYONE@:rw"
+
+	if diff := cmp.Diff(mockPoolProfile("a"), db.data.PoolProfiles["a"]); diff != "" {
+		t.Fatalf("stored profile modified via returned copy (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestSystem_Database_PoolProfiles_Snapshot(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	db := MockDatabase(t, log)
+	for _, name := range []string{"a", "b"} {
+		if err := db.AddPoolProfile(mockPoolProfile(name), false); err != nil {
+			t.Fatal(err)
+		}
+	}
+	snap, err := (*fsm)(db).Snapshot()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restored := MockDatabase(t, log)
+	rc := ioutil.NopCloser(bytes.NewReader(snap.(*fsmSnapshot).data))
+	if err := (*fsm)(restored).Restore(rc); err != nil {
+		t.Fatal(err)
+	}
+
+	if diff := cmp.Diff(db.data.PoolProfiles, restored.data.PoolProfiles); diff != "" {
+		t.Fatalf("unexpected pool profiles after restore (-want, +got):\n%s\n", diff)
+	}
+}
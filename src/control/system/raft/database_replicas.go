@@ -0,0 +1,405 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/build"
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+// replicasFile holds the most recent replica set known to this node. It
+// is written whenever the replica set changes at runtime and overrides the
+// access points in the server configuration on subsequent starts.
+const replicasFile = "replicas.json"
+
+// ReplicasFilePath returns the path to the persisted replica set.
+func (cfg *DatabaseConfig) ReplicasFilePath() string {
+	return filepath.Join(cfg.RaftDir, replicasFile)
+}
+
+// LoadReplicas replaces the configured replicas with the persisted replica
+// set, if one exists. Returns true if the replicas were updated.
+func (cfg *DatabaseConfig) LoadReplicas() (bool, error) {
+	if cfg.RaftDir == "" {
+		return false, nil
+	}
+
+	data, err := os.ReadFile(cfg.ReplicasFilePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "failed to read %s", cfg.ReplicasFilePath())
+	}
+
+	var strReps []string
+	if err := json.Unmarshal(data, &strReps); err != nil {
+		return false, errors.Wrapf(err, "failed to decode %s", cfg.ReplicasFilePath())
+	}
+
+	replicas, err := resolveReplicas(strReps)
+	if err != nil {
+		return false, err
+	}
+	if len(replicas) == 0 {
+		return false, nil
+	}
+	cfg.Replicas = replicas
+
+	return true, nil
+}
+
+// saveReplicas persists the configured replicas.
+func (cfg *DatabaseConfig) saveReplicas() error {
+	if cfg.RaftDir == "" {
+		return nil
+	}
+
+	if err := createRaftDir(cfg.RaftDir); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(cfg.stringReplicas())
+	if err != nil {
+		return err
+	}
+
+	tmpPath := cfg.ReplicasFilePath() + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0600); err != nil {
+		return errors.Wrapf(err, "failed to write %s", tmpPath)
+	}
+
+	return errors.Wrapf(os.Rename(tmpPath, cfg.ReplicasFilePath()),
+		"failed to update %s", cfg.ReplicasFilePath())
+}
+
+func resolveReplicas(strReps []string) ([]*net.TCPAddr, error) {
+	replicas := make([]*net.TCPAddr, 0, len(strReps))
+	for _, rep := range strReps {
+		addr, err := net.ResolveTCPAddr("tcp", rep)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid replica address %q", rep)
+		}
+		replicas = append(replicas, addr)
+	}
+
+	return replicas, nil
+}
+
+func containsAddr(addrs []*net.TCPAddr, addr *net.TCPAddr) bool {
+	for _, a := range addrs {
+		if common.CmpTCPAddr(a, addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// setReplicas updates and persists the set of replica addresses known
+// to this node, and updates the local replica address to match.
+func (db *Database) setReplicas(replicas []*net.TCPAddr) error {
+	db.replicaLock.Lock()
+	defer db.replicaLock.Unlock()
+
+	db.cfg.Replicas = replicas
+	if db.replicaAddr != nil && !containsAddr(replicas, db.replicaAddr) {
+		db.replicaAddr = nil
+	}
+	if db.replicaAddr == nil {
+		db.replicaAddr, _ = db.cfg.LocalReplicaAddr()
+	}
+
+	return db.cfg.saveReplicas()
+}
+
+// LoadReplicas updates the database replicas with the persisted replica set,
+// if one is available. It should be called once the storage hosting the raft
+// directory is available.
+func (db *Database) LoadReplicas() error {
+	db.replicaLock.Lock()
+	defer db.replicaLock.Unlock()
+
+	loaded, err := db.cfg.LoadReplicas()
+	if err != nil || !loaded {
+		return err
+	}
+
+	db.replicaAddr, _ = db.cfg.LocalReplicaAddr()
+	db.log.Debugf("loaded persisted %s replicas: %v", build.ManagementServiceName, db.cfg.stringReplicas())
+
+	return nil
+}
+
+// ReplicaAddrs returns the addresses of the current replicas.
+func (db *Database) ReplicaAddrs() []string {
+	return db.stringReplicas()
+}
+
+// SetReplicas is called on a control plane instance to notify it of a change
+// in the replica set. If the instance has become a replica, the local replica
+// is started. If it is no longer a replica, the local replica is stopped.
+func (db *Database) SetReplicas(ctx context.Context, strReps []string) error {
+	replicas, err := resolveReplicas(strReps)
+	if err != nil {
+		return err
+	}
+	if len(replicas) == 0 {
+		return errors.New("replica set must not be empty")
+	}
+
+	if err := db.setReplicas(replicas); err != nil {
+		return err
+	}
+
+	switch {
+	case db.IsReplica() && db.initialized.IsFalse():
+		db.log.Noticef("starting %s replica on %s", build.ManagementServiceName, db.getReplicaAddr())
+		return db.Start(ctx)
+	case !db.IsReplica() && db.initialized.IsTrue():
+		db.log.Noticef("stopping local %s replica", build.ManagementServiceName)
+		return db.stopReplica()
+	}
+
+	return nil
+}
+
+// stopReplica stops the local raft instance after it has been removed from
+// the replica set, leaving the database ready to be started again if the
+// instance is added back to the replica set later.
+func (db *Database) stopReplica() error {
+	err := db.Stop()
+
+	db.initialized.SetFalse()
+	db.raft.setSvc(nil)
+	db.shutdownCb = nil
+	db.shutdownErrCh = make(chan error)
+	// The only callbacks registered here are created by initRaft().
+	db.onRaftShutdown = nil
+
+	return err
+}
+
+// AddReplica adds the control plane instance at the supplied address as a
+// new voting replica and replicates the updated replica set.
+func (db *Database) AddReplica(addr *net.TCPAddr) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	if db.isReplica(addr) {
+		return errors.Errorf("%s is already a %s replica", addr, build.ManagementServiceName)
+	}
+	if _, err := db.FindMembersByAddr(addr); err != nil {
+		return errors.Wrapf(err, "%s replica must be a system member", build.ManagementServiceName)
+	}
+
+	rsi := raft.ServerID(addr.String())
+	rsa := raft.ServerAddress(addr.String())
+	db.log.Debugf("adding %s as a new raft voter", addr)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		return svc.AddVoter(rsi, rsa, 0, 0).Error()
+	}); err != nil {
+		return errors.Wrapf(err, "failed to add %q as raft replica", addr)
+	}
+
+	if err := db.submitReplicasUpdate(append(db.stringReplicas(), addr.String())); err != nil {
+		// Don't leave a voter behind that is missing from the
+		// replicated replica set.
+		db.log.Debugf("removing %s as a raft voter after failed replica set update", addr)
+		if rbErr := db.raft.withReadLock(func(svc raftService) error {
+			return svc.RemoveServer(rsi, 0, 0).Error()
+		}); rbErr != nil {
+			db.log.Errorf("failed to remove %q as raft replica: %s", addr, rbErr)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// RemoveReplica removes the control plane instance at the supplied address
+// from the set of voting replicas and replicates the updated replica set.
+// The removal is refused if it would leave the remaining replicas unable to
+// form a quorum.
+func (db *Database) RemoveReplica(addr *net.TCPAddr) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+	db.Lock()
+	defer db.Unlock()
+
+	if !db.isReplica(addr) {
+		return errors.Errorf("%s is not a %s replica", addr, build.ManagementServiceName)
+	}
+	if common.CmpTCPAddr(addr, db.getReplicaAddr()) {
+		return errors.Errorf("%s is the current %s leader; transfer leadership first",
+			addr, build.ManagementServiceName)
+	}
+	if err := db.checkRemovalQuorum(addr); err != nil {
+		return err
+	}
+
+	db.log.Debugf("removing %s as a raft voter", addr)
+	if err := db.raft.withReadLock(func(svc raftService) error {
+		return svc.RemoveServer(raft.ServerID(addr.String()), 0, 0).Error()
+	}); err != nil {
+		return errors.Wrapf(err, "failed to remove %q as raft replica", addr)
+	}
+
+	return db.submitReplicasUpdate(db.stringReplicas(addr))
+}
+
+// TransferLeadership transfers raft leadership from this replica to the
+// replica at the supplied address. If no address is supplied, the raft
+// implementation selects the most up-to-date peer.
+func (db *Database) TransferLeadership(addr *net.TCPAddr) error {
+	if err := db.CheckLeader(); err != nil {
+		return err
+	}
+
+	peers, err := db.PeerAddrs()
+	if err != nil {
+		return err
+	}
+	if len(peers) == 0 {
+		return errors.Errorf("no other %s replicas to transfer leadership to",
+			build.ManagementServiceName)
+	}
+	if addr != nil {
+		if common.CmpTCPAddr(addr, db.getReplicaAddr()) {
+			return errors.Errorf("%s is already the %s leader", addr, build.ManagementServiceName)
+		}
+		if !db.isReplica(addr) {
+			return errors.Errorf("%s is not a %s replica", addr, build.ManagementServiceName)
+		}
+	}
+
+	return db.raft.withReadLock(func(svc raftService) error {
+		if addr == nil {
+			db.log.Debug("transferring raft leadership")
+			return svc.LeadershipTransfer().Error()
+		}
+
+		db.log.Debugf("transferring raft leadership to %s", addr)
+		return svc.LeadershipTransferToServer(raft.ServerID(addr.String()),
+			raft.ServerAddress(addr.String())).Error()
+	})
+}
+
+// checkRemovalQuorum verifies that after removing the replica at the supplied
+// address, a majority of the remaining replicas are available.
+func (db *Database) checkRemovalQuorum(addr *net.TCPAddr) error {
+	db.replicaLock.RLock()
+	var remaining []*net.TCPAddr
+	for _, rep := range db.cfg.Replicas {
+		if !common.CmpTCPAddr(rep, addr) {
+			remaining = append(remaining, rep)
+		}
+	}
+	db.replicaLock.RUnlock()
+
+	if len(remaining) == 0 {
+		return errors.Errorf("cannot remove the last %s replica", build.ManagementServiceName)
+	}
+
+	healthy := 0
+	for _, rep := range remaining {
+		if db.isHealthyReplica(rep) {
+			healthy++
+		}
+	}
+
+	if healthy <= len(remaining)/2 {
+		return errors.Errorf("removing %s would leave %d/%d available replicas; quorum requires %d",
+			addr, healthy, len(remaining), len(remaining)/2+1)
+	}
+
+	return nil
+}
+
+// isHealthyReplica returns true if the supplied replica address is the local
+// replica or hosts at least one joined system member.
+func (db *Database) isHealthyReplica(addr *net.TCPAddr) bool {
+	if common.CmpTCPAddr(addr, db.getReplicaAddr()) {
+		return true
+	}
+
+	members, err := db.FindMembersByAddr(addr)
+	if err != nil {
+		return false
+	}
+	for _, m := range members {
+		if m.State == system.MemberStateJoined {
+			return true
+		}
+	}
+
+	return false
+}
+
+// submitReplicasUpdate submits the given replica set to the raft service.
+func (db *Database) submitReplicasUpdate(replicas []string) error {
+	data, err := createRaftUpdate(raftOpUpdateReplicas, replicas)
+	if err != nil {
+		return err
+	}
+	db.log.Debugf("%s replicas updated: %v", build.ManagementServiceName, replicas)
+	return db.submitRaftUpdate(data)
+}
+
+// applyReplicasUpdate is responsible for applying the replica set update
+// operation to the database.
+func (d *dbData) applyReplicasUpdate(op raftOp, data []byte, panicFn func(error)) {
+	var replicas []string
+	if err := json.Unmarshal(data, &replicas); err != nil {
+		panicFn(errors.Wrap(err, "failed to decode replicas update"))
+		return
+	}
+
+	d.Lock()
+	defer d.Unlock()
+
+	switch op {
+	case raftOpUpdateReplicas:
+		d.Replicas = replicas
+	default:
+		panicFn(errors.Errorf("unhandled Replicas Apply operation: %d", op))
+		return
+	}
+}
+
+// syncReplicas updates the replicas known to this node to match the
+// replicated replica set.
+func (db *Database) syncReplicas() {
+	db.data.RLock()
+	strReps := db.data.Replicas
+	db.data.RUnlock()
+
+	if len(strReps) == 0 {
+		return
+	}
+
+	replicas, err := resolveReplicas(strReps)
+	if err == nil {
+		err = db.setReplicas(replicas)
+	}
+	if err != nil {
+		db.log.Errorf("failed to update local replicas: %s", err)
+	}
+}
@@ -0,0 +1,346 @@
+//
+// (C) Copyright 2023 Intel Corporation.
+//
+// SPDX-License-Identifier: BSD-2-Clause-Patent
+//
+
+package raft
+
+import (
+	"net"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/raft"
+	"github.com/pkg/errors"
+
+	"github.com/daos-stack/daos/src/control/common"
+	"github.com/daos-stack/daos/src/control/common/test"
+	"github.com/daos-stack/daos/src/control/logging"
+	"github.com/daos-stack/daos/src/control/system"
+)
+
+func mockReplicaDatabase(t *testing.T, log logging.Logger, members []*system.Member, replicas ...*net.TCPAddr) *Database {
+	t.Helper()
+
+	db := MockDatabaseWithCfg(t, log, &DatabaseConfig{
+		Replicas: append([]*net.TCPAddr{common.LocalhostCtrlAddr()}, replicas...),
+	})
+	for _, m := range members {
+		if err := db.AddMember(m); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	return db
+}
+
+func TestSystem_Database_AddReplica(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+	m2 := system.MockMember(t, 2, system.MemberStateJoined)
+	m3 := system.MockMember(t, 3, system.MemberStateJoined)
+
+	for name, tc := range map[string]struct {
+		replicas    []*net.TCPAddr
+		members     []*system.Member
+		addr        *net.TCPAddr
+		applyErr    error
+		expErr      error
+		expRemoved  []raft.ServerID
+		expReplicas []string
+	}{
+		"already a replica": {
+			replicas: []*net.TCPAddr{m2.Addr},
+			members:  []*system.Member{m2},
+			addr:     m2.Addr,
+			expErr:   errors.New("already a"),
+		},
+		"replica set update fails": {
+			replicas:    []*net.TCPAddr{m2.Addr},
+			members:     []*system.Member{m2, m3},
+			addr:        m3.Addr,
+			applyErr:    errors.New("apply failed"),
+			expErr:      errors.New("apply failed"),
+			expRemoved:  []raft.ServerID{raft.ServerID(m3.Addr.String())},
+			expReplicas: []string{local.String(), m2.Addr.String()},
+		},
+		"not a member": {
+			members: []*system.Member{m2},
+			addr:    m3.Addr,
+			expErr:  errors.New("must be a system member"),
+		},
+		"success": {
+			replicas:    []*net.TCPAddr{m2.Addr},
+			members:     []*system.Member{m2, m3},
+			addr:        m3.Addr,
+			expReplicas: []string{local.String(), m2.Addr.String(), m3.Addr.String()},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := mockReplicaDatabase(t, log, tc.members, tc.replicas...)
+			mrs := db.raft.svc.(*mockRaftService)
+			mrs.cfg.ApplyErr = tc.applyErr
+
+			gotErr := db.AddReplica(tc.addr)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if diff := cmp.Diff(tc.expRemoved, mrs.removed); diff != "" {
+				t.Fatalf("unexpected removed voters (-want, +got):\n%s\n", diff)
+			}
+			if tc.expErr != nil {
+				if tc.expReplicas == nil {
+					return
+				}
+				if diff := cmp.Diff(tc.expReplicas, db.ReplicaAddrs()); diff != "" {
+					t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+				}
+				return
+			}
+
+			if diff := cmp.Diff(tc.expReplicas, db.ReplicaAddrs()); diff != "" {
+				t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expReplicas, db.data.Replicas); diff != "" {
+				t.Fatalf("unexpected replicated replicas (-want, +got):\n%s\n", diff)
+			}
+			if !db.IsLeader() {
+				t.Fatal("expected leader to remain a replica")
+			}
+		})
+	}
+}
+
+func TestSystem_Database_RemoveReplica(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+	joined2 := system.MockMember(t, 2, system.MemberStateJoined)
+	joined3 := system.MockMember(t, 3, system.MemberStateJoined)
+	stopped3 := system.MockMember(t, 3, system.MemberStateStopped)
+	joined4 := system.MockMember(t, 4, system.MemberStateJoined)
+
+	for name, tc := range map[string]struct {
+		replicas    []*net.TCPAddr
+		members     []*system.Member
+		addr        *net.TCPAddr
+		expErr      error
+		expReplicas []string
+	}{
+		"not a replica": {
+			replicas: []*net.TCPAddr{joined2.Addr},
+			members:  []*system.Member{joined2, joined4},
+			addr:     joined4.Addr,
+			expErr:   errors.New("not a"),
+		},
+		"leader": {
+			replicas: []*net.TCPAddr{joined2.Addr},
+			members:  []*system.Member{joined2},
+			addr:     local,
+			expErr:   errors.New("transfer leadership"),
+		},
+		"quorum would be lost": {
+			replicas: []*net.TCPAddr{joined2.Addr, stopped3.Addr},
+			members:  []*system.Member{joined2, stopped3},
+			addr:     joined2.Addr,
+			expErr:   errors.New("quorum requires 2"),
+		},
+		"unavailable replica removed": {
+			replicas:    []*net.TCPAddr{joined2.Addr, stopped3.Addr},
+			members:     []*system.Member{joined2, stopped3},
+			addr:        stopped3.Addr,
+			expReplicas: []string{local.String(), joined2.Addr.String()},
+		},
+		"healthy replica removed": {
+			replicas:    []*net.TCPAddr{joined2.Addr, joined3.Addr},
+			members:     []*system.Member{joined2, joined3},
+			addr:        joined3.Addr,
+			expReplicas: []string{local.String(), joined2.Addr.String()},
+		},
+		"down to single replica": {
+			replicas:    []*net.TCPAddr{joined2.Addr},
+			members:     []*system.Member{joined2},
+			addr:        joined2.Addr,
+			expReplicas: []string{local.String()},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := mockReplicaDatabase(t, log, tc.members, tc.replicas...)
+
+			gotErr := db.RemoveReplica(tc.addr)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tc.expReplicas, db.ReplicaAddrs()); diff != "" {
+				t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+			}
+			if diff := cmp.Diff(tc.expReplicas, db.data.Replicas); diff != "" {
+				t.Fatalf("unexpected replicated replicas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
+
+func TestSystem_Database_TransferLeadership(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+	m2 := system.MockMember(t, 2, system.MemberStateJoined)
+	m3 := system.MockMember(t, 3, system.MemberStateJoined)
+
+	for name, tc := range map[string]struct {
+		replicas    []*net.TCPAddr
+		addr        *net.TCPAddr
+		transferErr error
+		expErr      error
+	}{
+		"single replica": {
+			expErr: errors.New("no other"),
+		},
+		"already leader": {
+			replicas: []*net.TCPAddr{m2.Addr},
+			addr:     local,
+			expErr:   errors.New("already the"),
+		},
+		"not a replica": {
+			replicas: []*net.TCPAddr{m2.Addr},
+			addr:     m3.Addr,
+			expErr:   errors.New("not a"),
+		},
+		"transfer fails": {
+			replicas:    []*net.TCPAddr{m2.Addr},
+			transferErr: errors.New("transfer failed"),
+			expErr:      errors.New("transfer failed"),
+		},
+		"any replica": {
+			replicas: []*net.TCPAddr{m2.Addr, m3.Addr},
+		},
+		"named replica": {
+			replicas: []*net.TCPAddr{m2.Addr, m3.Addr},
+			addr:     m3.Addr,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			db := mockReplicaDatabase(t, log, nil, tc.replicas...)
+			db.raft.setSvc(newMockRaftService(&mockRaftServiceConfig{
+				LeadershipTransferErr: tc.transferErr,
+				State:                 raft.Leader,
+			}, (*fsm)(db)))
+
+			gotErr := db.TransferLeadership(tc.addr)
+			test.CmpErr(t, tc.expErr, gotErr)
+			if tc.expErr != nil {
+				test.AssertTrue(t, db.IsLeader(), "leadership unexpectedly lost")
+				return
+			}
+
+			test.AssertFalse(t, db.IsLeader(), "leadership not transferred")
+		})
+	}
+}
+
+func TestSystem_Database_PersistReplicas(t *testing.T) {
+	log, buf := logging.NewTestLogger(t.Name())
+	defer test.ShowBufferOnFailure(t, buf)
+
+	testDir, cleanup := test.CreateTestDir(t)
+	defer cleanup()
+
+	local := common.LocalhostCtrlAddr()
+	member := system.MockMember(t, 2, system.MemberStateJoined)
+
+	db := MockDatabaseWithCfg(t, log, &DatabaseConfig{
+		Replicas: []*net.TCPAddr{local},
+		RaftDir:  filepath.Join(testDir, "raft"),
+	})
+	if err := db.AddMember(member); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddReplica(member.Addr); err != nil {
+		t.Fatal(err)
+	}
+
+	// A restarted instance configured with the original access points
+	// should pick up the persisted replica set.
+	cfg := &DatabaseConfig{
+		Replicas: []*net.TCPAddr{local},
+		RaftDir:  filepath.Join(testDir, "raft"),
+	}
+	loaded, err := cfg.LoadReplicas()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertTrue(t, loaded, "expected persisted replicas to be loaded")
+
+	expReplicas := []string{local.String(), member.Addr.String()}
+	if diff := cmp.Diff(expReplicas, cfg.stringReplicas()); diff != "" {
+		t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+	}
+
+	// No persisted replicas; configured replicas are retained.
+	emptyCfg := &DatabaseConfig{
+		Replicas: []*net.TCPAddr{local},
+		RaftDir:  filepath.Join(testDir, "missing"),
+	}
+	loaded, err = emptyCfg.LoadReplicas()
+	if err != nil {
+		t.Fatal(err)
+	}
+	test.AssertFalse(t, loaded, "expected no persisted replicas")
+	if diff := cmp.Diff([]string{local.String()}, emptyCfg.stringReplicas()); diff != "" {
+		t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+	}
+}
+
+func TestSystem_fsm_Restore_Replicas(t *testing.T) {
+	local := common.LocalhostCtrlAddr()
+	m2 := system.MockMember(t, 2, system.MemberStateJoined)
+	m3 := system.MockMember(t, 3, system.MemberStateJoined)
+
+	for name, tc := range map[string]struct {
+		initialized bool
+		expReplicas []string
+	}{
+		"startup restore keeps local replicas": {
+			expReplicas: []string{local.String(), m2.Addr.String()},
+		},
+		"installed snapshot updates local replicas": {
+			initialized: true,
+			expReplicas: []string{local.String(), m3.Addr.String()},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			log, buf := logging.NewTestLogger(t.Name())
+			defer test.ShowBufferOnFailure(t, buf)
+
+			src := mockReplicaDatabase(t, log, nil, m3.Addr)
+			src.data.Replicas = src.stringReplicas()
+			snap, err := (*fsm)(src).Snapshot()
+			if err != nil {
+				t.Fatal(err)
+			}
+			sink := &testSnapshotSink{}
+			if err := snap.Persist(sink); err != nil {
+				t.Fatal(err)
+			}
+
+			db := mockReplicaDatabase(t, log, nil, m2.Addr)
+			if !tc.initialized {
+				db.initialized.SetFalse()
+			}
+			if err := (*fsm)(db).Restore(sink.Reader()); err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tc.expReplicas, db.ReplicaAddrs()); diff != "" {
+				t.Fatalf("unexpected replicas (-want, +got):\n%s\n", diff)
+			}
+		})
+	}
+}
@@ -339,7 +339,8 @@ func TestSystem_Database_SnapshotRestoreBadVersion(t *testing.T) {
 	db1, cleanup1 := TestDatabase(t, log)
 	defer cleanup1()
 
-	wantErr := errors.Errorf("%d != %d", db0.data.SchemaVersion, CurrentSchemaVersion)
+	wantErr := errors.Errorf("restored schema version %d is newer than supported version %d",
+		db0.data.SchemaVersion, CurrentSchemaVersion)
 	gotErr := (*fsm)(db1).Restore(sink.Reader())
 	test.CmpErr(t, wantErr, gotErr)
 }
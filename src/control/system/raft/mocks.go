@@ -7,6 +7,9 @@
 package raft
 
 import (
+	"bytes"
+	"io"
+	"io/ioutil"
 	"net"
 	"testing"
 	"time"
@@ -26,15 +29,22 @@ type (
 		index    uint64
 		response interface{}
 	}
+	mockSnapshotFuture struct {
+		err  error
+		meta *raft.SnapshotMeta
+		data []byte
+	}
 	mockRaftServiceConfig struct {
 		LeaderCh              <-chan bool
 		ServerAddress         raft.ServerAddress
 		State                 raft.RaftState
 		LeadershipTransferErr error
+		ApplyErr              error
 	}
 	mockRaftService struct {
-		cfg mockRaftServiceConfig
-		fsm raft.FSM
+		cfg     mockRaftServiceConfig
+		fsm     raft.FSM
+		removed []raft.ServerID
 	}
 )
 
@@ -43,7 +53,19 @@ func (mrf *mockRaftFuture) Error() error          { return mrf.err }
 func (mrf *mockRaftFuture) Index() uint64         { return mrf.index }
 func (mrf *mockRaftFuture) Response() interface{} { return mrf.response }
 
+// mockSnapshotFuture implements raft.SnapshotFuture
+func (msf *mockSnapshotFuture) Error() error { return msf.err }
+func (msf *mockSnapshotFuture) Open() (*raft.SnapshotMeta, io.ReadCloser, error) {
+	if msf.err != nil {
+		return nil, nil, msf.err
+	}
+	return msf.meta, ioutil.NopCloser(bytes.NewReader(msf.data)), nil
+}
+
 func (mrs *mockRaftService) Apply(cmd []byte, timeout time.Duration) raft.ApplyFuture {
+	if mrs.cfg.ApplyErr != nil {
+		return &mockRaftFuture{err: mrs.cfg.ApplyErr}
+	}
 	mrs.fsm.Apply(&raft.Log{Data: cmd})
 	return &mockRaftFuture{}
 }
@@ -52,7 +74,8 @@ func (mr *mockRaftService) AddVoter(_ raft.ServerID, _ raft.ServerAddress, _ uin
 	return &mockRaftFuture{}
 }
 
-func (mr *mockRaftService) RemoveServer(_ raft.ServerID, _ uint64, _ time.Duration) raft.IndexFuture {
+func (mr *mockRaftService) RemoveServer(id raft.ServerID, _ uint64, _ time.Duration) raft.IndexFuture {
+	mr.removed = append(mr.removed, id)
 	return &mockRaftFuture{}
 }
 
@@ -75,6 +98,10 @@ func (mrs *mockRaftService) LeadershipTransfer() raft.Future {
 	return &mockRaftFuture{err: mrs.cfg.LeadershipTransferErr}
 }
 
+func (mrs *mockRaftService) LeadershipTransferToServer(_ raft.ServerID, _ raft.ServerAddress) raft.Future {
+	return mrs.LeadershipTransfer()
+}
+
 func (mrs *mockRaftService) Shutdown() raft.Future {
 	mrs.cfg.State = raft.Shutdown
 	return &mockRaftFuture{}
@@ -84,10 +111,39 @@ func (mrs *mockRaftService) State() raft.RaftState {
 	return mrs.cfg.State
 }
 
+func (mrs *mockRaftService) Stats() map[string]string {
+	return map[string]string{
+		"state":          mrs.cfg.State.String(),
+		"term":           "1",
+		"commit_index":   "1",
+		"applied_index":  "1",
+		"last_log_index": "1",
+	}
+}
+
 func (mrs *mockRaftService) Barrier(time.Duration) raft.Future {
 	return &mockRaftFuture{}
 }
 
+func (mrs *mockRaftService) Snapshot() raft.SnapshotFuture {
+	snap, err := mrs.fsm.Snapshot()
+	if err != nil {
+		return &mockSnapshotFuture{err: err}
+	}
+	data := snap.(*fsmSnapshot).data
+
+	return &mockSnapshotFuture{
+		meta: &raft.SnapshotMeta{
+			Version: raft.SnapshotVersionMax,
+			ID:      "mock-snapshot",
+			Index:   1,
+			Term:    1,
+			Size:    int64(len(data)),
+		},
+		data: data,
+	}
+}
+
 func newMockRaftService(cfg *mockRaftServiceConfig, fsm raft.FSM) *mockRaftService {
 	if cfg == nil {
 		cfg = &mockRaftServiceConfig{
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2022-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -26,12 +26,13 @@ type (
 	// closure will only ever be called once, no
 	// matter how many times Release() is called.
 	PoolLock struct {
-		id       uuid.UUID
-		poolUUID uuid.UUID
-		takenAt  time.Time
-		refCount int32
-		relOnce  sync.Once
-		release  func()
+		id          uuid.UUID
+		poolUUID    uuid.UUID
+		takenAt     time.Time
+		contendedAt time.Time // first failed attempt to take the lock
+		refCount    int32
+		relOnce     sync.Once
+		release     func()
 	}
 
 	// poolLockMap is a map of pool UUIDs to pool locks.
@@ -42,8 +43,9 @@ type (
 	// intended to be local to the current MS leader.
 	poolLockMap struct {
 		sync.RWMutex
-		locks map[uuid.UUID]*PoolLock
-		log   logging.DebugLogger
+		locks   map[uuid.UUID]*PoolLock
+		log     logging.DebugLogger
+		metrics *dbMetrics
 	}
 
 	ctxKey string
@@ -154,6 +156,9 @@ func (plm *poolLockMap) take(poolUUID uuid.UUID) (*PoolLock, error) {
 	}
 
 	if lock, exists := plm.locks[poolUUID]; exists {
+		if lock.contendedAt.IsZero() {
+			lock.contendedAt = time.Now()
+		}
 		return nil, system.FaultPoolLocked(poolUUID, lock.id, lock.takenAt)
 	}
 
@@ -176,6 +181,15 @@ func (plm *poolLockMap) release(poolUUID uuid.UUID) {
 	defer plm.Unlock()
 
 	plm.log.Debugf("%s: lock released", dbgUuidStr(poolUUID))
+	if lock, exists := plm.locks[poolUUID]; exists && plm.metrics != nil {
+		// Pool locks are not blocking, so the wait time is measured
+		// from the first failed attempt to take the lock until it
+		// becomes available again.
+		if !lock.contendedAt.IsZero() {
+			plm.metrics.poolLockWait.Observe(time.Since(lock.contendedAt).Seconds())
+		}
+		plm.metrics.poolLockHold.Observe(time.Since(lock.takenAt).Seconds())
+	}
 	delete(plm.locks, poolUUID)
 }
 
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2020-2022 Intel Corporation.
+// (C) Copyright 2020-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -46,6 +46,11 @@ const (
 	raftOpRemovePoolService
 	raftOpIncMapVer
 	raftOpUpdateSystemAttrs
+	raftOpUpdateReplicas
+	raftOpMigrateSchema
+	raftOpAddAuditRecords
+	raftOpUpdatePoolProfile
+	raftOpRemovePoolProfile
 
 	sysDBFile = "daos_system.db"
 )
@@ -81,6 +86,11 @@ func (ro raftOp) String() string {
 		"removePoolService",
 		"incMapVer",
 		"updateSystemAttrs",
+		"updateReplicas",
+		"migrateSchema",
+		"addAuditRecords",
+		"updatePoolProfile",
+		"removePoolProfile",
 	}[ro]
 }
 
@@ -256,21 +266,22 @@ func ConfigureComponents(log logging.Logger, dbCfg *DatabaseConfig) (*RaftCompon
 }
 
 // ConfigureTransport configures the raft transport for the database.
+//
+// NB: The transport is registered even if the system is not currently
+// configured as a MS replica, so that a replica may be added at runtime.
+// The local address is set when the raft instance is initialized.
 func (db *Database) ConfigureTransport(srv *grpc.Server, dialOpts ...grpc.DialOption) error {
-	repAddr, err := db.cfg.LocalReplicaAddr()
-	if err != nil {
-		// no-op if the system is not configured as a MS replica.
-		if system.IsNotReplica(err) {
-			return nil
-		}
-		return err
+	var localAddr raft.ServerAddress
+	if repAddr := db.getReplicaAddr(); repAddr != nil {
+		localAddr = raft.ServerAddress(repAddr.String())
 	}
 
-	tm := transport.New(raft.ServerAddress(repAddr.String()), dialOpts)
+	tm := transport.New(localAddr, dialOpts)
 	tm.Register(srv)
 	db.raftTransport = &loggingTransport{
 		Transport: tm.Transport(),
 		log:       db.log,
+		localAddr: localAddr,
 	}
 
 	return nil
@@ -293,6 +304,15 @@ func (db *Database) initRaft() error {
 	if db.raftTransport == nil {
 		return errors.New("no raft transport configured")
 	}
+	if lt, ok := db.raftTransport.(*loggingTransport); ok {
+		lt.localAddr = db.serverAddress()
+	}
+
+	// The raft library reports replication activity via the global
+	// go-metrics sink, which is used to track follower contact.
+	if err := installContactSink(); err != nil {
+		return errors.Wrap(err, "failed to configure raft metrics")
+	}
 
 	// Rank 0 is reserved for the first instance on the bootstrap server.
 	// NB: This is a bit of a hack. It would be better to persist this
@@ -367,7 +387,17 @@ func (db *Database) bootstrapRaft(newDB bool) error {
 // being developed.
 type loggingTransport struct {
 	raft.Transport
-	log logging.Logger
+	log       logging.Logger
+	localAddr raft.ServerAddress
+}
+
+// LocalAddr returns the local replica address, which may have been
+// assigned after the underlying transport was created.
+func (dt *loggingTransport) LocalAddr() raft.ServerAddress {
+	if dt.localAddr != "" {
+		return dt.localAddr
+	}
+	return dt.Transport.LocalAddr()
 }
 
 /*
@@ -400,7 +430,7 @@ func (dt *loggingTransport) TimeoutNow(id raft.ServerID, target raft.ServerAddre
 // serverAddress returns a raft.ServerAddress representation of
 // the db's replica address.
 func (db *Database) serverAddress() raft.ServerAddress {
-	return raft.ServerAddress(db.replicaAddr.String())
+	return raft.ServerAddress(db.getReplicaAddr().String())
 }
 
 // createRaftUpdate serializes the inner payload and then wraps
@@ -463,7 +493,11 @@ func (db *Database) submitSystemAttrsUpdate(props map[string]string) error {
 // submitRaftUpdate submits the serialized operation to the raft service.
 func (db *Database) submitRaftUpdate(data []byte) error {
 	return db.raft.withReadLock(func(svc raftService) error {
+		start := time.Now()
 		err := svc.Apply(data, 0).Error()
+		if err == nil {
+			db.metrics.applyDuration.Observe(time.Since(start).Seconds())
+		}
 
 		// In the case that leadership is lost while trying to
 		// apply an update, return a sentinel error that may
@@ -508,25 +542,47 @@ func (f *fsm) Apply(l *raft.Log) interface{} {
 		return nil
 	}
 
+	if !f.data.applyUpdate(c, f.EmergencyShutdown) {
+		return nil
+	}
+
+	if c.Op == raftOpUpdateReplicas {
+		(*Database)(f).syncReplicas()
+	}
+
+	return nil
+}
+
+// applyUpdate applies the supplied update to the database. Returns false if
+// the update operation is not recognized.
+func (d *dbData) applyUpdate(c *raftUpdate, panicFn func(error)) bool {
 	switch c.Op {
 	case raftOpIncMapVer:
-		f.data.applyMapVersionIncrement()
+		d.applyMapVersionIncrement()
 	case raftOpAddMember, raftOpUpdateMember, raftOpRemoveMember:
-		f.data.applyMemberUpdate(c.Op, c.Data, f.EmergencyShutdown)
+		d.applyMemberUpdate(c.Op, c.Data, panicFn)
 	case raftOpAddPoolService, raftOpUpdatePoolService, raftOpRemovePoolService:
-		f.data.applyPoolUpdate(c.Op, c.Data, f.EmergencyShutdown)
+		d.applyPoolUpdate(c.Op, c.Data, panicFn)
 	case raftOpUpdateSystemAttrs:
-		f.data.applySystemUpdate(c.Op, c.Data, f.EmergencyShutdown)
+		d.applySystemUpdate(c.Op, c.Data, panicFn)
+	case raftOpUpdateReplicas:
+		d.applyReplicasUpdate(c.Op, c.Data, panicFn)
+	case raftOpMigrateSchema:
+		d.applySchemaUpdate(c.Op, c.Data, panicFn)
+	case raftOpAddAuditRecords:
+		d.applyAuditUpdate(c.Op, c.Data, panicFn)
+	case raftOpUpdatePoolProfile, raftOpRemovePoolProfile:
+		d.applyPoolProfileUpdate(c.Op, c.Data, panicFn)
 	default:
-		f.EmergencyShutdown(errors.Errorf("unhandled Apply operation: %d", c.Op))
-		return nil
+		panicFn(errors.Errorf("unhandled Apply operation: %d", c.Op))
+		return false
 	}
 
-	f.data.Lock()
-	f.data.Version++ // Successful updates should increment this value.
-	f.data.Unlock()
+	d.Lock()
+	d.Version++ // Successful updates should increment this value.
+	d.Unlock()
 
-	return nil
+	return true
 }
 
 // applyMapVersionIncrement is responsible for incrementing the group map version.
@@ -628,6 +684,8 @@ func (d *dbData) applySystemUpdate(op raftOp, data []byte, panicFn func(error))
 // creates a point-in-time snapshot which can be used to restore the current state, or
 // to efficiently catch up a peer.
 func (f *fsm) Snapshot() (raft.FSMSnapshot, error) {
+	defer f.metrics.observeSnapshot(snapshotPhaseCreate, time.Now())
+
 	f.data.Lock()
 	defer f.data.Unlock()
 
@@ -637,19 +695,23 @@ func (f *fsm) Snapshot() (raft.FSMSnapshot, error) {
 	}
 
 	f.log.Debugf("created raft db snapshot (map version %d; data version %d)", f.data.MapVersion, f.data.Version)
-	return &fsmSnapshot{data}, nil
+	return &fsmSnapshot{data: data, metrics: f.metrics}, nil
 }
 
 // Restore is called to force the FSM to read in a snapshot, discarding any previous state.
 func (f *fsm) Restore(rc io.ReadCloser) error {
+	defer f.metrics.observeSnapshot(snapshotPhaseRestore, time.Now())
+
 	db, _ := NewDatabase(nil, nil)
 	if err := json.NewDecoder(rc).Decode(db.data); err != nil {
 		return err
 	}
 
-	if db.data.SchemaVersion != CurrentSchemaVersion {
-		return errors.Errorf("restored schema version %d != %d",
-			db.data.SchemaVersion, CurrentSchemaVersion)
+	// Older schemas are upgraded by the leader via the raft log, but
+	// a schema from a newer version of this software cannot be used.
+	if supported := f.data.migrations.version(); db.data.SchemaVersion > supported {
+		return errors.Errorf("restored schema version %d is newer than supported version %d",
+			db.data.SchemaVersion, supported)
 	}
 
 	f.data.Lock()
@@ -659,7 +721,19 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 	f.data.MapVersion = db.data.MapVersion
 	f.data.System = db.data.System
 	f.data.Version = db.data.Version
+	f.data.Replicas = db.data.Replicas
+	f.data.SchemaVersion = db.data.SchemaVersion
+	f.data.Audit = db.data.Audit
+	f.data.PoolProfiles = db.data.PoolProfiles
 	f.data.Unlock()
+
+	// The local snapshot that is restored when the replica starts may be
+	// older than the persisted replica set, which is updated whenever a
+	// replica set change is applied or received. Only a snapshot installed
+	// from the leader at runtime updates the persisted set.
+	if (*Database)(f).initialized.IsTrue() {
+		(*Database)(f).syncReplicas()
+	}
 	f.log.Debugf("db snapshot loaded (map version %d; data version %d)", db.data.MapVersion, db.data.Version)
 	return nil
 }
@@ -667,11 +741,14 @@ func (f *fsm) Restore(rc io.ReadCloser) error {
 // fsmSnapshot implements the raft.FSMSnapshot interface, and is used
 // to persist the snapshot to an io.WriteCloser.
 type fsmSnapshot struct {
-	data []byte
+	data    []byte
+	metrics *dbMetrics
 }
 
 // Persist writes the snapshot to the supplied raft.SnapshotSink.
 func (f *fsmSnapshot) Persist(sink raft.SnapshotSink) error {
+	defer f.metrics.observeSnapshot(snapshotPhasePersist, time.Now())
+
 	err := func() error {
 		if _, err := sink.Write(f.data); err != nil {
 			return err
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2022-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -474,11 +474,16 @@ func readSnapshotData(path string) ([]byte, error) {
 }
 
 // ReadSnapshotInfo reads the snapshot metadata and data from the given path.
+// If the snapshot is accompanied by a checksums file, as with database backups,
+// the snapshot files are verified against it.
 func ReadSnapshotInfo(path string) (*SnapshotDetails, error) {
 	details := &SnapshotDetails{
 		Path:     path,
 		Metadata: new(raft.SnapshotMeta),
 	}
+	if err := verifySnapshotChecksums(path); err != nil {
+		return nil, errors.Wrapf(err, "failed to verify snapshot in %s", path)
+	}
 	if err := readSnapshotMeta(path, details.Metadata); err != nil {
 		return nil, err
 	}
@@ -1,5 +1,5 @@
 //
-// (C) Copyright 2022 Intel Corporation.
+// (C) Copyright 2022-2023 Intel Corporation.
 //
 // SPDX-License-Identifier: BSD-2-Clause-Patent
 //
@@ -304,9 +304,12 @@ func Test_Raft_RestoreLocalReplica(t *testing.T) {
 				t.Fatal(err)
 			}
 
+			// The restored snapshot is re-encoded with the current
+			// database format, so its size differs from that of a
+			// snapshot written by an older release.
 			cmpOpts := []cmp.Option{
 				cmpopts.IgnoreFields(SnapshotDetails{}, "Path"),
-				cmpopts.IgnoreFields(raft.SnapshotMeta{}, "ID", "Index"),
+				cmpopts.IgnoreFields(raft.SnapshotMeta{}, "ID", "Index", "Size"),
 				cmp.Comparer(func(x, y RankSet) bool {
 					return x.String() == y.String()
 				}),
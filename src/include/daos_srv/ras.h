@@ -1,5 +1,5 @@
 /**
- * (C) Copyright 2020-2022 Intel Corporation.
+ * (C) Copyright 2020-2023 Intel Corporation.
  *
  * SPDX-License-Identifier: BSD-2-Clause-Patent
  */
@@ -53,7 +53,11 @@
 	X(RAS_SWIM_RANK_ALIVE,		"swim_rank_alive")				\
 	X(RAS_SWIM_RANK_DEAD,		"swim_rank_dead")				\
 	X(RAS_SYSTEM_START_FAILED,	"system_start_failed")				\
-	X(RAS_SYSTEM_STOP_FAILED,	"system_stop_failed")
+	X(RAS_SYSTEM_STOP_FAILED,	"system_stop_failed")				\
+	X(RAS_ENGINE_RESTART_ABANDONED,	"engine_restart_abandoned")			\
+	X(RAS_POOL_SPACE_WARNING,	"pool_space_warning")				\
+	X(RAS_POOL_SPACE_CRITICAL,	"pool_space_critical")				\
+	X(RAS_POOL_SPACE_CLEARED,	"pool_space_cleared")
 
 /** Define RAS event enum */
 typedef enum {
@@ -97,6 +97,51 @@ void   mgmt__pool_create_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_create_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
+void   mgmt__pool_create_plan_resp__init
+                     (Mgmt__PoolCreatePlanResp         *message)
+{
+  static const Mgmt__PoolCreatePlanResp init_value = MGMT__POOL_CREATE_PLAN_RESP__INIT;
+  *message = init_value;
+}
+size_t mgmt__pool_create_plan_resp__get_packed_size
+                     (const Mgmt__PoolCreatePlanResp *message)
+{
+  assert(message->base.descriptor == &mgmt__pool_create_plan_resp__descriptor);
+  return protobuf_c_message_get_packed_size ((const ProtobufCMessage*)(message));
+}
+size_t mgmt__pool_create_plan_resp__pack
+                     (const Mgmt__PoolCreatePlanResp *message,
+                      uint8_t       *out)
+{
+  assert(message->base.descriptor == &mgmt__pool_create_plan_resp__descriptor);
+  return protobuf_c_message_pack ((const ProtobufCMessage*)message, out);
+}
+size_t mgmt__pool_create_plan_resp__pack_to_buffer
+                     (const Mgmt__PoolCreatePlanResp *message,
+                      ProtobufCBuffer *buffer)
+{
+  assert(message->base.descriptor == &mgmt__pool_create_plan_resp__descriptor);
+  return protobuf_c_message_pack_to_buffer ((const ProtobufCMessage*)message, buffer);
+}
+Mgmt__PoolCreatePlanResp *
+       mgmt__pool_create_plan_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data)
+{
+  return (Mgmt__PoolCreatePlanResp *)
+     protobuf_c_message_unpack (&mgmt__pool_create_plan_resp__descriptor,
+                                allocator, len, data);
+}
+void   mgmt__pool_create_plan_resp__free_unpacked
+                     (Mgmt__PoolCreatePlanResp *message,
+                      ProtobufCAllocator *allocator)
+{
+  if(!message)
+    return;
+  assert(message->base.descriptor == &mgmt__pool_create_plan_resp__descriptor);
+  protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
+}
 void   mgmt__pool_destroy_req__init
                      (Mgmt__PoolDestroyReq         *message)
 {
@@ -1504,7 +1549,7 @@ void   mgmt__pool_query_target_resp__free_unpacked
   assert(message->base.descriptor == &mgmt__pool_query_target_resp__descriptor);
   protobuf_c_message_free_unpacked ((ProtobufCMessage*)message, allocator);
 }
-static const ProtobufCFieldDescriptor mgmt__pool_create_req__field_descriptors[14] =
+static const ProtobufCFieldDescriptor mgmt__pool_create_req__field_descriptors[16] =
 {
   {
     "uuid",
@@ -1674,6 +1719,30 @@ static const ProtobufCFieldDescriptor mgmt__pool_create_req__field_descriptors[1
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "tags",
+    15,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Mgmt__PoolCreateReq, n_tags),
+    offsetof(Mgmt__PoolCreateReq, tags),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "profile",
+    16,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_STRING,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolCreateReq, profile),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__pool_create_req__field_indices_by_name[] = {
   4,   /* field[4] = acl */
@@ -1681,9 +1750,11 @@ static const unsigned mgmt__pool_create_req__field_indices_by_name[] = {
   13,   /* field[13] = meta_blob_size */
   10,   /* field[10] = numranks */
   7,   /* field[7] = numsvcreps */
+  15,   /* field[15] = profile */
   5,   /* field[5] = properties */
   11,   /* field[11] = ranks */
   1,   /* field[1] = sys */
+  14,   /* field[14] = tags */
   12,   /* field[12] = tierbytes */
   9,   /* field[9] = tierratio */
   8,   /* field[8] = totalbytes */
@@ -1694,7 +1765,7 @@ static const unsigned mgmt__pool_create_req__field_indices_by_name[] = {
 static const ProtobufCIntRange mgmt__pool_create_req__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 14 }
+  { 0, 16 }
 };
 const ProtobufCMessageDescriptor mgmt__pool_create_req__descriptor =
 {
@@ -1704,7 +1775,7 @@ const ProtobufCMessageDescriptor mgmt__pool_create_req__descriptor =
   "Mgmt__PoolCreateReq",
   "mgmt",
   sizeof(Mgmt__PoolCreateReq),
-  14,
+  16,
   mgmt__pool_create_req__field_descriptors,
   mgmt__pool_create_req__field_indices_by_name,
   1,  mgmt__pool_create_req__number_ranges,
@@ -1814,6 +1885,96 @@ const ProtobufCMessageDescriptor mgmt__pool_create_resp__descriptor =
   (ProtobufCMessageInit) mgmt__pool_create_resp__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
+static const ProtobufCFieldDescriptor mgmt__pool_create_plan_resp__field_descriptors[5] =
+{
+  {
+    "tgt_ranks",
+    1,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT32,
+    offsetof(Mgmt__PoolCreatePlanResp, n_tgt_ranks),
+    offsetof(Mgmt__PoolCreatePlanResp, tgt_ranks),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "tier_bytes",
+    2,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_UINT64,
+    offsetof(Mgmt__PoolCreatePlanResp, n_tier_bytes),
+    offsetof(Mgmt__PoolCreatePlanResp, tier_bytes),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "meta_blob_size",
+    3,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolCreatePlanResp, meta_blob_size),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "tgts_per_rank",
+    4,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT32,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolCreatePlanResp, tgts_per_rank),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+  {
+    "fault_domains",
+    5,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Mgmt__PoolCreatePlanResp, n_fault_domains),
+    offsetof(Mgmt__PoolCreatePlanResp, fault_domains),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
+};
+static const unsigned mgmt__pool_create_plan_resp__field_indices_by_name[] = {
+  4,   /* field[4] = fault_domains */
+  2,   /* field[2] = meta_blob_size */
+  0,   /* field[0] = tgt_ranks */
+  3,   /* field[3] = tgts_per_rank */
+  1,   /* field[1] = tier_bytes */
+};
+static const ProtobufCIntRange mgmt__pool_create_plan_resp__number_ranges[1 + 1] =
+{
+  { 1, 0 },
+  { 0, 5 }
+};
+const ProtobufCMessageDescriptor mgmt__pool_create_plan_resp__descriptor =
+{
+  PROTOBUF_C__MESSAGE_DESCRIPTOR_MAGIC,
+  "mgmt.PoolCreatePlanResp",
+  "PoolCreatePlanResp",
+  "Mgmt__PoolCreatePlanResp",
+  "mgmt",
+  sizeof(Mgmt__PoolCreatePlanResp),
+  5,
+  mgmt__pool_create_plan_resp__field_descriptors,
+  mgmt__pool_create_plan_resp__field_indices_by_name,
+  1,  mgmt__pool_create_plan_resp__number_ranges,
+  (ProtobufCMessageInit) mgmt__pool_create_plan_resp__init,
+  NULL,NULL,NULL    /* reserved[123] */
+};
 static const ProtobufCFieldDescriptor mgmt__pool_destroy_req__field_descriptors[5] =
 {
   {
@@ -2698,7 +2859,7 @@ const ProtobufCMessageDescriptor mgmt__list_pools_req__descriptor =
   (ProtobufCMessageInit) mgmt__list_pools_req__init,
   NULL,NULL,NULL    /* reserved[123] */
 };
-static const ProtobufCFieldDescriptor mgmt__list_pools_resp__pool__field_descriptors[5] =
+static const ProtobufCFieldDescriptor mgmt__list_pools_resp__pool__field_descriptors[6] =
 {
   {
     "uuid",
@@ -2760,18 +2921,31 @@ static const ProtobufCFieldDescriptor mgmt__list_pools_resp__pool__field_descrip
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "tags",
+    6,
+    PROTOBUF_C_LABEL_REPEATED,
+    PROTOBUF_C_TYPE_STRING,
+    offsetof(Mgmt__ListPoolsResp__Pool, n_tags),
+    offsetof(Mgmt__ListPoolsResp__Pool, tags),
+    NULL,
+    &protobuf_c_empty_string,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__list_pools_resp__pool__field_indices_by_name[] = {
   1,   /* field[1] = label */
   4,   /* field[4] = rebuild_state */
   3,   /* field[3] = state */
   2,   /* field[2] = svc_reps */
+  5,   /* field[5] = tags */
   0,   /* field[0] = uuid */
 };
 static const ProtobufCIntRange mgmt__list_pools_resp__pool__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 5 }
+  { 0, 6 }
 };
 const ProtobufCMessageDescriptor mgmt__list_pools_resp__pool__descriptor =
 {
@@ -2781,7 +2955,7 @@ const ProtobufCMessageDescriptor mgmt__list_pools_resp__pool__descriptor =
   "Mgmt__ListPoolsResp__Pool",
   "mgmt",
   sizeof(Mgmt__ListPoolsResp__Pool),
-  5,
+  6,
   mgmt__list_pools_resp__pool__field_descriptors,
   mgmt__list_pools_resp__pool__field_indices_by_name,
   1,  mgmt__list_pools_resp__pool__number_ranges,
@@ -3228,7 +3402,7 @@ const ProtobufCEnumDescriptor mgmt__pool_rebuild_status__state__descriptor =
   mgmt__pool_rebuild_status__state__value_ranges,
   NULL,NULL,NULL,NULL   /* reserved[1234] */
 };
-static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descriptors[4] =
+static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descriptors[5] =
 {
   {
     "status",
@@ -3278,17 +3452,30 @@ static const ProtobufCFieldDescriptor mgmt__pool_rebuild_status__field_descripto
     0,             /* flags */
     0,NULL,NULL    /* reserved1,reserved2, etc */
   },
+  {
+    "total_objects",
+    5,
+    PROTOBUF_C_LABEL_NONE,
+    PROTOBUF_C_TYPE_UINT64,
+    0,   /* quantifier_offset */
+    offsetof(Mgmt__PoolRebuildStatus, total_objects),
+    NULL,
+    NULL,
+    0,             /* flags */
+    0,NULL,NULL    /* reserved1,reserved2, etc */
+  },
 };
 static const unsigned mgmt__pool_rebuild_status__field_indices_by_name[] = {
   2,   /* field[2] = objects */
   3,   /* field[3] = records */
   1,   /* field[1] = state */
   0,   /* field[0] = status */
+  4,   /* field[4] = total_objects */
 };
 static const ProtobufCIntRange mgmt__pool_rebuild_status__number_ranges[1 + 1] =
 {
   { 1, 0 },
-  { 0, 4 }
+  { 0, 5 }
 };
 const ProtobufCMessageDescriptor mgmt__pool_rebuild_status__descriptor =
 {
@@ -3298,7 +3485,7 @@ const ProtobufCMessageDescriptor mgmt__pool_rebuild_status__descriptor =
   "Mgmt__PoolRebuildStatus",
   "mgmt",
   sizeof(Mgmt__PoolRebuildStatus),
-  4,
+  5,
   mgmt__pool_rebuild_status__field_descriptors,
   mgmt__pool_rebuild_status__field_indices_by_name,
   1,  mgmt__pool_rebuild_status__number_ranges,
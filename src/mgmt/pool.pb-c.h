@@ -17,6 +17,7 @@ PROTOBUF_C__BEGIN_DECLS
 
 typedef struct _Mgmt__PoolCreateReq Mgmt__PoolCreateReq;
 typedef struct _Mgmt__PoolCreateResp Mgmt__PoolCreateResp;
+typedef struct _Mgmt__PoolCreatePlanResp Mgmt__PoolCreatePlanResp;
 typedef struct _Mgmt__PoolDestroyReq Mgmt__PoolDestroyReq;
 typedef struct _Mgmt__PoolDestroyResp Mgmt__PoolDestroyResp;
 typedef struct _Mgmt__PoolEvictReq Mgmt__PoolEvictReq;
@@ -213,10 +214,19 @@ struct  _Mgmt__PoolCreateReq
    * Size in bytes of metadata blob on SSD (manual config)
    */
   uint64_t meta_blob_size;
+  /*
+   * operator-defined pool tags in key=value format
+   */
+  size_t n_tags;
+  char **tags;
+  /*
+   * name of the pool profile providing defaults, if any
+   */
+  char *profile;
 };
 #define MGMT__POOL_CREATE_REQ__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_create_req__descriptor) \
-    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0,NULL, 0, 0, 0,NULL, 0, 0,NULL, 0,NULL, 0 }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, 0,NULL, 0,NULL, 0, 0, 0,NULL, 0, 0,NULL, 0,NULL, 0, 0,NULL, (char *)protobuf_c_empty_string }
 
 
 /*
@@ -258,6 +268,42 @@ struct  _Mgmt__PoolCreateResp
     , 0, 0, 0,NULL, 0,NULL, 0,NULL, 0 }
 
 
+/*
+ * PoolCreatePlanResp describes how a pool create request would be placed
+ * across the system without creating the pool.
+ */
+struct  _Mgmt__PoolCreatePlanResp
+{
+  ProtobufCMessage base;
+  /*
+   * pool target ranks
+   */
+  size_t n_tgt_ranks;
+  uint32_t *tgt_ranks;
+  /*
+   * storage tiers allocated per rank
+   */
+  size_t n_tier_bytes;
+  uint64_t *tier_bytes;
+  /*
+   * Size in bytes of metadata blob on SSD (manual config)
+   */
+  uint64_t meta_blob_size;
+  /*
+   * number of targets per rank
+   */
+  uint32_t tgts_per_rank;
+  /*
+   * fault domains of the pool target ranks
+   */
+  size_t n_fault_domains;
+  char **fault_domains;
+};
+#define MGMT__POOL_CREATE_PLAN_RESP__INIT \
+ { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_create_plan_resp__descriptor) \
+    , 0,NULL, 0,NULL, 0, 0, 0,NULL }
+
+
 /*
  * PoolDestroyReq supplies pool identifier and force flag.
  */
@@ -625,10 +671,15 @@ struct  _Mgmt__ListPoolsResp__Pool
    * pool rebuild state
    */
   char *rebuild_state;
+  /*
+   * operator-defined pool tags in key=value format
+   */
+  size_t n_tags;
+  char **tags;
 };
 #define MGMT__LIST_POOLS_RESP__POOL__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__list_pools_resp__pool__descriptor) \
-    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string }
+    , (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL, (char *)protobuf_c_empty_string, (char *)protobuf_c_empty_string, 0,NULL }
 
 
 /*
@@ -775,10 +826,14 @@ struct  _Mgmt__PoolRebuildStatus
   Mgmt__PoolRebuildStatus__State state;
   uint64_t objects;
   uint64_t records;
+  /*
+   * objects to be rebuilt; increases while busy
+   */
+  uint64_t total_objects;
 };
 #define MGMT__POOL_REBUILD_STATUS__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__pool_rebuild_status__descriptor) \
-    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0 }
+    , 0, MGMT__POOL_REBUILD_STATUS__STATE__IDLE, 0, 0, 0 }
 
 
 /*
@@ -1162,6 +1217,25 @@ Mgmt__PoolCreateResp *
 void   mgmt__pool_create_resp__free_unpacked
                      (Mgmt__PoolCreateResp *message,
                       ProtobufCAllocator *allocator);
+/* Mgmt__PoolCreatePlanResp methods */
+void   mgmt__pool_create_plan_resp__init
+                     (Mgmt__PoolCreatePlanResp         *message);
+size_t mgmt__pool_create_plan_resp__get_packed_size
+                     (const Mgmt__PoolCreatePlanResp   *message);
+size_t mgmt__pool_create_plan_resp__pack
+                     (const Mgmt__PoolCreatePlanResp   *message,
+                      uint8_t             *out);
+size_t mgmt__pool_create_plan_resp__pack_to_buffer
+                     (const Mgmt__PoolCreatePlanResp   *message,
+                      ProtobufCBuffer     *buffer);
+Mgmt__PoolCreatePlanResp *
+       mgmt__pool_create_plan_resp__unpack
+                     (ProtobufCAllocator  *allocator,
+                      size_t               len,
+                      const uint8_t       *data);
+void   mgmt__pool_create_plan_resp__free_unpacked
+                     (Mgmt__PoolCreatePlanResp *message,
+                      ProtobufCAllocator *allocator);
 /* Mgmt__PoolDestroyReq methods */
 void   mgmt__pool_destroy_req__init
                      (Mgmt__PoolDestroyReq         *message);
@@ -1765,6 +1839,9 @@ typedef void (*Mgmt__PoolCreateReq_Closure)
 typedef void (*Mgmt__PoolCreateResp_Closure)
                  (const Mgmt__PoolCreateResp *message,
                   void *closure_data);
+typedef void (*Mgmt__PoolCreatePlanResp_Closure)
+                 (const Mgmt__PoolCreatePlanResp *message,
+                  void *closure_data);
 typedef void (*Mgmt__PoolDestroyReq_Closure)
                  (const Mgmt__PoolDestroyReq *message,
                   void *closure_data);
@@ -1874,6 +1951,7 @@ extern const ProtobufCEnumDescriptor    mgmt__storage_media_type__descriptor;
 extern const ProtobufCEnumDescriptor    mgmt__pool_service_state__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_create_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_create_resp__descriptor;
+extern const ProtobufCMessageDescriptor mgmt__pool_create_plan_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_destroy_req__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_destroy_resp__descriptor;
 extern const ProtobufCMessageDescriptor mgmt__pool_evict_req__descriptor;
@@ -1710,6 +1710,7 @@ pool_rebuild_status_from_info(Mgmt__PoolRebuildStatus *rebuild,
 	if (rebuild->status == 0) {
 		rebuild->objects = info->rs_obj_nr;
 		rebuild->records = info->rs_rec_nr;
+		rebuild->total_objects = info->rs_toberb_obj_nr;
 
 		if (info->rs_version == 0)
 			rebuild->state = MGMT__POOL_REBUILD_STATUS__STATE__IDLE;
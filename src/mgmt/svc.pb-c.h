@@ -311,10 +311,15 @@ struct  _Mgmt__GetAttachInfoResp
    * Name of the DAOS system
    */
   char *sys;
+  /*
+   * Control plane addresses of MS replicas
+   */
+  size_t n_ms_replicas;
+  char **ms_replicas;
 };
 #define MGMT__GET_ATTACH_INFO_RESP__INIT \
  { PROTOBUF_C_MESSAGE_INIT (&mgmt__get_attach_info_resp__descriptor) \
-    , 0, 0,NULL, 0,NULL, NULL, 0, (char *)protobuf_c_empty_string }
+    , 0, 0,NULL, 0,NULL, NULL, 0, (char *)protobuf_c_empty_string, 0,NULL }
 
 
 struct  _Mgmt__PrepShutdownReq
@@ -1328,6 +1328,7 @@ init_test_rebuild_status(struct daos_rebuild_status *rebuild)
 {
 	rebuild->rs_obj_nr = 101;
 	rebuild->rs_rec_nr = 102;
+	rebuild->rs_toberb_obj_nr = 103;
 }
 
 static void
@@ -1354,6 +1355,7 @@ expect_rebuild_status(struct daos_rebuild_status *exp,
 	assert_int_equal(actual->status, exp->rs_errno);
 	assert_int_equal(actual->objects, exp->rs_obj_nr);
 	assert_int_equal(actual->records, exp->rs_rec_nr);
+	assert_int_equal(actual->total_objects, exp->rs_toberb_obj_nr);
 	assert_int_equal(actual->state, exp_state);
 }
 